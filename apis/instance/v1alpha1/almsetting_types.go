@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// DevOps Platforms of an AlmSetting.
+const (
+	// AlmGitHub is GitHub, integrated through a GitHub App.
+	AlmGitHub = "github"
+	// AlmGitLab is GitLab, integrated through a personal access token.
+	AlmGitLab = "gitlab"
+	// AlmAzureDevOps is Azure DevOps, integrated through a personal access token.
+	AlmAzureDevOps = "azure"
+	// AlmBitbucketServer is Bitbucket Server or Data Center, integrated through a personal access token.
+	AlmBitbucketServer = "bitbucket"
+	// AlmBitbucketCloud is Bitbucket Cloud, integrated through an OAuth consumer.
+	AlmBitbucketCloud = "bitbucketcloud"
+)
+
+// AlmSettingParameters represent the desired state of an AlmSetting.
+type AlmSettingParameters struct {
+	// Alm is the DevOps Platform the AlmSetting integrates: github, gitlab, azure (Azure DevOps),
+	// bitbucket (Bitbucket Server or Data Center) or bitbucketcloud (Bitbucket Cloud).
+	// WARNING: This field is immutable once set.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Alm is immutable."
+	// +kubebuilder:validation:Enum=github;gitlab;azure;bitbucket;bitbucketcloud
+	// +kubebuilder:validation:Required
+	Alm string `json:"alm"`
+	// Key is the unique key of the AlmSetting, used to bind Projects to it.
+	// WARNING: This field is immutable once set.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Key is immutable."
+	// +kubebuilder:validation:MaxLength=200
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// URL is the API URL of the DevOps Platform, such as https://api.github.com/ or https://gitlab.com/api/v4.
+	// It is required for every DevOps Platform but Bitbucket Cloud.
+	// +kubebuilder:validation:Optional
+	URL *string `json:"url,omitempty"`
+	// AppID is the ID of the GitHub App.
+	// +kubebuilder:validation:Optional
+	AppID *string `json:"appId,omitempty"`
+	// ClientID is the client ID of the GitHub App or of the Bitbucket Cloud OAuth consumer.
+	// +kubebuilder:validation:Optional
+	ClientID *string `json:"clientId,omitempty"`
+	// Workspace is the ID of the Bitbucket Cloud workspace.
+	// +kubebuilder:validation:Optional
+	Workspace *string `json:"workspace,omitempty"`
+	// PersonalAccessTokenSecretRef references the key of a Secret, in the namespace of the AlmSetting,
+	// holding the personal access token of GitLab, Azure DevOps or Bitbucket Server.
+	// +kubebuilder:validation:Optional
+	PersonalAccessTokenSecretRef *xpv1.LocalSecretKeySelector `json:"personalAccessTokenSecretRef,omitempty"`
+	// ClientSecretSecretRef references the key of a Secret, in the namespace of the AlmSetting,
+	// holding the client secret of the GitHub App or of the Bitbucket Cloud OAuth consumer.
+	// +kubebuilder:validation:Optional
+	ClientSecretSecretRef *xpv1.LocalSecretKeySelector `json:"clientSecretSecretRef,omitempty"`
+	// PrivateKeySecretRef references the key of a Secret, in the namespace of the AlmSetting,
+	// holding the private key of the GitHub App.
+	// +kubebuilder:validation:Optional
+	PrivateKeySecretRef *xpv1.LocalSecretKeySelector `json:"privateKeySecretRef,omitempty"`
+	// WebhookSecretSecretRef references the key of a Secret, in the namespace of the AlmSetting,
+	// holding the webhook secret of the GitHub App.
+	// +kubebuilder:validation:Optional
+	WebhookSecretSecretRef *xpv1.LocalSecretKeySelector `json:"webhookSecretSecretRef,omitempty"`
+}
+
+// AlmSettingObservation are the observable fields of an AlmSetting.
+type AlmSettingObservation struct {
+	// Alm is the DevOps Platform the AlmSetting integrates.
+	Alm string `json:"alm,omitempty"`
+	// Key is the unique key of the AlmSetting.
+	Key string `json:"key,omitempty"`
+	// URL is the API URL of the DevOps Platform.
+	URL string `json:"url,omitempty"`
+	// AppID is the ID of the GitHub App.
+	AppID string `json:"appId,omitempty"`
+	// ClientID is the client ID of the GitHub App or of the Bitbucket Cloud OAuth consumer.
+	ClientID string `json:"clientId,omitempty"`
+	// Workspace is the ID of the Bitbucket Cloud workspace.
+	Workspace string `json:"workspace,omitempty"`
+	// SecretVersions are the UIDs and resource versions of the referenced Secrets whose credentials were last set by the provider,
+	// keyed by the name of the credential. SonarQube does not return the credentials, so they are used to detect a change of the Secrets.
+	SecretVersions map[string]string `json:"secretVersions,omitempty"`
+}
+
+// An AlmSettingSpec defines the desired state of an AlmSetting.
+type AlmSettingSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the AlmSetting.
+	ForProvider AlmSettingParameters `json:"forProvider"`
+}
+
+// An AlmSettingStatus represents the observed state of an AlmSetting.
+type AlmSettingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the AlmSetting.
+	AtProvider AlmSettingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AlmSetting is the integration of SonarQube with a DevOps Platform, such as GitHub or GitLab,
+// used to import Projects and to decorate their pull requests.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ALM",type="string",JSONPath=".spec.forProvider.alm"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.url"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type AlmSetting struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self.forProvider.alm == 'bitbucketcloud' || has(self.forProvider.url)",message="spec.forProvider.url is required for every DevOps Platform but Bitbucket Cloud"
+	// +kubebuilder:validation:XValidation:rule="self.forProvider.alm != 'github' || (has(self.forProvider.appId) && has(self.forProvider.clientId) && has(self.forProvider.clientSecretSecretRef) && has(self.forProvider.privateKeySecretRef))",message="spec.forProvider.appId, clientId, clientSecretSecretRef and privateKeySecretRef are required for GitHub"
+	// +kubebuilder:validation:XValidation:rule="!(self.forProvider.alm in ['gitlab', 'azure', 'bitbucket']) || has(self.forProvider.personalAccessTokenSecretRef)",message="spec.forProvider.personalAccessTokenSecretRef is required for GitLab, Azure DevOps and Bitbucket Server"
+	// +kubebuilder:validation:XValidation:rule="self.forProvider.alm != 'bitbucketcloud' || (has(self.forProvider.clientId) && has(self.forProvider.clientSecretSecretRef) && has(self.forProvider.workspace))",message="spec.forProvider.clientId, clientSecretSecretRef and workspace are required for Bitbucket Cloud"
+	Spec   AlmSettingSpec   `json:"spec"`
+	Status AlmSettingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlmSettingList contains a list of AlmSetting
+type AlmSettingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlmSetting `json:"items"`
+}
+
+// AlmSettingKey extracts the key of a referenced AlmSetting, which identifies it in SonarQube.
+func AlmSettingKey() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		as, ok := mg.(*AlmSetting)
+		if !ok {
+			return ""
+		}
+		return as.Spec.ForProvider.Key
+	}
+}
+
+// AlmSetting type metadata.
+var (
+	AlmSettingKind             = reflect.TypeOf(AlmSetting{}).Name()
+	AlmSettingGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AlmSettingKind}.String()
+	AlmSettingKindAPIVersion   = AlmSettingKind + "." + SchemeGroupVersion.String()
+	AlmSettingGroupVersionKind = SchemeGroupVersion.WithKind(AlmSettingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AlmSetting{}, &AlmSettingList{})
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// TypeEditionSupported is the status condition type reporting whether the edition of the SonarQube instance
+// supports the resource, Applications and Portfolios requiring the Enterprise Edition or above.
+const TypeEditionSupported xpv1.ConditionType = "EditionSupported"
+
+// Reasons of the EditionSupported status condition of an Application or a Portfolio.
+const (
+	ReasonEditionSupported   xpv1.ConditionReason = "Supported"
+	ReasonEditionUnsupported xpv1.ConditionReason = "Unsupported"
+)
+
+// ApplicationParameters represent the desired state of an Application.
+type ApplicationParameters struct {
+	// Key is the unique key of the Application.
+	// WARNING: This field is immutable once set, SonarQube does not allow updating it through its API.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Key is immutable."
+	// +kubebuilder:validation:MaxLength=400
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// Name is the display name of the Application.
+	// +kubebuilder:validation:MaxLength=500
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Description is the description of the Application.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=256
+	Description *string `json:"description,omitempty"`
+	// Projects is the list of keys of the Projects aggregated by the Application.
+	// Projects of the Application missing from this list are removed from it.
+	// If not specified, the Projects of the Application are not managed, an empty list removes all of them.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectRefs
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Projects []string `json:"projects"`
+	// ProjectRefs are references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectRefs []xpv1.NamespacedReference `json:"projectRefs,omitempty"`
+	// ProjectSelector selects references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectSelector *xpv1.NamespacedSelector `json:"projectSelector,omitempty"`
+	// Branches is the list of branches of the Application, other than its main branch which aggregates the main branches of its Projects.
+	// Branches of the Application missing from this list are deleted.
+	// If not specified, the branches of the Application are not managed, an empty list deletes all of them.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Branches []ApplicationBranch `json:"branches"`
+}
+
+// ApplicationBranch is a branch of an Application, aggregating a branch of each of its Projects.
+type ApplicationBranch struct {
+	// Name is the name of the branch of the Application.
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Projects is the list of the branches of the Projects aggregated by the branch of the Application.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	// +listType=map
+	// +listMapKey=projectKey
+	Projects []ApplicationBranchProject `json:"projects"`
+}
+
+// ApplicationBranchProject is the branch of a Project aggregated by a branch of an Application.
+type ApplicationBranchProject struct {
+	// ProjectKey is the key of the Project, which must be one of the Projects of the Application.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	ProjectKey string `json:"projectKey"`
+	// Branch is the name of the branch of the Project.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Branch string `json:"branch"`
+}
+
+// ApplicationObservation are the observable fields of an Application.
+type ApplicationObservation struct {
+	// Key is the unique key of the Application.
+	Key string `json:"key,omitempty"`
+	// Name is the display name of the Application.
+	Name string `json:"name,omitempty"`
+	// Description is the description of the Application.
+	Description string `json:"description,omitempty"`
+	// Visibility is the visibility of the Application.
+	Visibility string `json:"visibility,omitempty"`
+	// Projects is the list of keys of the Projects aggregated by the Application.
+	Projects []string `json:"projects,omitempty"`
+	// Branches is the list of branches of the Application, other than its main branch.
+	Branches []ApplicationBranch `json:"branches,omitempty"`
+}
+
+// An ApplicationSpec defines the desired state of an Application.
+type ApplicationSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Application.
+	ForProvider ApplicationParameters `json:"forProvider"`
+}
+
+// An ApplicationStatus represents the observed state of an Application.
+type ApplicationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Application.
+	AtProvider ApplicationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Application aggregates several SonarQube Projects, and some of their branches, to report on them as a whole.
+// Applications require the SonarQube Enterprise Edition or above.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EDITION-SUPPORTED",type="string",JSONPath=".status.conditions[?(@.type=='EditionSupported')].status",priority=1
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type Application struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSpec   `json:"spec"`
+	Status ApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationList contains a list of Application
+type ApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Application `json:"items"`
+}
+
+// Application type metadata.
+var (
+	ApplicationKind             = reflect.TypeOf(Application{}).Name()
+	ApplicationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ApplicationKind}.String()
+	ApplicationKindAPIVersion   = ApplicationKind + "." + SchemeGroupVersion.String()
+	ApplicationGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Application{}, &ApplicationList{})
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// GroupParameters represent the desired state of a Group.
+type GroupParameters struct {
+	// Name is the unique name of the Group.
+	// Changing it renames the Group in SonarQube.
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.lowerAscii() != 'anyone'",message="The name anyone is reserved by SonarQube."
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Description is the description of the Group.
+	// If not specified, the description of the Group is not managed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=200
+	Description *string `json:"description,omitempty"`
+}
+
+// GroupObservation are the observable fields of a Group.
+type GroupObservation struct {
+	// Default indicates whether the Group is the default group of the new Users.
+	Default bool `json:"default"`
+	// Description is the description of the Group.
+	Description string `json:"description,omitempty"`
+	// Managed indicates whether the Group is managed by an external provisioning system.
+	Managed bool `json:"managed"`
+	// MembersCount is the number of Users in the Group.
+	MembersCount int64 `json:"membersCount"`
+	// Name is the name of the Group.
+	Name string `json:"name"`
+}
+
+// A GroupSpec defines the desired state of a Group.
+type GroupSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Group.
+	ForProvider GroupParameters `json:"forProvider"`
+}
+
+// A GroupStatus represents the observed state of a Group.
+type GroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Group.
+	AtProvider GroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Group is a group of Users of the SonarQube instance, whose members are managed with GroupMemberships.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MEMBERS",type="integer",JSONPath=".status.atProvider.membersCount"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type Group struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupSpec   `json:"spec"`
+	Status GroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupList contains a list of Group
+type GroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Group `json:"items"`
+}
+
+// Group type metadata.
+var (
+	GroupKind             = reflect.TypeOf(Group{}).Name()
+	GroupGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupKind}.String()
+	GroupKindAPIVersion   = GroupKind + "." + SchemeGroupVersion.String()
+	GroupGroupVersionKind = SchemeGroupVersion.WithKind(GroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Group{}, &GroupList{})
+}
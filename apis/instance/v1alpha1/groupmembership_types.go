@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// GroupMembershipParameters represent the User added to a Group by a GroupMembership.
+type GroupMembershipParameters struct {
+	// Group is the name of the Group the User is added to.
+	// WARNING: This field is immutable once set, a GroupMembership cannot be moved to another Group.
+	// +crossplane:generate:reference:type=Group
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Group is immutable."
+	// +kubebuilder:validation:Optional
+	Group *string `json:"group,omitempty"`
+	// GroupRef is a reference to a Group used to set Group.
+	// +kubebuilder:validation:Optional
+	GroupRef *xpv1.NamespacedReference `json:"groupRef,omitempty"`
+	// GroupSelector selects a reference to a Group used to set Group.
+	// +kubebuilder:validation:Optional
+	GroupSelector *xpv1.NamespacedSelector `json:"groupSelector,omitempty"`
+	// Login is the login of the User added to the Group.
+	// WARNING: This field is immutable once set, a GroupMembership cannot be moved to another User.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Login is immutable."
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Login string `json:"login"`
+}
+
+// GroupMembershipObservation are the observable fields of a GroupMembership.
+type GroupMembershipObservation struct {
+	// ID is the unique identifier of the membership.
+	ID string `json:"id,omitempty"`
+	// GroupID is the unique identifier of the Group.
+	GroupID string `json:"groupId,omitempty"`
+	// UserID is the unique identifier of the User.
+	UserID string `json:"userId,omitempty"`
+}
+
+// A GroupMembershipSpec defines the desired state of a GroupMembership.
+type GroupMembershipSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the User added to the Group.
+	ForProvider GroupMembershipParameters `json:"forProvider"`
+}
+
+// A GroupMembershipStatus represents the observed state of a GroupMembership.
+type GroupMembershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the GroupMembership.
+	AtProvider GroupMembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupMembership adds a User to a Group of the SonarQube instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.forProvider.group"
+// +kubebuilder:printcolumn:name="LOGIN",type="string",JSONPath=".spec.forProvider.login"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type GroupMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.group) || has(self.forProvider.groupRef) || has(self.forProvider.groupSelector)",message="spec.forProvider.group is required"
+	Spec   GroupMembershipSpec   `json:"spec"`
+	Status GroupMembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupMembershipList contains a list of GroupMembership
+type GroupMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupMembership `json:"items"`
+}
+
+// GroupMembership type metadata.
+var (
+	GroupMembershipKind             = reflect.TypeOf(GroupMembership{}).Name()
+	GroupMembershipGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupMembershipKind}.String()
+	GroupMembershipKindAPIVersion   = GroupMembershipKind + "." + SchemeGroupVersion.String()
+	GroupMembershipGroupVersionKind = SchemeGroupVersion.WithKind(GroupMembershipKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GroupMembership{}, &GroupMembershipList{})
+}
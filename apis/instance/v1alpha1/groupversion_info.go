@@ -27,13 +27,13 @@ import (
 
 // Package type metadata.
 const (
-	Group   = "instance.sonarqube.crossplane.io"
-	Version = "v1alpha1"
+	CRDGroup = "instance.sonarqube.crossplane.io"
+	Version  = "v1alpha1"
 )
 
 var (
 	// SchemeGroupVersion is group version used to register these objects
-	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+	SchemeGroupVersion = schema.GroupVersion{Group: CRDGroup, Version: Version}
 
 	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
 	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
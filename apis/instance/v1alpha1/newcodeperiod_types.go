@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// Scopes of a NewCodePeriod.
+const (
+	// NewCodePeriodScopeInstance defines the new code of every Project without a definition of its own.
+	NewCodePeriodScopeInstance = "Instance"
+	// NewCodePeriodScopeProject defines the new code of every branch of a Project without a definition of its own.
+	NewCodePeriodScopeProject = "Project"
+	// NewCodePeriodScopeBranch defines the new code of a single branch of a Project.
+	NewCodePeriodScopeBranch = "Branch"
+)
+
+// Types of a NewCodePeriod.
+const (
+	// NewCodePeriodTypePreviousVersion considers the code changed since the previous version as new code.
+	NewCodePeriodTypePreviousVersion = "PREVIOUS_VERSION"
+	// NewCodePeriodTypeNumberOfDays considers the code changed in the last number of days as new code.
+	NewCodePeriodTypeNumberOfDays = "NUMBER_OF_DAYS"
+	// NewCodePeriodTypeReferenceBranch considers the code that differs from a reference branch as new code.
+	NewCodePeriodTypeReferenceBranch = "REFERENCE_BRANCH"
+	// NewCodePeriodTypeSpecificAnalysis considers the code changed since a specific analysis as new code.
+	NewCodePeriodTypeSpecificAnalysis = "SPECIFIC_ANALYSIS"
+)
+
+// NewCodePeriodParameters represent the desired state of a NewCodePeriod.
+type NewCodePeriodParameters struct {
+	// Scope defines whether the new code definition applies to the whole instance (Instance), a Project (Project) or a single branch (Branch).
+	// WARNING: This field is immutable once set.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Scope is immutable."
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Instance;Project;Branch
+	// +kubebuilder:default=Instance
+	Scope *string `json:"scope,omitempty"`
+	// ProjectKey is the key of the Project the new code definition applies to, required for the Project and Branch scopes.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectKeyRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectKeySelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ProjectKey is immutable."
+	// +kubebuilder:validation:Optional
+	ProjectKey *string `json:"projectKey,omitempty"`
+	// ProjectKeyRef is a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeyRef *xpv1.NamespacedReference `json:"projectKeyRef,omitempty"`
+	// ProjectKeySelector selects a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeySelector *xpv1.NamespacedSelector `json:"projectKeySelector,omitempty"`
+	// Branch is the key of the branch the new code definition applies to, required for the Branch scope.
+	// WARNING: This field is immutable once set.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Branch is immutable."
+	// +kubebuilder:validation:Optional
+	Branch *string `json:"branch,omitempty"`
+	// Type is the type of the new code definition.
+	// REFERENCE_BRANCH is not allowed for the Instance scope, and SPECIFIC_ANALYSIS is only allowed for the Branch scope.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=PREVIOUS_VERSION;NUMBER_OF_DAYS;REFERENCE_BRANCH;SPECIFIC_ANALYSIS
+	Type string `json:"type"`
+	// Value is the value of the new code definition: a number of days between 1 and 90 for NUMBER_OF_DAYS,
+	// the key of a branch for REFERENCE_BRANCH or the ID of an analysis for SPECIFIC_ANALYSIS.
+	// It must not be specified for PREVIOUS_VERSION.
+	// +kubebuilder:validation:Optional
+	Value *string `json:"value,omitempty"`
+}
+
+// NewCodePeriodObservation are the observable fields of a NewCodePeriod.
+type NewCodePeriodObservation struct {
+	// ProjectKey is the key of the Project of the new code definition.
+	ProjectKey string `json:"projectKey,omitempty"`
+	// BranchKey is the key of the branch of the new code definition.
+	BranchKey string `json:"branchKey,omitempty"`
+	// Type is the type of the new code definition in effect.
+	Type string `json:"type,omitempty"`
+	// Value is the value of the new code definition in effect.
+	Value string `json:"value,omitempty"`
+	// EffectiveValue is the value the new code definition resolves to, such as the date of the previous version.
+	EffectiveValue string `json:"effectiveValue,omitempty"`
+	// Inherited indicates whether the new code definition in effect is inherited from the Project or the instance, rather than defined in the scope.
+	Inherited bool `json:"inherited"`
+}
+
+// A NewCodePeriodSpec defines the desired state of a NewCodePeriod.
+type NewCodePeriodSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the NewCodePeriod.
+	ForProvider NewCodePeriodParameters `json:"forProvider"`
+}
+
+// A NewCodePeriodStatus represents the observed state of a NewCodePeriod.
+type NewCodePeriodStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the NewCodePeriod.
+	AtProvider NewCodePeriodObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NewCodePeriod defines the new code of the instance, a Project or a branch, on which the new_* Quality Gate conditions are evaluated.
+// Deleting a NewCodePeriod unsets it, so that the definition is inherited again, or reset to PREVIOUS_VERSION for the instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.scope"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="INHERITED",type="boolean",JSONPath=".status.atProvider.inherited"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type NewCodePeriod struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.scope) || self.forProvider.scope == 'Instance' || has(self.forProvider.projectKey) || has(self.forProvider.projectKeyRef) || has(self.forProvider.projectKeySelector)",message="spec.forProvider.projectKey is required for the Project and Branch scopes"
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.projectKey) || (has(self.forProvider.scope) && self.forProvider.scope != 'Instance')",message="spec.forProvider.projectKey can only be set for the Project and Branch scopes"
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.branch) == (has(self.forProvider.scope) && self.forProvider.scope == 'Branch')",message="spec.forProvider.branch must be set for the Branch scope, and only for it"
+	// +kubebuilder:validation:XValidation:rule="self.forProvider.type != 'REFERENCE_BRANCH' || (has(self.forProvider.scope) && self.forProvider.scope != 'Instance')",message="REFERENCE_BRANCH is not allowed for the Instance scope"
+	// +kubebuilder:validation:XValidation:rule="self.forProvider.type != 'SPECIFIC_ANALYSIS' || (has(self.forProvider.scope) && self.forProvider.scope == 'Branch')",message="SPECIFIC_ANALYSIS is only allowed for the Branch scope"
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.value) == (self.forProvider.type != 'PREVIOUS_VERSION')",message="spec.forProvider.value is required for NUMBER_OF_DAYS, REFERENCE_BRANCH and SPECIFIC_ANALYSIS, and not allowed for PREVIOUS_VERSION"
+	Spec   NewCodePeriodSpec   `json:"spec"`
+	Status NewCodePeriodStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NewCodePeriodList contains a list of NewCodePeriod
+type NewCodePeriodList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NewCodePeriod `json:"items"`
+}
+
+// NewCodePeriod type metadata.
+var (
+	NewCodePeriodKind             = reflect.TypeOf(NewCodePeriod{}).Name()
+	NewCodePeriodGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: NewCodePeriodKind}.String()
+	NewCodePeriodKindAPIVersion   = NewCodePeriodKind + "." + SchemeGroupVersion.String()
+	NewCodePeriodGroupVersionKind = SchemeGroupVersion.WithKind(NewCodePeriodKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NewCodePeriod{}, &NewCodePeriodList{})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// Scopes of a Permission.
+const (
+	// PermissionScopeGlobal grants permissions on the whole SonarQube instance.
+	PermissionScopeGlobal = "Global"
+	// PermissionScopeProject grants permissions on a single Project.
+	PermissionScopeProject = "Project"
+)
+
+// PermissionParameters represent the desired state of a Permission.
+type PermissionParameters struct {
+	// Scope defines whether the permissions are granted on the whole instance (Global) or on a single Project (Project).
+	// WARNING: This field is immutable once set.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Scope is immutable."
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Global;Project
+	// +kubebuilder:default=Global
+	Scope *string `json:"scope,omitempty"`
+	// ProjectKey is the key of the Project the permissions are granted on, required for the Project scope.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectKeyRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectKeySelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ProjectKey is immutable."
+	// +kubebuilder:validation:Optional
+	ProjectKey *string `json:"projectKey,omitempty"`
+	// ProjectKeyRef is a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeyRef *xpv1.NamespacedReference `json:"projectKeyRef,omitempty"`
+	// ProjectKeySelector selects a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeySelector *xpv1.NamespacedSelector `json:"projectKeySelector,omitempty"`
+	// Login is the login of the User the permissions are granted to.
+	// Exactly one of Login and GroupName must be specified.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=User
+	// +crossplane:generate:reference:refFieldName=LoginRef
+	// +crossplane:generate:reference:selectorFieldName=LoginSelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Login is immutable."
+	// +kubebuilder:validation:Optional
+	Login *string `json:"login,omitempty"`
+	// LoginRef is a reference to a User used to set Login.
+	// +kubebuilder:validation:Optional
+	LoginRef *xpv1.NamespacedReference `json:"loginRef,omitempty"`
+	// LoginSelector selects a reference to a User used to set Login.
+	// +kubebuilder:validation:Optional
+	LoginSelector *xpv1.NamespacedSelector `json:"loginSelector,omitempty"`
+	// GroupName is the name of the Group the permissions are granted to, or 'anyone' for everybody.
+	// Exactly one of Login and GroupName must be specified.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Group
+	// +crossplane:generate:reference:refFieldName=GroupNameRef
+	// +crossplane:generate:reference:selectorFieldName=GroupNameSelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="GroupName is immutable."
+	// +kubebuilder:validation:Optional
+	GroupName *string `json:"groupName,omitempty"`
+	// GroupNameRef is a reference to a Group used to set GroupName.
+	// +kubebuilder:validation:Optional
+	GroupNameRef *xpv1.NamespacedReference `json:"groupNameRef,omitempty"`
+	// GroupNameSelector selects a reference to a Group used to set GroupName.
+	// +kubebuilder:validation:Optional
+	GroupNameSelector *xpv1.NamespacedSelector `json:"groupNameSelector,omitempty"`
+	// Permissions is the list of permissions granted to the User or Group.
+	// Global permissions are admin, gateadmin, profileadmin, provisioning, scan, applicationcreator and portfoliocreator.
+	// Project permissions are admin, codeviewer, issueadmin, securityhotspotadmin, scan and user.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=admin;gateadmin;profileadmin;provisioning;scan;applicationcreator;portfoliocreator;codeviewer;issueadmin;securityhotspotadmin;user
+	// +listType=set
+	Permissions []string `json:"permissions"`
+	// Exclusive defines whether the permissions of the User or Group in the scope that are not listed in Permissions are removed.
+	// If false, such permissions are left untouched.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	Exclusive *bool `json:"exclusive,omitempty"`
+}
+
+// PermissionObservation are the observable fields of a Permission.
+type PermissionObservation struct {
+	// Permissions is the list of permissions the User or Group holds directly in the scope.
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// A PermissionSpec defines the desired state of a Permission.
+type PermissionSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Permission.
+	ForProvider PermissionParameters `json:"forProvider"`
+}
+
+// A PermissionStatus represents the observed state of a Permission.
+type PermissionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Permission.
+	AtProvider PermissionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Permission grants global or Project permissions to a User or a Group.
+// Deleting a Permission removes the listed permissions, and every permission of the User or Group in the scope when exclusive.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.scope"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.projectKey",priority=1
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.forProvider.login"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.forProvider.groupName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type Permission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="(has(self.forProvider.login) || has(self.forProvider.loginRef) || has(self.forProvider.loginSelector)) != (has(self.forProvider.groupName) || has(self.forProvider.groupNameRef) || has(self.forProvider.groupNameSelector))",message="exactly one of spec.forProvider.login and spec.forProvider.groupName must be set"
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.scope) || self.forProvider.scope != 'Project' || has(self.forProvider.projectKey) || has(self.forProvider.projectKeyRef) || has(self.forProvider.projectKeySelector)",message="spec.forProvider.projectKey is required for the Project scope"
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.projectKey) || (has(self.forProvider.scope) && self.forProvider.scope == 'Project')",message="spec.forProvider.projectKey can only be set for the Project scope"
+	Spec   PermissionSpec   `json:"spec"`
+	Status PermissionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionList contains a list of Permission
+type PermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Permission `json:"items"`
+}
+
+// Permission type metadata.
+var (
+	PermissionKind             = reflect.TypeOf(Permission{}).Name()
+	PermissionGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PermissionKind}.String()
+	PermissionKindAPIVersion   = PermissionKind + "." + SchemeGroupVersion.String()
+	PermissionGroupVersionKind = SchemeGroupVersion.WithKind(PermissionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Permission{}, &PermissionList{})
+}
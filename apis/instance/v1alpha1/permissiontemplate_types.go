@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// PermissionTemplateUserParameters are the permissions a Permission Template grants to a User.
+type PermissionTemplateUserParameters struct {
+	// Login is the login of the User.
+	// +kubebuilder:validation:Required
+	Login string `json:"login"`
+	// Permissions is the list of Project permissions granted to the User.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=admin;codeviewer;issueadmin;securityhotspotadmin;scan;user
+	// +listType=set
+	Permissions []string `json:"permissions"`
+}
+
+// PermissionTemplateGroupParameters are the permissions a Permission Template grants to a Group.
+type PermissionTemplateGroupParameters struct {
+	// GroupName is the name of the Group, or 'anyone' for everybody.
+	// +kubebuilder:validation:Required
+	GroupName string `json:"groupName"`
+	// Permissions is the list of Project permissions granted to the Group.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=admin;codeviewer;issueadmin;securityhotspotadmin;scan;user
+	// +listType=set
+	Permissions []string `json:"permissions"`
+}
+
+// PermissionTemplateParameters represent the desired state of a PermissionTemplate.
+type PermissionTemplateParameters struct {
+	// Name is the name of the Permission Template.
+	// Changing it renames the Permission Template in SonarQube.
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Description is the description of the Permission Template.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=4000
+	Description *string `json:"description,omitempty"`
+	// ProjectKeyPattern is the Java regular expression matching the keys of the Projects the Permission Template is applied to on creation.
+	// +kubebuilder:validation:Optional
+	ProjectKeyPattern *string `json:"projectKeyPattern,omitempty"`
+	// Users is the list of Users granted permissions by the Permission Template.
+	// Users granted permissions by the Permission Template but missing from this list lose them.
+	// If not specified, the Users of the Permission Template are not managed, an empty list removes all of them.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=login
+	Users []PermissionTemplateUserParameters `json:"users"`
+	// Groups is the list of Groups granted permissions by the Permission Template.
+	// Groups granted permissions by the Permission Template but missing from this list lose them.
+	// If not specified, the Groups of the Permission Template are not managed, an empty list removes all of them.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=groupName
+	Groups []PermissionTemplateGroupParameters `json:"groups"`
+	// ProjectCreatorPermissions is the list of Project permissions granted to the User creating a Project.
+	// If not specified, the permissions of the Project creator are not managed, an empty list removes all of them.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Enum=admin;codeviewer;issueadmin;securityhotspotadmin;scan;user
+	// +listType=set
+	ProjectCreatorPermissions []string `json:"projectCreatorPermissions"`
+	// DefaultFor is the list of qualifiers the Permission Template is the default one for, among Project, Application and Portfolio.
+	// WARNING: It is not possible to unset the default Permission Template in SonarQube. The only way to change it is to set another Permission Template as default.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Enum=Project;Application;Portfolio
+	// +listType=set
+	DefaultFor []string `json:"defaultFor,omitempty"`
+}
+
+// PermissionTemplateUserObservation are the permissions a Permission Template grants to a User.
+type PermissionTemplateUserObservation struct {
+	// Login is the login of the User.
+	Login string `json:"login"`
+	// Permissions is the list of Project permissions granted to the User.
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// PermissionTemplateGroupObservation are the permissions a Permission Template grants to a Group.
+type PermissionTemplateGroupObservation struct {
+	// GroupName is the name of the Group.
+	GroupName string `json:"groupName"`
+	// Permissions is the list of Project permissions granted to the Group.
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// PermissionTemplateObservation are the observable fields of a PermissionTemplate.
+type PermissionTemplateObservation struct {
+	// ID is the unique identifier of the Permission Template.
+	ID string `json:"id"`
+	// Name is the name of the Permission Template.
+	Name string `json:"name"`
+	// Description is the description of the Permission Template.
+	Description string `json:"description,omitempty"`
+	// ProjectKeyPattern is the regular expression matching the keys of the Projects the Permission Template is applied to.
+	ProjectKeyPattern string `json:"projectKeyPattern,omitempty"`
+	// CreatedAt is the date the Permission Template was created.
+	CreatedAt string `json:"createdAt,omitempty"`
+	// UpdatedAt is the date the Permission Template was last updated.
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	// Users is the list of Users granted permissions by the Permission Template.
+	Users []PermissionTemplateUserObservation `json:"users,omitempty"`
+	// Groups is the list of Groups granted permissions by the Permission Template.
+	Groups []PermissionTemplateGroupObservation `json:"groups,omitempty"`
+	// ProjectCreatorPermissions is the list of Project permissions granted to the User creating a Project.
+	ProjectCreatorPermissions []string `json:"projectCreatorPermissions,omitempty"`
+	// DefaultFor is the list of qualifiers the Permission Template is the default one for.
+	DefaultFor []string `json:"defaultFor,omitempty"`
+}
+
+// A PermissionTemplateSpec defines the desired state of a PermissionTemplate.
+type PermissionTemplateSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the PermissionTemplate.
+	ForProvider PermissionTemplateParameters `json:"forProvider"`
+}
+
+// A PermissionTemplateStatus represents the observed state of a PermissionTemplate.
+type PermissionTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the PermissionTemplate.
+	AtProvider PermissionTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PermissionTemplate defines the permissions granted on the Projects it is applied to, on creation or when it is the default one.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DEFAULT-FOR",type="string",JSONPath=".status.atProvider.defaultFor"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type PermissionTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PermissionTemplateSpec   `json:"spec"`
+	Status PermissionTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionTemplateList contains a list of PermissionTemplate
+type PermissionTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PermissionTemplate `json:"items"`
+}
+
+// PermissionTemplate type metadata.
+var (
+	PermissionTemplateKind             = reflect.TypeOf(PermissionTemplate{}).Name()
+	PermissionTemplateGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PermissionTemplateKind}.String()
+	PermissionTemplateKindAPIVersion   = PermissionTemplateKind + "." + SchemeGroupVersion.String()
+	PermissionTemplateGroupVersionKind = SchemeGroupVersion.WithKind(PermissionTemplateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PermissionTemplate{}, &PermissionTemplateList{})
+}
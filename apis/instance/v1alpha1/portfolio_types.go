@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// Selection modes of the Projects of a Portfolio.
+const (
+	// PortfolioSelectionModeNone selects no Project, the Portfolio only aggregates its sub-portfolios.
+	PortfolioSelectionModeNone = "NONE"
+	// PortfolioSelectionModeManual selects the Projects listed in the Portfolio.
+	PortfolioSelectionModeManual = "MANUAL"
+	// PortfolioSelectionModeTags selects the Projects having any of the tags of the Portfolio.
+	PortfolioSelectionModeTags = "TAGS"
+	// PortfolioSelectionModeRegexp selects the Projects whose name or key matches the regular expression of the Portfolio.
+	PortfolioSelectionModeRegexp = "REGEXP"
+	// PortfolioSelectionModeRest selects the Projects not selected by any other Portfolio.
+	PortfolioSelectionModeRest = "REST"
+)
+
+// PortfolioParameters represent the desired state of a Portfolio.
+type PortfolioParameters struct {
+	// Key is the unique key of the Portfolio.
+	// WARNING: This field is immutable once set, SonarQube does not allow updating it through its API.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Key is immutable."
+	// +kubebuilder:validation:MaxLength=400
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// Name is the display name of the Portfolio.
+	// +kubebuilder:validation:MaxLength=500
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Description is the description of the Portfolio.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=256
+	Description *string `json:"description,omitempty"`
+	// SelectionMode defines how the Projects of the Portfolio are selected:
+	// NONE selects no Project, MANUAL selects the listed Projects, TAGS selects the Projects having any of the listed tags,
+	// REGEXP selects the Projects whose name or key matches the regular expression,
+	// and REST selects the Projects not selected by any other Portfolio.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=NONE;MANUAL;TAGS;REGEXP;REST
+	// +kubebuilder:default=NONE
+	SelectionMode *string `json:"selectionMode,omitempty"`
+	// Projects is the list of keys of the Projects selected by the Portfolio, with the MANUAL selection mode only.
+	// Projects of the Portfolio missing from this list are removed from it.
+	// If not specified, the Projects of the Portfolio are not managed, an empty list removes all of them.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectRefs
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Projects []string `json:"projects"`
+	// ProjectRefs are references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectRefs []xpv1.NamespacedReference `json:"projectRefs,omitempty"`
+	// ProjectSelector selects references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectSelector *xpv1.NamespacedSelector `json:"projectSelector,omitempty"`
+	// Tags is the list of tags of the Projects selected by the Portfolio, with the TAGS selection mode only.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Tags []string `json:"tags,omitempty"`
+	// Regexp is the regular expression matching the name or key of the Projects selected by the Portfolio, with the REGEXP selection mode only.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	Regexp *string `json:"regexp,omitempty"`
+	// Branch is the name of the branch of the selected Projects aggregated by the Portfolio, with the TAGS, REGEXP and REST selection modes.
+	// If not specified, the main branch of the selected Projects is aggregated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	Branch *string `json:"branch,omitempty"`
+	// SubPortfolios is the list of keys of the Portfolios aggregated by the Portfolio.
+	// Sub-portfolios of the Portfolio missing from this list are removed from it.
+	// If not specified, the sub-portfolios of the Portfolio are not managed, an empty list removes all of them.
+	// +crossplane:generate:reference:type=Portfolio
+	// +crossplane:generate:reference:extractor=PortfolioKey()
+	// +crossplane:generate:reference:refFieldName=SubPortfolioRefs
+	// +crossplane:generate:reference:selectorFieldName=SubPortfolioSelector
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	SubPortfolios []string `json:"subPortfolios"`
+	// SubPortfolioRefs are references to Portfolios used to set SubPortfolios.
+	// +kubebuilder:validation:Optional
+	SubPortfolioRefs []xpv1.NamespacedReference `json:"subPortfolioRefs,omitempty"`
+	// SubPortfolioSelector selects references to Portfolios used to set SubPortfolios.
+	// +kubebuilder:validation:Optional
+	SubPortfolioSelector *xpv1.NamespacedSelector `json:"subPortfolioSelector,omitempty"`
+}
+
+// PortfolioObservation are the observable fields of a Portfolio.
+type PortfolioObservation struct {
+	// Key is the unique key of the Portfolio.
+	Key string `json:"key,omitempty"`
+	// Name is the display name of the Portfolio.
+	Name string `json:"name,omitempty"`
+	// Description is the description of the Portfolio.
+	Description string `json:"description,omitempty"`
+	// Qualifier is the component qualifier of the Portfolio.
+	Qualifier string `json:"qualifier,omitempty"`
+	// Visibility is the visibility of the Portfolio.
+	Visibility string `json:"visibility,omitempty"`
+	// SelectionMode is how the Projects of the Portfolio are selected.
+	SelectionMode string `json:"selectionMode,omitempty"`
+	// Projects is the list of keys of the Projects selected by the Portfolio with the MANUAL selection mode.
+	Projects []string `json:"projects,omitempty"`
+	// Tags is the list of tags of the Projects selected by the Portfolio with the TAGS selection mode.
+	Tags []string `json:"tags,omitempty"`
+	// Regexp is the regular expression matching the Projects selected by the Portfolio with the REGEXP selection mode.
+	Regexp string `json:"regexp,omitempty"`
+	// Branch is the name of the branch of the selected Projects aggregated by the Portfolio.
+	Branch string `json:"branch,omitempty"`
+	// SubPortfolios is the list of keys of the Portfolios aggregated by the Portfolio.
+	SubPortfolios []string `json:"subPortfolios,omitempty"`
+}
+
+// A PortfolioSpec defines the desired state of a Portfolio.
+type PortfolioSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Portfolio.
+	ForProvider PortfolioParameters `json:"forProvider"`
+}
+
+// A PortfolioStatus represents the observed state of a Portfolio.
+type PortfolioStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Portfolio.
+	AtProvider PortfolioObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Portfolio aggregates SonarQube Projects, selected manually or by tags, regular expression or leftover,
+// and other Portfolios to report on them as a whole.
+// Portfolios require the SonarQube Enterprise Edition or above.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EDITION-SUPPORTED",type="string",JSONPath=".status.conditions[?(@.type=='EditionSupported')].status",priority=1
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="SELECTION",type="string",JSONPath=".status.atProvider.selectionMode"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type Portfolio struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="(!has(self.forProvider.projects) && !has(self.forProvider.projectRefs) && !has(self.forProvider.projectSelector)) || (has(self.forProvider.selectionMode) && self.forProvider.selectionMode == 'MANUAL')",message="spec.forProvider.projects can only be set for the MANUAL selection mode"
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.tags) == (has(self.forProvider.selectionMode) && self.forProvider.selectionMode == 'TAGS')",message="spec.forProvider.tags must be set for the TAGS selection mode, and only for it"
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.regexp) == (has(self.forProvider.selectionMode) && self.forProvider.selectionMode == 'REGEXP')",message="spec.forProvider.regexp must be set for the REGEXP selection mode, and only for it"
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.branch) || (has(self.forProvider.selectionMode) && self.forProvider.selectionMode in ['TAGS', 'REGEXP', 'REST'])",message="spec.forProvider.branch can only be set for the TAGS, REGEXP and REST selection modes"
+	Spec   PortfolioSpec   `json:"spec"`
+	Status PortfolioStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PortfolioList contains a list of Portfolio
+type PortfolioList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Portfolio `json:"items"`
+}
+
+// PortfolioKey extracts the key of a referenced Portfolio, which identifies it in SonarQube.
+func PortfolioKey() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*Portfolio)
+		if !ok {
+			return ""
+		}
+		return p.Spec.ForProvider.Key
+	}
+}
+
+// Portfolio type metadata.
+var (
+	PortfolioKind             = reflect.TypeOf(Portfolio{}).Name()
+	PortfolioGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PortfolioKind}.String()
+	PortfolioKindAPIVersion   = PortfolioKind + "." + SchemeGroupVersion.String()
+	PortfolioGroupVersionKind = SchemeGroupVersion.WithKind(PortfolioKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Portfolio{}, &PortfolioList{})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// ProjectParameters represent the desired state of a Project.
+type ProjectParameters struct {
+	// Key is the unique key of the Project.
+	// Changing it renames the Project key in SonarQube.
+	// +kubebuilder:validation:MaxLength=400
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// Name is the display name of the Project.
+	// WARNING: This field is immutable once set, SonarQube does not allow updating it through its API.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable."
+	// +kubebuilder:validation:MaxLength=500
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Visibility defines whether the Project is visible to everyone (public) or only to specific users / groups (private).
+	// If not specified, the default project visibility of the SonarQube instance is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=public;private
+	Visibility *string `json:"visibility,omitempty"`
+	// MainBranch is the name of the main branch of the Project.
+	// If not specified, the default main branch name of the SonarQube instance is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	MainBranch *string `json:"mainBranch,omitempty"`
+	// Tags is the list of tags associated with the Project.
+	// If not specified, the tags of the Project are not managed, an empty list clears all of them.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Tags []string `json:"tags"`
+}
+
+// ProjectObservation are the observable fields of a Project.
+type ProjectObservation struct {
+	// Key is the unique key of the Project.
+	Key string `json:"key"`
+	// LastAnalysisDate is the date of the last analysis of the Project.
+	LastAnalysisDate string `json:"lastAnalysisDate,omitempty"`
+	// MainBranch is the name of the main branch of the Project.
+	MainBranch string `json:"mainBranch,omitempty"`
+	// Managed indicates whether the Project is managed by an external provisioning system.
+	Managed bool `json:"managed"`
+	// Name is the display name of the Project.
+	Name string `json:"name"`
+	// Qualifier is the component qualifier of the Project.
+	Qualifier string `json:"qualifier,omitempty"`
+	// Revision is the SCM revision of the last analysis of the main branch.
+	Revision string `json:"revision,omitempty"`
+	// Tags is the list of tags associated with the Project.
+	Tags []string `json:"tags,omitempty"`
+	// UUID is the unique identifier of the Project.
+	UUID string `json:"uuid,omitempty"`
+	// Visibility is the visibility of the Project.
+	Visibility string `json:"visibility,omitempty"`
+}
+
+// A ProjectSpec defines the desired state of a Project.
+type ProjectSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Project.
+	ForProvider ProjectParameters `json:"forProvider"`
+}
+
+// A ProjectStatus represents the observed state of a Project.
+type ProjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Project.
+	AtProvider ProjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Project is a SonarQube project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type Project struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectSpec   `json:"spec"`
+	Status ProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectList contains a list of Project
+type ProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Project `json:"items"`
+}
+
+// Project type metadata.
+var (
+	ProjectKind             = reflect.TypeOf(Project{}).Name()
+	ProjectGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ProjectKind}.String()
+	ProjectKindAPIVersion   = ProjectKind + "." + SchemeGroupVersion.String()
+	ProjectGroupVersionKind = SchemeGroupVersion.WithKind(ProjectKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Project{}, &ProjectList{})
+}
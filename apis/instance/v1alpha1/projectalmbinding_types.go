@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// ProjectAlmBindingParameters represent the desired state of a ProjectAlmBinding.
+type ProjectAlmBindingParameters struct {
+	// ProjectKey is the key of the Project bound to the repository.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectKeyRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectKeySelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ProjectKey is immutable."
+	// +kubebuilder:validation:Optional
+	ProjectKey *string `json:"projectKey,omitempty"`
+	// ProjectKeyRef is a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeyRef *xpv1.NamespacedReference `json:"projectKeyRef,omitempty"`
+	// ProjectKeySelector selects a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeySelector *xpv1.NamespacedSelector `json:"projectKeySelector,omitempty"`
+	// AlmSetting is the key of the AlmSetting of the DevOps Platform hosting the repository.
+	// +crossplane:generate:reference:type=AlmSetting
+	// +crossplane:generate:reference:extractor=AlmSettingKey()
+	// +crossplane:generate:reference:refFieldName=AlmSettingRef
+	// +crossplane:generate:reference:selectorFieldName=AlmSettingSelector
+	// +kubebuilder:validation:Optional
+	AlmSetting *string `json:"almSetting,omitempty"`
+	// AlmSettingRef is a reference to an AlmSetting used to set AlmSetting.
+	// +kubebuilder:validation:Optional
+	AlmSettingRef *xpv1.NamespacedReference `json:"almSettingRef,omitempty"`
+	// AlmSettingSelector selects a reference to an AlmSetting used to set AlmSetting.
+	// +kubebuilder:validation:Optional
+	AlmSettingSelector *xpv1.NamespacedSelector `json:"almSettingSelector,omitempty"`
+	// Repository identifies the repository in the DevOps Platform: the full name (organization/repository) on GitHub,
+	// the project ID on GitLab, the repository name on Azure DevOps, the project key on Bitbucket Server
+	// or the repository slug on Bitbucket Cloud.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Repository string `json:"repository"`
+	// Slug is the repository slug on Bitbucket Server, or the project name on Azure DevOps, where it is required.
+	// +kubebuilder:validation:Optional
+	Slug *string `json:"slug,omitempty"`
+	// SummaryCommentEnabled defines whether a summary comment is added to the pull requests, on GitHub only.
+	// +kubebuilder:validation:Optional
+	SummaryCommentEnabled *bool `json:"summaryCommentEnabled,omitempty"`
+	// Monorepo defines whether the repository holds several Projects, whose pull requests are decorated separately.
+	// +kubebuilder:validation:Optional
+	Monorepo *bool `json:"monorepo,omitempty"`
+}
+
+// ProjectAlmBindingObservation are the observable fields of a ProjectAlmBinding.
+type ProjectAlmBindingObservation struct {
+	// Alm is the DevOps Platform hosting the repository.
+	Alm string `json:"alm,omitempty"`
+	// AlmSetting is the key of the AlmSetting the Project is bound through.
+	AlmSetting string `json:"almSetting,omitempty"`
+	// Repository identifies the repository in the DevOps Platform.
+	Repository string `json:"repository,omitempty"`
+	// Slug is the repository slug on Bitbucket Server, or the project name on Azure DevOps.
+	Slug string `json:"slug,omitempty"`
+	// URL is the API URL of the DevOps Platform.
+	URL string `json:"url,omitempty"`
+	// SummaryCommentEnabled indicates whether a summary comment is added to the pull requests.
+	SummaryCommentEnabled bool `json:"summaryCommentEnabled"`
+	// Monorepo indicates whether the repository holds several Projects.
+	Monorepo bool `json:"monorepo"`
+}
+
+// A ProjectAlmBindingSpec defines the desired state of a ProjectAlmBinding.
+type ProjectAlmBindingSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the ProjectAlmBinding.
+	ForProvider ProjectAlmBindingParameters `json:"forProvider"`
+}
+
+// A ProjectAlmBindingStatus represents the observed state of a ProjectAlmBinding.
+type ProjectAlmBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the ProjectAlmBinding.
+	AtProvider ProjectAlmBindingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectAlmBinding binds a SonarQube Project to its repository in a DevOps Platform, through an AlmSetting,
+// so that its pull requests are decorated with the analysis results.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ALM",type="string",JSONPath=".status.atProvider.alm"
+// +kubebuilder:printcolumn:name="ALM-SETTING",type="string",JSONPath=".status.atProvider.almSetting"
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".status.atProvider.repository"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type ProjectAlmBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.projectKey) || has(self.forProvider.projectKeyRef) || has(self.forProvider.projectKeySelector)",message="spec.forProvider.projectKey is required"
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.almSetting) || has(self.forProvider.almSettingRef) || has(self.forProvider.almSettingSelector)",message="spec.forProvider.almSetting is required"
+	Spec   ProjectAlmBindingSpec   `json:"spec"`
+	Status ProjectAlmBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectAlmBindingList contains a list of ProjectAlmBinding
+type ProjectAlmBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectAlmBinding `json:"items"`
+}
+
+// ProjectAlmBinding type metadata.
+var (
+	ProjectAlmBindingKind             = reflect.TypeOf(ProjectAlmBinding{}).Name()
+	ProjectAlmBindingGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ProjectAlmBindingKind}.String()
+	ProjectAlmBindingKindAPIVersion   = ProjectAlmBindingKind + "." + SchemeGroupVersion.String()
+	ProjectAlmBindingGroupVersionKind = SchemeGroupVersion.WithKind(ProjectAlmBindingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProjectAlmBinding{}, &ProjectAlmBindingList{})
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// ProjectBranchParameters represent the desired state of a ProjectBranch.
+type ProjectBranchParameters struct {
+	// ProjectKey is the key of the Project the branch belongs to.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectKeyRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectKeySelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ProjectKey is immutable."
+	// +kubebuilder:validation:Optional
+	ProjectKey *string `json:"projectKey,omitempty"`
+	// ProjectKeyRef is a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeyRef *xpv1.NamespacedReference `json:"projectKeyRef,omitempty"`
+	// ProjectKeySelector selects a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeySelector *xpv1.NamespacedSelector `json:"projectKeySelector,omitempty"`
+	// Name is the name of the branch.
+	// Branches are created by analyzing them, except for the main branch of the Project,
+	// which is renamed to Name when it has another name and IsMain is true.
+	// Once another branch has been observed, the main branch is only renamed if it is that branch.
+	// WARNING: This field can only be updated for the main branch.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// ExcludedFromPurge defines whether the branch is protected from automatic deletion when it has not been analyzed for a while.
+	// The main branch is always protected.
+	// If not specified, the protection of the branch is not managed.
+	// +kubebuilder:validation:Optional
+	ExcludedFromPurge *bool `json:"excludedFromPurge,omitempty"`
+	// IsMain defines whether the branch is the main branch of the Project.
+	// Setting it to true makes the branch the main one, the previous main branch becoming a regular branch.
+	// A branch stops being the main one only when another branch is made the main one, so that false is ignored.
+	// +kubebuilder:validation:Optional
+	IsMain *bool `json:"isMain,omitempty"`
+}
+
+// ProjectBranchObservation are the observable fields of a ProjectBranch.
+type ProjectBranchObservation struct {
+	// BranchID is the ID of the branch.
+	BranchID string `json:"branchId,omitempty"`
+	// Name is the name of the branch.
+	Name string `json:"name,omitempty"`
+	// Type is the type of the branch.
+	Type string `json:"type,omitempty"`
+	// IsMain indicates whether the branch is the main branch of the Project.
+	IsMain bool `json:"isMain"`
+	// ExcludedFromPurge indicates whether the branch is protected from automatic deletion.
+	ExcludedFromPurge bool `json:"excludedFromPurge"`
+	// AnalysisDate is the date of the last analysis of the branch.
+	AnalysisDate string `json:"analysisDate,omitempty"`
+	// QualityGateStatus is the status of the Quality Gate on the last analysis of the branch, such as OK or ERROR.
+	QualityGateStatus string `json:"qualityGateStatus,omitempty"`
+}
+
+// A ProjectBranchSpec defines the desired state of a ProjectBranch.
+type ProjectBranchSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the ProjectBranch.
+	ForProvider ProjectBranchParameters `json:"forProvider"`
+}
+
+// A ProjectBranchStatus represents the observed state of a ProjectBranch.
+type ProjectBranchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the ProjectBranch.
+	AtProvider ProjectBranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectBranch is a long-lived branch of a SonarQube Project.
+// Deleting a ProjectBranch deletes the branch, except for the main branch, which can only be deleted along with its Project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="MAIN",type="boolean",JSONPath=".status.atProvider.isMain"
+// +kubebuilder:printcolumn:name="QUALITY-GATE",type="string",JSONPath=".status.atProvider.qualityGateStatus"
+// +kubebuilder:printcolumn:name="LAST-ANALYSIS",type="string",JSONPath=".status.atProvider.analysisDate"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type ProjectBranch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.projectKey) || has(self.forProvider.projectKeyRef) || has(self.forProvider.projectKeySelector)",message="spec.forProvider.projectKey is required"
+	// +kubebuilder:validation:XValidation:rule="self.forProvider.name == oldSelf.forProvider.name || (has(self.forProvider.isMain) && self.forProvider.isMain)",message="spec.forProvider.name can only be updated for the main branch"
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.isMain) || !self.forProvider.isMain || !has(self.forProvider.excludedFromPurge) || self.forProvider.excludedFromPurge",message="the main branch is always excluded from purge"
+	Spec   ProjectBranchSpec   `json:"spec"`
+	Status ProjectBranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectBranchList contains a list of ProjectBranch
+type ProjectBranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectBranch `json:"items"`
+}
+
+// ProjectBranch type metadata.
+var (
+	ProjectBranchKind             = reflect.TypeOf(ProjectBranch{}).Name()
+	ProjectBranchGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ProjectBranchKind}.String()
+	ProjectBranchKindAPIVersion   = ProjectBranchKind + "." + SchemeGroupVersion.String()
+	ProjectBranchGroupVersionKind = SchemeGroupVersion.WithKind(ProjectBranchKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProjectBranch{}, &ProjectBranchList{})
+}
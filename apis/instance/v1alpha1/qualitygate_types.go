@@ -29,8 +29,7 @@ import (
 // QualityGateParameters represent the desired state of a QualityGate.
 type QualityGateParameters struct {
 	// Name is the Display name of the Quality Gate.
-	// WARNING: This field is immutable once set.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable."
+	// Changing it renames the Quality Gate in SonarQube, which is refused for built-in Quality Gates.
 	// +kubebuilder:validation:MaxLength=100
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Required
@@ -39,11 +38,85 @@ type QualityGateParameters struct {
 	// WARNING: It is currently not possible to unset the default Quality Gate in SonarQube once it is set. The only way to change the default Quality Gate is to set another Quality Gate as default.
 	// +kubebuilder:validation:Optional
 	Default *bool `json:"default,omitempty"`
+	// AiCodeSupported indicates whether the Quality Gate qualifies for AI Code Assurance.
+	// Projects containing AI-generated code can only be associated with Quality Gates qualifying for AI Code Assurance.
+	// +kubebuilder:validation:Optional
+	AiCodeSupported *bool `json:"aiCodeSupported,omitempty"`
 	// Conditions is the list of conditions associated with the Quality Gate.
 	// +kubebuilder:validation:Optional
 	Conditions []QualityGateConditionParameters `json:"conditions,omitempty"`
+	// CopyFrom is the name of an existing Quality Gate to copy when creating this Quality Gate.
+	// The copied conditions are merged into Conditions, a specified condition overriding the copied condition on the same metric.
+	// It is only used upon creation, changing it afterwards has no effect.
+	// +crossplane:generate:reference:type=QualityGate
+	// +crossplane:generate:reference:refFieldName=CopyFromRef
+	// +crossplane:generate:reference:selectorFieldName=CopyFromSelector
+	// +kubebuilder:validation:Optional
+	CopyFrom *string `json:"copyFrom,omitempty"`
+	// CopyFromRef is a reference to a QualityGate used to set CopyFrom.
+	// +kubebuilder:validation:Optional
+	CopyFromRef *xpv1.NamespacedReference `json:"copyFromRef,omitempty"`
+	// CopyFromSelector selects a reference to a QualityGate used to set CopyFrom.
+	// +kubebuilder:validation:Optional
+	CopyFromSelector *xpv1.NamespacedSelector `json:"copyFromSelector,omitempty"`
+	// Projects is the list of keys of the Projects associated with the Quality Gate.
+	// Projects associated with the Quality Gate but missing from this list are deselected from it.
+	// If not specified, the Projects associated with the Quality Gate are not managed, an empty list deselects all of them.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectRefs
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Projects []string `json:"projects"`
+	// ProjectRefs are references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectRefs []xpv1.NamespacedReference `json:"projectRefs,omitempty"`
+	// ProjectSelector selects references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectSelector *xpv1.NamespacedSelector `json:"projectSelector,omitempty"`
+	// DelegatedUsers is the list of logins of the users allowed to edit the Quality Gate without the 'Administer Quality Gates' permission.
+	// If not specified, the users allowed to edit the Quality Gate are not managed, an empty list revokes all of them.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	DelegatedUsers []string `json:"delegatedUsers"`
+	// DelegatedGroups is the list of names of the groups allowed to edit the Quality Gate without the 'Administer Quality Gates' permission.
+	// If not specified, the groups allowed to edit the Quality Gate are not managed, an empty list revokes all of them.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	DelegatedGroups []string `json:"delegatedGroups"`
+	// CaycPolicy defines how the controller reacts when the Quality Gate is not Clean as You Code compliant.
+	// Ignore does nothing, Warn emits an event and sets the CaycCompliant status condition,
+	// Enforce also refuses to apply conditions that would make the Quality Gate non-compliant.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Ignore;Warn;Enforce
+	// +kubebuilder:default=Ignore
+	CaycPolicy *string `json:"caycPolicy,omitempty"`
 }
 
+// Clean as You Code policies of a QualityGate.
+const (
+	CaycPolicyIgnore  = "Ignore"
+	CaycPolicyWarn    = "Warn"
+	CaycPolicyEnforce = "Enforce"
+)
+
+// Clean as You Code statuses of a QualityGate, as reported by SonarQube.
+const (
+	CaycStatusCompliant     = "compliant"
+	CaycStatusNonCompliant  = "non-compliant"
+	CaycStatusOverCompliant = "over-compliant"
+)
+
+// TypeCaycCompliant is the status condition type reporting whether a QualityGate is Clean as You Code compliant.
+const TypeCaycCompliant xpv1.ConditionType = "CaycCompliant"
+
+// Reasons of the CaycCompliant status condition of a QualityGate.
+const (
+	ReasonCaycCompliant     xpv1.ConditionReason = "Compliant"
+	ReasonCaycNonCompliant  xpv1.ConditionReason = "NonCompliant"
+	ReasonCaycOverCompliant xpv1.ConditionReason = "OverCompliant"
+)
+
 // QualityGateObservation are the observable fields of a QualityGate.
 type QualityGateObservation struct {
 	// Actions represents the actions that can be performed on the Quality Gate.
@@ -52,6 +125,12 @@ type QualityGateObservation struct {
 	CaycStatus string `json:"caycStatus"`
 	// Conditions represents the list of conditions associated with the Quality Gate.
 	Conditions []QualityGateConditionObservation `json:"conditions,omitempty"`
+	// ConditionsCopied indicates whether the conditions copied from CopyFrom have been merged into the conditions of the spec.
+	ConditionsCopied bool `json:"conditionsCopied,omitempty"`
+	// DelegatedGroups represents the names of the groups allowed to edit the Quality Gate.
+	DelegatedGroups []string `json:"delegatedGroups,omitempty"`
+	// DelegatedUsers represents the logins of the users allowed to edit the Quality Gate.
+	DelegatedUsers []string `json:"delegatedUsers,omitempty"`
 	// IsAiCodeSupported indicates whether AI Code Assurance is supported for the Quality Gate.
 	IsAiCodeSupported bool `json:"isAiCodeSupported"`
 	// IsBuiltIn indicates whether the Quality Gate is built-in.
@@ -60,6 +139,8 @@ type QualityGateObservation struct {
 	IsDefault bool `json:"isDefault"`
 	// Name represents the name of the Quality Gate.
 	Name string `json:"name"`
+	// Projects represents the keys of the Projects explicitly associated with the Quality Gate.
+	Projects []string `json:"projects,omitempty"`
 }
 
 // A QualityGateSpec defines the desired state of a QualityGate.
@@ -113,6 +194,7 @@ type QualityGateConditionParameters struct {
 	// Metric is the Condition metric that the condition applies to.
 	// Only accepts metrics of the following types: INT, MILLISEC, RATING, WORK_DUR, FLOAT, PERCENT, LEVEL.
 	// The following metrics are forbidden: alert_status, security_hotspots, new_security_hotspots.
+	// The metric, operator and error threshold are validated against the metrics catalogue of the SonarQube instance before being applied.
 	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9_]+$"
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
@@ -166,7 +248,7 @@ type QualityGateList struct {
 // QualityGate type metadata.
 var (
 	QualityGateKind             = reflect.TypeOf(QualityGate{}).Name()
-	QualityGateGroupKind        = schema.GroupKind{Group: Group, Kind: QualityGateKind}.String()
+	QualityGateGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: QualityGateKind}.String()
 	QualityGateKindAPIVersion   = QualityGateKind + "." + SchemeGroupVersion.String()
 	QualityGateGroupVersionKind = SchemeGroupVersion.WithKind(QualityGateKind)
 )
@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// QualityProfileParameters represent the desired state of a QualityProfile.
+type QualityProfileParameters struct {
+	// Name is the display name of the Quality Profile.
+	// Changing it renames the Quality Profile in SonarQube, which is refused for built-in Quality Profiles.
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Language is the key of the language of the Quality Profile, such as java or js.
+	// WARNING: This field is immutable once set, the language of a Quality Profile cannot be changed.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Language is immutable."
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Language string `json:"language"`
+	// Parent is the name of the Quality Profile this Quality Profile inherits its rules from.
+	// An empty value removes the inheritance, which deactivates the rules coming from the former parent that are not overridden.
+	// If not specified, the parent of the Quality Profile is not managed.
+	// +crossplane:generate:reference:type=QualityProfile
+	// +crossplane:generate:reference:extractor=QualityProfileName()
+	// +crossplane:generate:reference:refFieldName=ParentRef
+	// +crossplane:generate:reference:selectorFieldName=ParentSelector
+	// +kubebuilder:validation:Optional
+	Parent *string `json:"parent,omitempty"`
+	// ParentRef is a reference to a QualityProfile used to set Parent.
+	// +kubebuilder:validation:Optional
+	ParentRef *xpv1.NamespacedReference `json:"parentRef,omitempty"`
+	// ParentSelector selects a reference to a QualityProfile used to set Parent.
+	// +kubebuilder:validation:Optional
+	ParentSelector *xpv1.NamespacedSelector `json:"parentSelector,omitempty"`
+	// Default indicates whether this Quality Profile is the default one for its language.
+	// WARNING: It is not possible to unset the default Quality Profile in SonarQube. The only way to change the default Quality Profile is to set another Quality Profile as default.
+	// +kubebuilder:validation:Optional
+	Default *bool `json:"default,omitempty"`
+	// Projects is the list of keys of the Projects associated with the Quality Profile.
+	// Projects associated with the Quality Profile but missing from this list are dissociated from it.
+	// If not specified, the Projects associated with the Quality Profile are not managed, an empty list dissociates all of them.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectRefs
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Projects []string `json:"projects"`
+	// ProjectRefs are references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectRefs []xpv1.NamespacedReference `json:"projectRefs,omitempty"`
+	// ProjectSelector selects references to Projects used to set Projects.
+	// +kubebuilder:validation:Optional
+	ProjectSelector *xpv1.NamespacedSelector `json:"projectSelector,omitempty"`
+	// Rules is the list of rules activated in the Quality Profile, with their severity and parameter overrides.
+	// Rules activated directly in the Quality Profile but missing from this list are deactivated.
+	// Rules inherited from the parent Quality Profile cannot be deactivated, but their severity and parameters can be overridden by listing them.
+	// If not specified, the rules of the Quality Profile are not managed, an empty list deactivates all the rules activated directly in it.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=rule
+	Rules []QualityProfileRuleParameters `json:"rules"`
+}
+
+// QualityProfileRuleParameters are the configurable fields of a rule activated in a QualityProfile.
+type QualityProfileRuleParameters struct {
+	// Rule is the key of the rule, such as java:S1144.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Rule string `json:"rule"`
+	// Severity is the severity of the rule in the Quality Profile.
+	// If not specified, the severity is not managed and defaults to the one of the parent Quality Profile or of the rule.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=INFO;MINOR;MAJOR;CRITICAL;BLOCKER
+	Severity *string `json:"severity,omitempty"`
+	// Params are the parameter overrides of the rule in the Quality Profile, indexed by parameter key.
+	// Parameters missing from this map are not managed.
+	// +kubebuilder:validation:Optional
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// Inheritance of a rule activated in a QualityProfile, as reported by SonarQube.
+const (
+	QualityProfileRuleInheritanceNone      = "NONE"
+	QualityProfileRuleInheritanceInherited = "INHERITED"
+	QualityProfileRuleInheritanceOverrides = "OVERRIDES"
+)
+
+// QualityProfileObservation are the observable fields of a QualityProfile.
+type QualityProfileObservation struct {
+	// Actions represents the actions that can be performed on the Quality Profile.
+	Actions QualityProfileActions `json:"actions,omitempty"`
+	// ActiveRuleCount is the number of rules activated in the Quality Profile.
+	ActiveRuleCount int64 `json:"activeRuleCount,omitempty"`
+	// IsBuiltIn indicates whether the Quality Profile is built-in.
+	IsBuiltIn bool `json:"isBuiltIn"`
+	// IsDefault indicates whether the Quality Profile is the default one for its language.
+	IsDefault bool `json:"isDefault"`
+	// IsInherited indicates whether the Quality Profile inherits its rules from a parent Quality Profile.
+	IsInherited bool `json:"isInherited"`
+	// Key is the unique key of the Quality Profile.
+	Key string `json:"key"`
+	// Language is the key of the language of the Quality Profile.
+	Language string `json:"language"`
+	// LanguageName is the display name of the language of the Quality Profile.
+	LanguageName string `json:"languageName,omitempty"`
+	// Name represents the name of the Quality Profile.
+	Name string `json:"name"`
+	// ParentKey is the key of the parent Quality Profile.
+	ParentKey string `json:"parentKey,omitempty"`
+	// ParentName is the name of the parent Quality Profile.
+	ParentName string `json:"parentName,omitempty"`
+	// Projects represents the keys of the Projects explicitly associated with the Quality Profile.
+	Projects []string `json:"projects,omitempty"`
+	// Rules represents the rules activated in the Quality Profile.
+	Rules []QualityProfileRuleObservation `json:"rules,omitempty"`
+}
+
+// QualityProfileActions represents the actions that can be performed on a Quality Profile.
+type QualityProfileActions struct {
+	// AssociateProjects defines whether projects can be associated with the Quality Profile.
+	AssociateProjects bool `json:"associateProjects"`
+	// Copy defines whether the Quality Profile can be copied.
+	Copy bool `json:"copy"`
+	// Delete defines whether the Quality Profile can be deleted.
+	Delete bool `json:"delete"`
+	// Edit defines whether the Quality Profile can be edited.
+	Edit bool `json:"edit"`
+	// SetAsDefault defines whether the Quality Profile can be set as the default one.
+	SetAsDefault bool `json:"setAsDefault"`
+}
+
+// QualityProfileRuleObservation are the observable fields of a rule activated in a QualityProfile.
+type QualityProfileRuleObservation struct {
+	// Inherit is the inheritance of the rule activation: NONE, INHERITED or OVERRIDES.
+	Inherit string `json:"inherit,omitempty"`
+	// Params are the parameters of the rule in the Quality Profile, indexed by parameter key.
+	Params map[string]string `json:"params,omitempty"`
+	// Rule is the key of the rule.
+	Rule string `json:"rule"`
+	// Severity is the severity of the rule in the Quality Profile.
+	Severity string `json:"severity,omitempty"`
+}
+
+// A QualityProfileSpec defines the desired state of a QualityProfile.
+type QualityProfileSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Quality Profile.
+	ForProvider QualityProfileParameters `json:"forProvider"`
+}
+
+// A QualityProfileStatus represents the observed state of a QualityProfile.
+type QualityProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Quality Profile.
+	AtProvider QualityProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A QualityProfile is a set of rules applied to the analysis of the Projects of a language.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LANGUAGE",type="string",JSONPath=".spec.forProvider.language"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type QualityProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QualityProfileSpec   `json:"spec"`
+	Status QualityProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QualityProfileList contains a list of QualityProfile
+type QualityProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QualityProfile `json:"items"`
+}
+
+// QualityProfileName extracts the name of a referenced QualityProfile, which identifies it along with its language in SonarQube.
+func QualityProfileName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		qp, ok := mg.(*QualityProfile)
+		if !ok {
+			return ""
+		}
+		return qp.Spec.ForProvider.Name
+	}
+}
+
+// QualityProfile type metadata.
+var (
+	QualityProfileKind             = reflect.TypeOf(QualityProfile{}).Name()
+	QualityProfileGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: QualityProfileKind}.String()
+	QualityProfileKindAPIVersion   = QualityProfileKind + "." + SchemeGroupVersion.String()
+	QualityProfileGroupVersionKind = SchemeGroupVersion.WithKind(QualityProfileKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&QualityProfile{}, &QualityProfileList{})
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// QualityProfileComparisonParameters represent the Quality Profiles compared by a QualityProfileComparison.
+type QualityProfileComparisonParameters struct {
+	// LeftKey is the key of the Quality Profile compared on the left side, typically the baseline such as a built-in Quality Profile.
+	// +crossplane:generate:reference:type=QualityProfile
+	// +crossplane:generate:reference:refFieldName=LeftKeyRef
+	// +crossplane:generate:reference:selectorFieldName=LeftKeySelector
+	// +kubebuilder:validation:Optional
+	LeftKey *string `json:"leftKey,omitempty"`
+	// LeftKeyRef is a reference to a QualityProfile used to set LeftKey.
+	// +kubebuilder:validation:Optional
+	LeftKeyRef *xpv1.NamespacedReference `json:"leftKeyRef,omitempty"`
+	// LeftKeySelector selects a reference to a QualityProfile used to set LeftKey.
+	// +kubebuilder:validation:Optional
+	LeftKeySelector *xpv1.NamespacedSelector `json:"leftKeySelector,omitempty"`
+	// RightKey is the key of the Quality Profile compared on the right side, typically the one that may have drifted.
+	// +crossplane:generate:reference:type=QualityProfile
+	// +crossplane:generate:reference:refFieldName=RightKeyRef
+	// +crossplane:generate:reference:selectorFieldName=RightKeySelector
+	// +kubebuilder:validation:Optional
+	RightKey *string `json:"rightKey,omitempty"`
+	// RightKeyRef is a reference to a QualityProfile used to set RightKey.
+	// +kubebuilder:validation:Optional
+	RightKeyRef *xpv1.NamespacedReference `json:"rightKeyRef,omitempty"`
+	// RightKeySelector selects a reference to a QualityProfile used to set RightKey.
+	// +kubebuilder:validation:Optional
+	RightKeySelector *xpv1.NamespacedSelector `json:"rightKeySelector,omitempty"`
+}
+
+// QualityProfileComparisonObservation is the comparison of the rules activated in two Quality Profiles, re-evaluated on every poll.
+type QualityProfileComparisonObservation struct {
+	// Left is the Quality Profile compared on the left side.
+	Left QualityProfileComparisonProfile `json:"left,omitempty"`
+	// Right is the Quality Profile compared on the right side.
+	Right QualityProfileComparisonProfile `json:"right,omitempty"`
+	// InLeft represents the rules only activated in the left Quality Profile.
+	InLeft []QualityProfileComparisonRule `json:"inLeft,omitempty"`
+	// InLeftCount is the number of rules only activated in the left Quality Profile.
+	InLeftCount int `json:"inLeftCount"`
+	// InRight represents the rules only activated in the right Quality Profile.
+	InRight []QualityProfileComparisonRule `json:"inRight,omitempty"`
+	// InRightCount is the number of rules only activated in the right Quality Profile.
+	InRightCount int `json:"inRightCount"`
+	// Modified represents the rules activated in both Quality Profiles with a different severity or different parameters.
+	Modified []QualityProfileComparisonModifiedRule `json:"modified,omitempty"`
+	// ModifiedCount is the number of rules activated in both Quality Profiles with a different severity or different parameters.
+	ModifiedCount int `json:"modifiedCount"`
+	// SameCount is the number of rules activated identically in both Quality Profiles.
+	SameCount int `json:"sameCount"`
+}
+
+// TypeDrifted is the status condition type reporting whether the compared Quality Profiles differ.
+const TypeDrifted xpv1.ConditionType = "Drifted"
+
+// Reasons of the Drifted status condition of a QualityProfileComparison.
+const (
+	ReasonRulesDiffer    xpv1.ConditionReason = "RulesDiffer"
+	ReasonRulesIdentical xpv1.ConditionReason = "RulesIdentical"
+)
+
+// QualityProfileComparisonProfile identifies a compared Quality Profile.
+type QualityProfileComparisonProfile struct {
+	// Key is the unique key of the Quality Profile.
+	Key string `json:"key,omitempty"`
+	// Name is the name of the Quality Profile.
+	Name string `json:"name,omitempty"`
+}
+
+// QualityProfileComparisonRule is a rule activated in only one of the compared Quality Profiles.
+type QualityProfileComparisonRule struct {
+	// Key is the key of the rule.
+	Key string `json:"key"`
+	// Name is the name of the rule.
+	Name string `json:"name,omitempty"`
+}
+
+// QualityProfileComparisonModifiedRule is a rule activated differently in the compared Quality Profiles.
+type QualityProfileComparisonModifiedRule struct {
+	// Key is the key of the rule.
+	Key string `json:"key"`
+	// Name is the name of the rule.
+	Name string `json:"name,omitempty"`
+	// Left is the activation of the rule in the left Quality Profile.
+	Left QualityProfileComparisonActivation `json:"left,omitempty"`
+	// Right is the activation of the rule in the right Quality Profile.
+	Right QualityProfileComparisonActivation `json:"right,omitempty"`
+}
+
+// QualityProfileComparisonActivation is the activation of a rule in a compared Quality Profile.
+type QualityProfileComparisonActivation struct {
+	// Severity is the severity of the rule, only set when it differs between the Quality Profiles.
+	Severity string `json:"severity,omitempty"`
+	// Params are the parameters of the rule that differ between the Quality Profiles, indexed by parameter key.
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// A QualityProfileComparisonSpec defines the Quality Profiles compared by a QualityProfileComparison.
+type QualityProfileComparisonSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the Quality Profiles to compare.
+	ForProvider QualityProfileComparisonParameters `json:"forProvider"`
+}
+
+// A QualityProfileComparisonStatus represents the observed comparison of a QualityProfileComparison.
+type QualityProfileComparisonStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed comparison of the Quality Profiles.
+	AtProvider QualityProfileComparisonObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A QualityProfileComparison is an observe-only resource reporting how far a Quality Profile has drifted from another one.
+// Nothing is created, updated or deleted in SonarQube.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DRIFTED",type="string",JSONPath=".status.conditions[?(@.type=='Drifted')].status"
+// +kubebuilder:printcolumn:name="IN-LEFT",type="integer",JSONPath=".status.atProvider.inLeftCount"
+// +kubebuilder:printcolumn:name="IN-RIGHT",type="integer",JSONPath=".status.atProvider.inRightCount"
+// +kubebuilder:printcolumn:name="MODIFIED",type="integer",JSONPath=".status.atProvider.modifiedCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type QualityProfileComparison struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.leftKey) || has(self.forProvider.leftKeyRef) || has(self.forProvider.leftKeySelector)",message="spec.forProvider.leftKey is required"
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.rightKey) || has(self.forProvider.rightKeyRef) || has(self.forProvider.rightKeySelector)",message="spec.forProvider.rightKey is required"
+	Spec   QualityProfileComparisonSpec   `json:"spec"`
+	Status QualityProfileComparisonStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QualityProfileComparisonList contains a list of QualityProfileComparison
+type QualityProfileComparisonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QualityProfileComparison `json:"items"`
+}
+
+// QualityProfileComparison type metadata.
+var (
+	QualityProfileComparisonKind             = reflect.TypeOf(QualityProfileComparison{}).Name()
+	QualityProfileComparisonGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: QualityProfileComparisonKind}.String()
+	QualityProfileComparisonKindAPIVersion   = QualityProfileComparisonKind + "." + SchemeGroupVersion.String()
+	QualityProfileComparisonGroupVersionKind = SchemeGroupVersion.WithKind(QualityProfileComparisonKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&QualityProfileComparison{}, &QualityProfileComparisonList{})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// QualityProfileRestoreParameters represent the desired state of a QualityProfileRestore.
+// The name and the language of the Quality Profile are taken from the backup.
+type QualityProfileRestoreParameters struct {
+	// Backup is the Quality Profile backup in the XML format of api/qualityprofiles/backup.
+	// +kubebuilder:validation:Optional
+	Backup *string `json:"backup,omitempty"`
+	// BackupConfigMapRef references a key of a ConfigMap, in the namespace of the QualityProfileRestore, holding the Quality Profile backup.
+	// +kubebuilder:validation:Optional
+	BackupConfigMapRef *ConfigMapKeySelector `json:"backupConfigMapRef,omitempty"`
+	// Overwrite allows the backup to be restored over an existing Quality Profile with the same name and language,
+	// which is then managed, and deleted, by the QualityProfileRestore.
+	// Otherwise, the creation fails instead of overwriting a Quality Profile that the QualityProfileRestore did not create.
+	// +kubebuilder:validation:Optional
+	Overwrite *bool `json:"overwrite,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap in the namespace of the managed resource.
+type ConfigMapKeySelector struct {
+	// Name is the name of the ConfigMap.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Key is the key of the ConfigMap holding the value.
+	// +kubebuilder:default="backup.xml"
+	// +kubebuilder:validation:Optional
+	Key string `json:"key,omitempty"`
+}
+
+// QualityProfileRestoreObservation are the observable fields of a QualityProfileRestore.
+type QualityProfileRestoreObservation struct {
+	// ActiveRuleCount is the number of rules activated in the Quality Profile.
+	ActiveRuleCount int64 `json:"activeRuleCount"`
+	// IsBuiltIn indicates whether the Quality Profile is built-in.
+	IsBuiltIn bool `json:"isBuiltIn"`
+	// IsDefault indicates whether the Quality Profile is the default one for its language.
+	IsDefault bool `json:"isDefault"`
+	// Key is the unique key of the Quality Profile.
+	Key string `json:"key"`
+	// Language is the key of the language of the Quality Profile.
+	Language string `json:"language"`
+	// Name is the name of the Quality Profile.
+	Name string `json:"name"`
+	// MissingRules are the keys of the rules activated in the backup but not in the Quality Profile.
+	MissingRules []string `json:"missingRules,omitempty"`
+	// UnexpectedRules are the keys of the rules activated in the Quality Profile but not in the backup.
+	UnexpectedRules []string `json:"unexpectedRules,omitempty"`
+	// ModifiedRules are the keys of the rules activated in the Quality Profile with a different priority or different parameters than in the backup.
+	ModifiedRules []string `json:"modifiedRules,omitempty"`
+}
+
+// A QualityProfileRestoreSpec defines the desired state of a QualityProfileRestore.
+type QualityProfileRestoreSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the backup to restore.
+	ForProvider QualityProfileRestoreParameters `json:"forProvider"`
+}
+
+// A QualityProfileRestoreStatus represents the observed state of a QualityProfileRestore.
+type QualityProfileRestoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the restored Quality Profile.
+	AtProvider QualityProfileRestoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A QualityProfileRestore is a Quality Profile whose rules are restored from a SonarQube backup.
+// The backup is restored again whenever the rules activated in the Quality Profile drift from it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROFILE",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="LANGUAGE",type="string",JSONPath=".status.atProvider.language"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type QualityProfileRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="has(self.forProvider.backup) != has(self.forProvider.backupConfigMapRef)",message="exactly one of spec.forProvider.backup or spec.forProvider.backupConfigMapRef is required"
+	Spec   QualityProfileRestoreSpec   `json:"spec"`
+	Status QualityProfileRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QualityProfileRestoreList contains a list of QualityProfileRestore
+type QualityProfileRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QualityProfileRestore `json:"items"`
+}
+
+// QualityProfileRestore type metadata.
+var (
+	QualityProfileRestoreKind             = reflect.TypeOf(QualityProfileRestore{}).Name()
+	QualityProfileRestoreGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: QualityProfileRestoreKind}.String()
+	QualityProfileRestoreKindAPIVersion   = QualityProfileRestoreKind + "." + SchemeGroupVersion.String()
+	QualityProfileRestoreGroupVersionKind = SchemeGroupVersion.WithKind(QualityProfileRestoreKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&QualityProfileRestore{}, &QualityProfileRestoreList{})
+}
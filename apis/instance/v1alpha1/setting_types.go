@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// SettingParameters represent the desired state of a Setting.
+type SettingParameters struct {
+	// Component is the key of the Project the Setting applies to.
+	// If not specified, the Setting applies to the whole SonarQube instance.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ComponentRef
+	// +crossplane:generate:reference:selectorFieldName=ComponentSelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Component is immutable."
+	// +kubebuilder:validation:Optional
+	Component *string `json:"component,omitempty"`
+	// ComponentRef is a reference to a Project used to set Component.
+	// +kubebuilder:validation:Optional
+	ComponentRef *xpv1.NamespacedReference `json:"componentRef,omitempty"`
+	// ComponentSelector selects a reference to a Project used to set Component.
+	// +kubebuilder:validation:Optional
+	ComponentSelector *xpv1.NamespacedSelector `json:"componentSelector,omitempty"`
+	// Key is the key of the Setting, such as sonar.exclusions.
+	// Secured Settings, whose key ends with .secured, are not supported since
+	// SonarQube never returns their value.
+	// WARNING: This field is immutable once set.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Key is immutable."
+	// +kubebuilder:validation:XValidation:rule="!self.endsWith('.secured')",message="Secured Settings are not supported."
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// Value is the value of a single-valued Setting.
+	// +kubebuilder:validation:Optional
+	Value *string `json:"value,omitempty"`
+	// Values are the values of a multi-valued Setting, in order.
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Values []string `json:"values,omitempty"`
+	// FieldValues are the entries of a property set Setting, each one mapping the keys of its fields to their value.
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	FieldValues []map[string]string `json:"fieldValues,omitempty"`
+}
+
+// SettingObservation are the observable fields of a Setting.
+type SettingObservation struct {
+	// Value is the value of a single-valued Setting.
+	Value *string `json:"value,omitempty"`
+	// Values are the values of a multi-valued Setting.
+	Values []string `json:"values,omitempty"`
+	// FieldValues are the entries of a property set Setting.
+	FieldValues []map[string]string `json:"fieldValues,omitempty"`
+	// Inherited indicates whether the value is inherited from the global Setting or the default one, rather than overridden.
+	Inherited bool `json:"inherited"`
+	// ParentValue is the value the Setting inherits when it is not overridden.
+	ParentValue *string `json:"parentValue,omitempty"`
+	// ParentValues are the values the Setting inherits when it is not overridden.
+	ParentValues []string `json:"parentValues,omitempty"`
+	// ParentFieldValues are the entries the Setting inherits when it is not overridden.
+	ParentFieldValues []map[string]string `json:"parentFieldValues,omitempty"`
+}
+
+// A SettingSpec defines the desired state of a Setting.
+type SettingSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Setting.
+	ForProvider SettingParameters `json:"forProvider"`
+}
+
+// A SettingStatus represents the observed state of a Setting.
+type SettingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Setting.
+	AtProvider SettingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Setting overrides the value of a global or Project setting, such as the exclusions or the SCM settings of a Project.
+// If no value is specified, the current value of the Setting, inherited or default, is late-initialized and then overridden.
+// Deleting a Setting resets it, so that its value is inherited again.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="COMPONENT",type="string",JSONPath=".spec.forProvider.component"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="INHERITED",type="boolean",JSONPath=".status.atProvider.inherited"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type Setting struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="[has(self.forProvider.value), has(self.forProvider.values), has(self.forProvider.fieldValues)].filter(x, x).size() <= 1",message="at most one of spec.forProvider.value, spec.forProvider.values and spec.forProvider.fieldValues can be set"
+	Spec   SettingSpec   `json:"spec"`
+	Status SettingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SettingList contains a list of Setting
+type SettingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Setting `json:"items"`
+}
+
+// Setting type metadata.
+var (
+	SettingKind             = reflect.TypeOf(Setting{}).Name()
+	SettingGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SettingKind}.String()
+	SettingKindAPIVersion   = SettingKind + "." + SchemeGroupVersion.String()
+	SettingGroupVersionKind = SchemeGroupVersion.WithKind(SettingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Setting{}, &SettingList{})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// Keys of the connection secret of a User.
+const (
+	// UserConnectionSecretLoginKey is the key of the login of the User in its connection secret.
+	UserConnectionSecretLoginKey = "login"
+	// UserConnectionSecretPasswordKey is the key of the password of a local User in its connection secret.
+	UserConnectionSecretPasswordKey = "password"
+)
+
+// UserParameters represent the desired state of a User.
+type UserParameters struct {
+	// Login is the unique login of the User.
+	// Changing it updates the login of the User in SonarQube.
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:Required
+	Login string `json:"login"`
+	// Name is the display name of the User.
+	// +kubebuilder:validation:MaxLength=200
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Email is the email address of the User.
+	// If not specified, the email of the User is not managed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=100
+	Email *string `json:"email,omitempty"`
+	// Local defines whether the User authenticates against SonarQube (true) or an external authentication system (false).
+	// WARNING: This field is immutable once set, SonarQube cannot turn an external User into a local one.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Local is immutable."
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	Local *bool `json:"local,omitempty"`
+	// ScmAccounts is the list of SCM accounts of the User, used to attribute the issues to their author.
+	// If not specified, the SCM accounts of the User are not managed, an empty list clears all of them.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	ScmAccounts []string `json:"scmAccounts"`
+	// PasswordSecretRef references the key of a Secret, in the namespace of the User, holding the password of a local User.
+	// The password is checked on every poll and changed in SonarQube when it no longer matches.
+	// If not specified, a password is generated when creating a local User and published in its connection secret.
+	// +kubebuilder:validation:Optional
+	PasswordSecretRef *xpv1.LocalSecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// UserObservation are the observable fields of a User.
+type UserObservation struct {
+	// Active indicates whether the User is active.
+	Active bool `json:"active"`
+	// Email is the email address of the User.
+	Email string `json:"email,omitempty"`
+	// ExternalIdentity is the login of the User in the external authentication system.
+	ExternalIdentity string `json:"externalIdentity,omitempty"`
+	// ExternalProvider is the external authentication system of the User.
+	ExternalProvider string `json:"externalProvider,omitempty"`
+	// Groups represents the names of the groups the User belongs to.
+	Groups []string `json:"groups,omitempty"`
+	// LastConnectionDate is the date of the last connection of the User.
+	LastConnectionDate string `json:"lastConnectionDate,omitempty"`
+	// Local indicates whether the User authenticates against SonarQube.
+	Local bool `json:"local"`
+	// Login is the login of the User.
+	Login string `json:"login"`
+	// Managed indicates whether the User is managed by an external provisioning system.
+	Managed bool `json:"managed"`
+	// Name is the display name of the User.
+	Name string `json:"name"`
+	// PasswordUpToDate indicates whether the password of the User matches the one of the referenced Secret.
+	PasswordUpToDate *bool `json:"passwordUpToDate,omitempty"`
+	// ScmAccounts is the list of SCM accounts of the User.
+	ScmAccounts []string `json:"scmAccounts,omitempty"`
+	// TokensCount is the number of tokens of the User.
+	TokensCount int64 `json:"tokensCount"`
+}
+
+// A UserSpec defines the desired state of a User.
+type UserSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the User.
+	ForProvider UserParameters `json:"forProvider"`
+}
+
+// A UserStatus represents the observed state of a User.
+type UserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the User.
+	AtProvider UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A User is an account of the SonarQube instance, such as a service account for a CI bot.
+// Deleting a User deactivates it, SonarQube does not allow deleting Users.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCAL",type="boolean",JSONPath=".status.atProvider.local"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.passwordSecretRef) || !has(self.forProvider.local) || self.forProvider.local",message="spec.forProvider.passwordSecretRef can only be set for local Users"
+	Spec   UserSpec   `json:"spec"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of User
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+// User type metadata.
+var (
+	UserKind             = reflect.TypeOf(User{}).Name()
+	UserGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: UserKind}.String()
+	UserKindAPIVersion   = UserKind + "." + SchemeGroupVersion.String()
+	UserGroupVersionKind = SchemeGroupVersion.WithKind(UserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+}
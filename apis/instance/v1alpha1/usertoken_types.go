@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// Keys of the connection secret of a UserToken.
+const (
+	// UserTokenConnectionSecretTokenKey is the key of the token in the connection secret of a UserToken.
+	UserTokenConnectionSecretTokenKey = "token"
+	// UserTokenConnectionSecretLoginKey is the key of the login of the User owning the token in the connection secret of a UserToken.
+	UserTokenConnectionSecretLoginKey = "login"
+)
+
+// UserTokenParameters represent the desired state of a UserToken.
+type UserTokenParameters struct {
+	// Login is the login of the User owning the token.
+	// If not specified, the token is generated for the User authenticating the provider.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=User
+	// +crossplane:generate:reference:refFieldName=LoginRef
+	// +crossplane:generate:reference:selectorFieldName=LoginSelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Login is immutable."
+	// +kubebuilder:validation:Optional
+	Login *string `json:"login,omitempty"`
+	// LoginRef is a reference to a User used to set Login.
+	// +kubebuilder:validation:Optional
+	LoginRef *xpv1.NamespacedReference `json:"loginRef,omitempty"`
+	// LoginSelector selects a reference to a User used to set Login.
+	// +kubebuilder:validation:Optional
+	LoginSelector *xpv1.NamespacedSelector `json:"loginSelector,omitempty"`
+	// Name is the name of the token, unique for its User.
+	// WARNING: This field is immutable once set, SonarQube does not allow renaming tokens.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable."
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Type is the type of the token.
+	// WARNING: This field is immutable once set.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Type is immutable."
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=USER_TOKEN;GLOBAL_ANALYSIS_TOKEN;PROJECT_ANALYSIS_TOKEN
+	// +kubebuilder:default=USER_TOKEN
+	Type *string `json:"type,omitempty"`
+	// ProjectKey is the key of the only Project a PROJECT_ANALYSIS_TOKEN can analyze.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectKeyRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectKeySelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ProjectKey is immutable."
+	// +kubebuilder:validation:Optional
+	ProjectKey *string `json:"projectKey,omitempty"`
+	// ProjectKeyRef is a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeyRef *xpv1.NamespacedReference `json:"projectKeyRef,omitempty"`
+	// ProjectKeySelector selects a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeySelector *xpv1.NamespacedSelector `json:"projectKeySelector,omitempty"`
+	// ExpirationDate is the date the token expires on, in the YYYY-MM-DD format.
+	// Changing it generates a new token, as SonarQube does not allow updating tokens.
+	// If neither ExpirationDate nor ExpiresAfter is specified, the token does not expire unless the SonarQube instance enforces a maximum lifetime.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d{4}-\d{2}-\d{2}$`
+	ExpirationDate *string `json:"expirationDate,omitempty"`
+	// ExpiresAfter is the lifetime of the token, its expiration date being computed whenever it is generated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('24h')",message="ExpiresAfter must be at least 24h."
+	ExpiresAfter *metav1.Duration `json:"expiresAfter,omitempty"`
+	// RotateBefore is how long before its expiration date the token is replaced by a new one.
+	// If not specified, the token is only replaced once it has expired.
+	// +kubebuilder:validation:Optional
+	RotateBefore *metav1.Duration `json:"rotateBefore,omitempty"`
+}
+
+// UserTokenObservation are the observable fields of a UserToken.
+type UserTokenObservation struct {
+	// CreatedAt is the date the token was generated.
+	CreatedAt string `json:"createdAt,omitempty"`
+	// ExpirationDate is the date the token expires.
+	ExpirationDate string `json:"expirationDate,omitempty"`
+	// IsExpired indicates whether the token has expired.
+	IsExpired bool `json:"isExpired"`
+	// Login is the login of the User owning the token.
+	Login string `json:"login,omitempty"`
+	// Name is the name of the token.
+	Name string `json:"name"`
+	// ProjectKey is the key of the Project a PROJECT_ANALYSIS_TOKEN can analyze.
+	ProjectKey string `json:"projectKey,omitempty"`
+	// Type is the type of the token.
+	Type string `json:"type,omitempty"`
+}
+
+// A UserTokenSpec defines the desired state of a UserToken.
+type UserTokenSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the UserToken.
+	ForProvider UserTokenParameters `json:"forProvider"`
+}
+
+// A UserTokenStatus represents the observed state of a UserToken.
+type UserTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the UserToken.
+	AtProvider UserTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserToken is an access token of a User, such as an analysis token of a CI pipeline.
+// The token is only known when it is generated, and published in the connection secret of the UserToken.
+// Deleting a UserToken revokes the token.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="EXPIRATION",type="string",JSONPath=".status.atProvider.expirationDate"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type UserToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.type) || self.forProvider.type != 'PROJECT_ANALYSIS_TOKEN' || has(self.forProvider.projectKey) || has(self.forProvider.projectKeyRef) || has(self.forProvider.projectKeySelector)",message="spec.forProvider.projectKey is required for PROJECT_ANALYSIS_TOKEN tokens"
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.projectKey) || (has(self.forProvider.type) && self.forProvider.type == 'PROJECT_ANALYSIS_TOKEN')",message="spec.forProvider.projectKey can only be set for PROJECT_ANALYSIS_TOKEN tokens"
+	// +kubebuilder:validation:XValidation:rule="!(has(self.forProvider.expirationDate) && has(self.forProvider.expiresAfter))",message="spec.forProvider.expirationDate and spec.forProvider.expiresAfter are mutually exclusive"
+	// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.rotateBefore) || (has(self.forProvider.expiresAfter) && duration(self.forProvider.rotateBefore) < duration(self.forProvider.expiresAfter))",message="spec.forProvider.rotateBefore requires spec.forProvider.expiresAfter to be longer"
+	Spec   UserTokenSpec   `json:"spec"`
+	Status UserTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserTokenList contains a list of UserToken
+type UserTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserToken `json:"items"`
+}
+
+// UserToken type metadata.
+var (
+	UserTokenKind             = reflect.TypeOf(UserToken{}).Name()
+	UserTokenGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: UserTokenKind}.String()
+	UserTokenKindAPIVersion   = UserTokenKind + "." + SchemeGroupVersion.String()
+	UserTokenGroupVersionKind = SchemeGroupVersion.WithKind(UserTokenKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&UserToken{}, &UserTokenList{})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// WebhookParameters represent the desired state of a Webhook.
+type WebhookParameters struct {
+	// Name is the name of the Webhook displayed in the administration console.
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// URL is the server endpoint that receives the payload of the Webhook.
+	// If HTTP Basic authentication is used, HTTPS is recommended to avoid man in the middle attacks.
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+	// ProjectKey is the key of the Project owning the Webhook.
+	// If not specified, the Webhook is global and triggered for every Project.
+	// WARNING: This field is immutable once set.
+	// +crossplane:generate:reference:type=Project
+	// +crossplane:generate:reference:refFieldName=ProjectKeyRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectKeySelector
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ProjectKey is immutable."
+	// +kubebuilder:validation:Optional
+	ProjectKey *string `json:"projectKey,omitempty"`
+	// ProjectKeyRef is a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeyRef *xpv1.NamespacedReference `json:"projectKeyRef,omitempty"`
+	// ProjectKeySelector selects a reference to a Project used to set ProjectKey.
+	// +kubebuilder:validation:Optional
+	ProjectKeySelector *xpv1.NamespacedSelector `json:"projectKeySelector,omitempty"`
+	// SecretRef references the key of a Secret, in the namespace of the Webhook, holding the secret used as the key
+	// of the HMAC hex digest sent in the 'X-Sonar-Webhook-HMAC-SHA256' header.
+	// The secret is checked on every poll and changed in SonarQube when the one of the Secret changes.
+	// If not specified, the Webhook has no secret.
+	// +kubebuilder:validation:Optional
+	SecretRef *xpv1.LocalSecretKeySelector `json:"secretRef,omitempty"`
+}
+
+// WebhookDeliveryObservation is a delivery of a Webhook.
+type WebhookDeliveryObservation struct {
+	// ID is the ID of the delivery.
+	ID string `json:"id"`
+	// At is the date of the delivery.
+	At string `json:"at,omitempty"`
+	// CeTaskID is the ID of the Compute Engine task that triggered the delivery.
+	CeTaskID string `json:"ceTaskId,omitempty"`
+	// ComponentKey is the key of the Project the delivery is about.
+	ComponentKey string `json:"componentKey,omitempty"`
+	// DurationMs is the duration of the delivery, in milliseconds.
+	DurationMs int64 `json:"durationMs,omitempty"`
+	// HTTPStatus is the HTTP status returned by the URL of the Webhook.
+	HTTPStatus int64 `json:"httpStatus,omitempty"`
+	// Success indicates whether the delivery succeeded.
+	Success bool `json:"success"`
+}
+
+// WebhookObservation are the observable fields of a Webhook.
+type WebhookObservation struct {
+	// Key is the key of the Webhook, generated by SonarQube.
+	Key string `json:"key,omitempty"`
+	// Name is the name of the Webhook.
+	Name string `json:"name,omitempty"`
+	// URL is the URL of the Webhook.
+	URL string `json:"url,omitempty"`
+	// ProjectKey is the key of the Project owning the Webhook, empty for a global Webhook.
+	ProjectKey string `json:"projectKey,omitempty"`
+	// HasSecret indicates whether the Webhook has a secret.
+	HasSecret bool `json:"hasSecret"`
+	// SecretVersion is the UID and resource version of the referenced Secret whose secret was last set by the provider, used to detect a change of the Secret.
+	SecretVersion string `json:"secretVersion,omitempty"`
+	// LastDelivery is the latest delivery of the Webhook.
+	LastDelivery *WebhookDeliveryObservation `json:"lastDelivery,omitempty"`
+}
+
+// A WebhookSpec defines the desired state of a Webhook.
+type WebhookSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	// ForProvider represents the desired state of the Webhook.
+	ForProvider WebhookParameters `json:"forProvider"`
+}
+
+// A WebhookStatus represents the observed state of a Webhook.
+type WebhookStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	// AtProvider represents the observed state of the Webhook.
+	AtProvider WebhookObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Webhook notifies an external service when the analysis of a Project completes.
+// It is either global or owned by a single Project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.url"
+// +kubebuilder:printcolumn:name="LAST-DELIVERY",type="boolean",JSONPath=".status.atProvider.lastDelivery.success"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,sonarqube}
+type Webhook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebhookSpec   `json:"spec"`
+	Status WebhookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebhookList contains a list of Webhook
+type WebhookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Webhook `json:"items"`
+}
+
+// Webhook type metadata.
+var (
+	WebhookKind             = reflect.TypeOf(Webhook{}).Name()
+	WebhookGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: WebhookKind}.String()
+	WebhookKindAPIVersion   = WebhookKind + "." + SchemeGroupVersion.String()
+	WebhookGroupVersionKind = SchemeGroupVersion.WithKind(WebhookKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Webhook{}, &WebhookList{})
+}
@@ -9,6 +9,38 @@ import (
 // A ProviderConfigStatus defines the status of a Provider.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// LastTokenRotationTime is the last time the token of the Provider was rotated.
+	LastTokenRotationTime *metav1.Time `json:"lastTokenRotationTime,omitempty"`
+	// TokenName is the name of the token generated by the last rotation, revoked by the next one.
+	TokenName string `json:"tokenName,omitempty"`
+	// PendingRevocationTokenName is the name of the token replaced by a rotation that could not be revoked yet.
+	// It is revoked before the token is rotated again.
+	PendingRevocationTokenName string `json:"pendingRevocationTokenName,omitempty"`
+}
+
+// TokenRotation configures the periodic rotation of the token of a Provider.
+// +kubebuilder:validation:XValidation:rule="!has(self.expiresAfter) || duration(self.expiresAfter) > duration(self.interval)",message="expiresAfter must be longer than interval"
+type TokenRotation struct {
+	// Interval between two rotations of the token.
+	// The first rotation happens as soon as the rotation is enabled.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1h')",message="Interval must be at least 1h."
+	Interval metav1.Duration `json:"interval"`
+	// TokenNamePrefix is the prefix of the name of the generated tokens, suffixed with their generation time.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=80
+	// +kubebuilder:default="crossplane-provider-sonarqube"
+	TokenNamePrefix *string `json:"tokenNamePrefix,omitempty"`
+	// ExpiresAfter is the lifetime of the generated tokens, rounded up to the next day.
+	// If not specified, the generated tokens do not expire, or get the maximum lifetime allowed by the SonarQube instance.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('24h')",message="ExpiresAfter must be at least 24h."
+	ExpiresAfter *metav1.Duration `json:"expiresAfter,omitempty"`
+	// InitialTokenName is the name of the token initially stored in the Secret, revoked by the first rotation.
+	// If not specified, the initial token is left untouched and must be revoked manually.
+	// +kubebuilder:validation:Optional
+	InitialTokenName *string `json:"initialTokenName,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -20,6 +52,7 @@ type ProviderCredentials struct {
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.tokenRotation) || has(self.token)",message="tokenRotation requires token"
 type ProviderConfigSpec struct {
 	// BaseURL of the SonarQube instance.
 	// +kubebuilder:validation:Required
@@ -39,6 +72,11 @@ type ProviderConfigSpec struct {
 	// Password is the password for Basic Authentication to the SonarQube instance.
 	// +kubebuilder:validation:Optional
 	Password *ProviderCredentials `json:"password,omitempty"`
+
+	// TokenRotation periodically replaces the Token by a freshly generated one, written back to its Secret.
+	// The previous token is revoked once the new one has been stored.
+	// +kubebuilder:validation:Optional
+	TokenRotation *TokenRotation `json:"tokenRotation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backup parses and canonicalizes the SonarQube Quality Profile backup XML format,
+// as produced by api/qualityprofiles/backup and consumed by api/qualityprofiles/restore.
+package backup
+
+import (
+	"bytes"
+	"encoding/xml"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errParseBackup       = "cannot parse Quality Profile backup XML"
+	errMarshalBackup     = "cannot marshal Quality Profile backup XML"
+	errMissingName       = "Quality Profile backup XML has no profile name"
+	errMissingLanguage   = "Quality Profile backup XML has no profile language"
+	errMissingRuleKey    = "Quality Profile backup XML has a rule without repositoryKey or key"
+	errDuplicateRuleKeys = "Quality Profile backup XML activates rule %s more than once"
+)
+
+// Profile is a Quality Profile backup
+// Only the elements relevant to the activated rules are kept, the others are ignored when parsing
+type Profile struct {
+	XMLName  xml.Name `xml:"profile"`
+	Name     string   `xml:"name"`
+	Language string   `xml:"language"`
+	Rules    []Rule   `xml:"rules>rule"`
+}
+
+// Rule is a rule activated in a Quality Profile backup
+type Rule struct {
+	RepositoryKey string      `xml:"repositoryKey"`
+	Key           string      `xml:"key"`
+	Priority      string      `xml:"priority,omitempty"`
+	Parameters    []Parameter `xml:"parameters>parameter,omitempty"`
+}
+
+// Parameter is a parameter of a rule activated in a Quality Profile backup
+type Parameter struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+// RuleKey returns the key of the rule as used by the SonarQube API, such as java:S1144
+func (r Rule) RuleKey() string {
+	return r.RepositoryKey + ":" + r.Key
+}
+
+// Parse parses a Quality Profile backup XML and canonicalizes it
+func Parse(data string) (*Profile, error) {
+	profile := &Profile{}
+	if err := xml.Unmarshal([]byte(data), profile); err != nil {
+		return nil, errors.Wrap(err, errParseBackup)
+	}
+	Canonicalize(profile)
+
+	if profile.Name == "" {
+		return nil, errors.New(errMissingName)
+	}
+	if profile.Language == "" {
+		return nil, errors.New(errMissingLanguage)
+	}
+	for i, rule := range profile.Rules {
+		if rule.RepositoryKey == "" || rule.Key == "" {
+			return nil, errors.New(errMissingRuleKey)
+		}
+		if i > 0 && profile.Rules[i-1].RuleKey() == rule.RuleKey() {
+			return nil, errors.Errorf(errDuplicateRuleKeys, rule.RuleKey())
+		}
+	}
+	return profile, nil
+}
+
+// Canonicalize trims the whitespace around every value and sorts the rules and their parameters by key,
+// so that two backups activating the same rules identically are equal regardless of their formatting
+func Canonicalize(profile *Profile) {
+	if profile == nil {
+		return
+	}
+	profile.Name = strings.TrimSpace(profile.Name)
+	profile.Language = strings.TrimSpace(profile.Language)
+	for i := range profile.Rules {
+		rule := &profile.Rules[i]
+		rule.RepositoryKey = strings.TrimSpace(rule.RepositoryKey)
+		rule.Key = strings.TrimSpace(rule.Key)
+		rule.Priority = strings.TrimSpace(rule.Priority)
+		for j := range rule.Parameters {
+			rule.Parameters[j].Key = strings.TrimSpace(rule.Parameters[j].Key)
+			rule.Parameters[j].Value = strings.TrimSpace(rule.Parameters[j].Value)
+		}
+		slices.SortFunc(rule.Parameters, func(a, b Parameter) int {
+			return strings.Compare(a.Key, b.Key)
+		})
+		if len(rule.Parameters) == 0 {
+			rule.Parameters = nil
+		}
+	}
+	slices.SortFunc(profile.Rules, func(a, b Rule) int {
+		return strings.Compare(a.RuleKey(), b.RuleKey())
+	})
+	if len(profile.Rules) == 0 {
+		profile.Rules = nil
+	}
+}
+
+// Marshal marshals a canonicalized Quality Profile backup to XML
+func Marshal(profile *Profile) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(profile); err != nil {
+		return "", errors.Wrap(err, errMarshalBackup)
+	}
+	buf.WriteString("\n")
+	return buf.String(), nil
+}
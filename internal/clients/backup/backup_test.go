@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const curatedBackup = `<?xml version='1.0' encoding='UTF-8'?>
+<profile>
+  <name>
+    Company way
+  </name>
+  <language>java</language>
+  <rules>
+    <rule>
+      <repositoryKey>java</repositoryKey>
+      <key>S107</key>
+      <type>CODE_SMELL</type>
+      <priority>CRITICAL</priority>
+      <parameters>
+        <parameter>
+          <key>max</key>
+          <value> 5 </value>
+        </parameter>
+        <parameter>
+          <key>constructorMax</key>
+          <value>7</value>
+        </parameter>
+      </parameters>
+    </rule>
+    <rule>
+      <repositoryKey>java</repositoryKey>
+      <key>S1144</key>
+      <priority>MAJOR</priority>
+      <parameters/>
+    </rule>
+  </rules>
+</profile>`
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		want    *Profile
+		wantErr bool
+	}{
+		"CuratedBackupIsCanonicalized": {
+			data: curatedBackup,
+			want: &Profile{
+				Name:     "Company way",
+				Language: "java",
+				Rules: []Rule{
+					{RepositoryKey: "java", Key: "S107", Priority: "CRITICAL", Parameters: []Parameter{{Key: "constructorMax", Value: "7"}, {Key: "max", Value: "5"}}},
+					{RepositoryKey: "java", Key: "S1144", Priority: "MAJOR"},
+				},
+			},
+		},
+		"NoRules": {
+			data: `<profile><name>Empty</name><language>js</language><rules/></profile>`,
+			want: &Profile{Name: "Empty", Language: "js"},
+		},
+		"InvalidXML": {
+			data:    `<profile><name>Broken</name>`,
+			wantErr: true,
+		},
+		"MissingName": {
+			data:    `<profile><language>java</language></profile>`,
+			wantErr: true,
+		},
+		"MissingLanguage": {
+			data:    `<profile><name>Company way</name></profile>`,
+			wantErr: true,
+		},
+		"MissingRuleKey": {
+			data:    `<profile><name>Company way</name><language>java</language><rules><rule><key>S107</key></rule></rules></profile>`,
+			wantErr: true,
+		},
+		"DuplicateRule": {
+			data:    `<profile><name>Company way</name><language>java</language><rules><rule><repositoryKey>java</repositoryKey><key>S107</key></rule><rule><repositoryKey>java</repositoryKey><key>S107</key></rule></rules></profile>`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tc.data)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.FilterPath(func(p cmp.Path) bool { return p.Last().String() == ".XMLName" }, cmp.Ignore())); diff != "" {
+				t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeIgnoresOrderingAndWhitespace(t *testing.T) {
+	reordered := `<profile>
+<name>Company way</name><language>java</language>
+<rules>
+<rule><repositoryKey>java</repositoryKey><key>S1144</key><priority>MAJOR</priority></rule>
+<rule><repositoryKey>java</repositoryKey><key>S107</key><priority>CRITICAL</priority>
+<parameters><parameter><key>constructorMax</key><value>7</value></parameter><parameter><key>max</key><value>5</value></parameter></parameters>
+</rule>
+</rules>
+</profile>`
+
+	curated, err := Parse(curatedBackup)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	other, err := Parse(reordered)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	curatedXML, err := Marshal(curated)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	otherXML, err := Marshal(other)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if diff := cmp.Diff(curatedXML, otherXML); diff != "" {
+		t.Errorf("Marshal() canonical XML mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	profile, err := Parse(curatedBackup)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	data, err := Marshal(profile)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	got, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() of the marshalled backup error = %v", err)
+	}
+	if diff := cmp.Diff(profile, got); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRuleKey(t *testing.T) {
+	if got := (Rule{RepositoryKey: "java", Key: "S1144"}).RuleKey(); got != "java:S1144" {
+		t.Errorf("RuleKey() = %q, want %q", got, "java:S1144")
+	}
+}
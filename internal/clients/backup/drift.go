@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"slices"
+)
+
+// Drift is the difference between the rules activated in a desired Quality Profile backup and in the observed one
+type Drift struct {
+	// MissingRules are the keys of the rules activated in the desired backup but not in the observed one
+	MissingRules []string
+	// UnexpectedRules are the keys of the rules activated in the observed backup but not in the desired one
+	UnexpectedRules []string
+	// ModifiedRules are the keys of the rules activated in both backups with a different priority or different parameters
+	ModifiedRules []string
+}
+
+// IsEmpty returns true if the observed backup activates the desired rules identically
+func (d Drift) IsEmpty() bool {
+	return len(d.MissingRules) == 0 && len(d.UnexpectedRules) == 0 && len(d.ModifiedRules) == 0
+}
+
+// Diff compares the rules of two canonicalized Quality Profile backups
+// The priority and the parameters of a rule are only compared when they are set in the desired backup,
+// as SonarQube exports every parameter of an activated rule, including those left to their default value
+func Diff(desired, observed *Profile) Drift {
+	drift := Drift{}
+	observedRules := map[string]Rule{}
+	if observed != nil {
+		for _, rule := range observed.Rules {
+			observedRules[rule.RuleKey()] = rule
+		}
+	}
+
+	if desired != nil {
+		for _, rule := range desired.Rules {
+			key := rule.RuleKey()
+			observedRule, found := observedRules[key]
+			delete(observedRules, key)
+			switch {
+			case !found:
+				drift.MissingRules = append(drift.MissingRules, key)
+			case !isRuleUpToDate(rule, observedRule):
+				drift.ModifiedRules = append(drift.ModifiedRules, key)
+			}
+		}
+	}
+
+	for key := range observedRules {
+		drift.UnexpectedRules = append(drift.UnexpectedRules, key)
+	}
+	slices.Sort(drift.UnexpectedRules)
+
+	return drift
+}
+
+// isRuleUpToDate checks whether the observed activation of a rule matches the desired one
+func isRuleUpToDate(desired, observed Rule) bool {
+	if desired.Priority != "" && desired.Priority != observed.Priority {
+		return false
+	}
+	for _, param := range desired.Parameters {
+		index := slices.IndexFunc(observed.Parameters, func(p Parameter) bool { return p.Key == param.Key })
+		if index < 0 || observed.Parameters[index].Value != param.Value {
+			return false
+		}
+	}
+	return true
+}
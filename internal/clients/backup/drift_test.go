@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	desired := &Profile{
+		Name:     "Company way",
+		Language: "java",
+		Rules: []Rule{
+			{RepositoryKey: "java", Key: "S107", Priority: "CRITICAL", Parameters: []Parameter{{Key: "max", Value: "5"}}},
+			{RepositoryKey: "java", Key: "S1144", Priority: "MAJOR"},
+		},
+	}
+
+	tests := map[string]struct {
+		desired  *Profile
+		observed *Profile
+		want     Drift
+	}{
+		"Identical": {
+			desired:  desired,
+			observed: desired,
+			want:     Drift{},
+		},
+		"DefaultParametersAreIgnored": {
+			desired: desired,
+			observed: &Profile{Rules: []Rule{
+				{RepositoryKey: "java", Key: "S107", Priority: "CRITICAL", Parameters: []Parameter{{Key: "constructorMax", Value: "7"}, {Key: "max", Value: "5"}}},
+				{RepositoryKey: "java", Key: "S1144", Priority: "MAJOR"},
+			}},
+			want: Drift{},
+		},
+		"UnsetPriorityIsIgnored": {
+			desired:  &Profile{Rules: []Rule{{RepositoryKey: "java", Key: "S1144"}}},
+			observed: &Profile{Rules: []Rule{{RepositoryKey: "java", Key: "S1144", Priority: "MAJOR"}}},
+			want:     Drift{},
+		},
+		"Drifted": {
+			desired: desired,
+			observed: &Profile{Rules: []Rule{
+				{RepositoryKey: "java", Key: "S107", Priority: "CRITICAL", Parameters: []Parameter{{Key: "max", Value: "7"}}},
+				{RepositoryKey: "java", Key: "S2095", Priority: "BLOCKER"},
+				{RepositoryKey: "java", Key: "S100", Priority: "MINOR"},
+			}},
+			want: Drift{
+				MissingRules:    []string{"java:S1144"},
+				UnexpectedRules: []string{"java:S100", "java:S2095"},
+				ModifiedRules:   []string{"java:S107"},
+			},
+		},
+		"PriorityChanged": {
+			desired:  desired,
+			observed: &Profile{Rules: []Rule{{RepositoryKey: "java", Key: "S107", Priority: "CRITICAL", Parameters: []Parameter{{Key: "max", Value: "5"}}}, {RepositoryKey: "java", Key: "S1144", Priority: "MINOR"}}},
+			want:     Drift{ModifiedRules: []string{"java:S1144"}},
+		},
+		"NilObserved": {
+			desired:  desired,
+			observed: nil,
+			want:     Drift{MissingRules: []string{"java:S107", "java:S1144"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Diff(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Diff() mismatch (-want +got):\n%s", diff)
+			}
+			if got.IsEmpty() != (len(tc.want.MissingRules)+len(tc.want.UnexpectedRules)+len(tc.want.ModifiedRules) == 0) {
+				t.Errorf("IsEmpty() = %v for %+v", got.IsEmpty(), got)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// TTLCache caches a value for each ProviderConfig, keyed by its ProviderConfigKey, until it expires
+// It is used for data of a SonarQube instance that rarely changes, so that it is not retrieved on every observation.
+// It is safe for concurrent use.
+type TTLCache[T any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]ttlCacheEntry[T]
+}
+
+// ttlCacheEntry is a cached value along with the time it was retrieved
+type ttlCacheEntry[T any] struct {
+	value     T
+	fetchedAt time.Time
+}
+
+// NewTTLCache creates a new TTLCache whose entries expire after the given ttl
+func NewTTLCache[T any](ttl time.Duration) *TTLCache[T] {
+	return &TTLCache[T]{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]ttlCacheEntry[T]),
+	}
+}
+
+// Get returns the cached value of the given ProviderConfig key, if it has not expired
+func (c *TTLCache[T]) Get(key string) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || c.now().Sub(entry.fetchedAt) > c.ttl {
+		var zero T
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Set caches the value of the given ProviderConfig key
+func (c *TTLCache[T]) Set(key string, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = ttlCacheEntry[T]{value: value, fetchedAt: c.now()}
+}
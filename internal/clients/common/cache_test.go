@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTTLCache(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewTTLCache[map[string]string](10 * time.Minute)
+	cache.now = func() time.Time { return now }
+
+	if _, ok := cache.Get("ProviderConfig/default/example"); ok {
+		t.Errorf("Get() on an empty cache returned a value")
+	}
+
+	value := map[string]string{"coverage": "PERCENT"}
+	cache.Set("ProviderConfig/default/example", value)
+
+	got, ok := cache.Get("ProviderConfig/default/example")
+	if !ok {
+		t.Fatalf("Get() did not return the cached value")
+	}
+	if diff := cmp.Diff(value, got); diff != "" {
+		t.Errorf("Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, ok := cache.Get("ProviderConfig/other/example"); ok {
+		t.Errorf("Get() returned the value of another ProviderConfig")
+	}
+
+	now = now.Add(11 * time.Minute)
+	if _, ok := cache.Get("ProviderConfig/default/example"); ok {
+		t.Errorf("Get() returned an expired value")
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/pkg/errors"
+)
+
+// conflictMessages are the lowercased message fragments SonarQube uses when it rejects a request
+// because the target resource already exists. SonarQube reports these as 400 Bad Request rather than 409 Conflict.
+var conflictMessages = []string{
+	"already exists",
+	"already been taken",
+}
+
+// APIError is a classified error returned by the SonarQube API.
+// It carries the HTTP status code and the messages SonarQube returned in its
+// {"errors":[{"msg":"..."}]} body so callers can tell a missing resource apart
+// from transport, authentication or server failures.
+type APIError struct {
+	// StatusCode is the HTTP status code returned by SonarQube.
+	// It is 0 when the request never produced a response (DNS failure, connection refused, timeout...).
+	StatusCode int
+	// Messages are the error messages extracted from the SonarQube error body.
+	Messages []string
+	// Err is the underlying error returned by the SonarQube client.
+	Err error
+}
+
+// sonarErrorBody is the JSON body SonarQube returns alongside error status codes.
+type sonarErrorBody struct {
+	Errors []struct {
+		Msg string `json:"msg"`
+	} `json:"errors"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	if len(e.Messages) > 0 {
+		return fmt.Sprintf("SonarQube API returned status %d: %s", e.StatusCode, strings.Join(e.Messages, "; "))
+	}
+	return fmt.Sprintf("SonarQube API returned status %d", e.StatusCode)
+}
+
+// Unwrap returns the underlying error.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// NewAPIError classifies the error returned by a SonarQube client call.
+// The status code and error messages are taken from the sonargo.ErrorResponse when available,
+// falling back to the provided *http.Response otherwise.
+// It returns nil if err is nil.
+func NewAPIError(resp *http.Response, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Do not classify the same error twice
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return err
+	}
+
+	apiErr = &APIError{Err: err}
+
+	var body []byte
+	var sonarErr *sonargo.ErrorResponse
+	if errors.As(err, &sonarErr) {
+		body = sonarErr.Body
+		if sonarErr.Response != nil {
+			resp = sonarErr.Response
+		}
+	}
+
+	if resp != nil {
+		apiErr.StatusCode = resp.StatusCode
+		// The sonargo client consumes the body of error responses, only read it when it was not captured
+		if body == nil && resp.Body != nil {
+			body, _ = io.ReadAll(resp.Body)
+		}
+	}
+
+	apiErr.Messages = parseErrorMessages(body)
+
+	return apiErr
+}
+
+// parseErrorMessages extracts the error messages from a SonarQube error body.
+// It returns nil if the body is empty or not a SonarQube error body.
+func parseErrorMessages(body []byte) []string {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var parsed sonarErrorBody
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil
+	}
+
+	messages := make([]string, 0, len(parsed.Errors))
+	for _, e := range parsed.Errors {
+		if e.Msg != "" {
+			messages = append(messages, e.Msg)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages
+}
+
+// asAPIError returns the APIError contained in err, classifying it if needed.
+// It returns nil if err is nil.
+func asAPIError(err error) *APIError {
+	if err == nil {
+		return nil
+	}
+	var apiErr *APIError
+	if errors.As(NewAPIError(nil, err), &apiErr) {
+		return apiErr
+	}
+	return nil
+}
+
+// IsNotFound returns true if the error indicates that the SonarQube resource does not exist.
+func IsNotFound(err error) bool {
+	apiErr := asAPIError(err)
+	return apiErr != nil && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsUnauthorized returns true if the error indicates that the credentials were rejected
+// or lack the permissions required to perform the request.
+func IsUnauthorized(err error) bool {
+	apiErr := asAPIError(err)
+	return apiErr != nil && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden)
+}
+
+// IsConflict returns true if the error indicates that the SonarQube resource already exists.
+func IsConflict(err error) bool {
+	apiErr := asAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusBadRequest:
+		for _, msg := range apiErr.Messages {
+			lowered := strings.ToLower(msg)
+			for _, fragment := range conflictMessages {
+				if strings.Contains(lowered, fragment) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// IsRetryable returns true if the error is transient and the request may succeed if retried.
+// This covers transport failures that never produced a response, rate limiting and server-side errors.
+func IsRetryable(err error) bool {
+	apiErr := asAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case 0, http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func newSonarErrorResponse(statusCode int, body string) error {
+	return &sonargo.ErrorResponse{
+		Body: []byte(body),
+		Response: &http.Response{
+			StatusCode: statusCode,
+			Request: &http.Request{
+				Method: http.MethodGet,
+				URL:    &url.URL{Scheme: "https", Host: "sonarqube.example.com", Path: "/api/qualitygates/show"},
+			},
+		},
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := map[string]struct {
+		resp *http.Response
+		err  error
+		want *APIError
+	}{
+		"NilError": {
+			resp: &http.Response{StatusCode: http.StatusNotFound},
+			err:  nil,
+			want: nil,
+		},
+		"TransportError": {
+			resp: nil,
+			err:  errors.New("dial tcp: lookup sonarqube: no such host"),
+			want: &APIError{StatusCode: 0},
+		},
+		"StatusFromResponse": {
+			resp: &http.Response{StatusCode: http.StatusServiceUnavailable},
+			err:  errors.New("unavailable"),
+			want: &APIError{StatusCode: http.StatusServiceUnavailable},
+		},
+		"MessagesFromResponseBody": {
+			resp: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"msg":"No quality gate has been found for name foo"}]}`)),
+			},
+			err:  errors.New("not found"),
+			want: &APIError{StatusCode: http.StatusNotFound, Messages: []string{"No quality gate has been found for name foo"}},
+		},
+		"StatusAndMessagesFromSonarErrorResponse": {
+			resp: nil,
+			err:  newSonarErrorResponse(http.StatusBadRequest, `{"errors":[{"msg":"Name has already been taken"},{"msg":"second"}]}`),
+			want: &APIError{StatusCode: http.StatusBadRequest, Messages: []string{"Name has already been taken", "second"}},
+		},
+		"NonJSONBody": {
+			resp: nil,
+			err:  newSonarErrorResponse(http.StatusBadGateway, `<html>Bad Gateway</html>`),
+			want: &APIError{StatusCode: http.StatusBadGateway},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewAPIError(tc.resp, tc.err)
+			if tc.want == nil {
+				if got != nil {
+					t.Fatalf("NewAPIError() = %v, want nil", got)
+				}
+				return
+			}
+			var apiErr *APIError
+			if !errors.As(got, &apiErr) {
+				t.Fatalf("NewAPIError() returned %T, want *APIError", got)
+			}
+			if diff := cmp.Diff(tc.want.StatusCode, apiErr.StatusCode); diff != "" {
+				t.Errorf("NewAPIError() StatusCode mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.Messages, apiErr.Messages); diff != "" {
+				t.Errorf("NewAPIError() Messages mismatch (-want +got):\n%s", diff)
+			}
+			if got.Error() != tc.err.Error() {
+				t.Errorf("NewAPIError().Error() = %q, want %q", got.Error(), tc.err.Error())
+			}
+		})
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	type want struct {
+		notFound     bool
+		unauthorized bool
+		conflict     bool
+		retryable    bool
+	}
+
+	tests := map[string]struct {
+		err  error
+		want want
+	}{
+		"NilError": {
+			err:  nil,
+			want: want{},
+		},
+		"NotFound": {
+			err:  NewAPIError(&http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")),
+			want: want{notFound: true},
+		},
+		"NotFoundFromSonarErrorResponse": {
+			err:  newSonarErrorResponse(http.StatusNotFound, `{"errors":[{"msg":"not found"}]}`),
+			want: want{notFound: true},
+		},
+		"WrappedNotFound": {
+			err:  errors.Wrap(NewAPIError(&http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")), "cannot get"),
+			want: want{notFound: true},
+		},
+		"Unauthorized": {
+			err:  NewAPIError(&http.Response{StatusCode: http.StatusUnauthorized}, errors.New("unauthorized")),
+			want: want{unauthorized: true},
+		},
+		"Forbidden": {
+			err:  NewAPIError(&http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")),
+			want: want{unauthorized: true},
+		},
+		"Conflict": {
+			err:  NewAPIError(&http.Response{StatusCode: http.StatusConflict}, errors.New("conflict")),
+			want: want{conflict: true},
+		},
+		"BadRequestAlreadyExists": {
+			err:  newSonarErrorResponse(http.StatusBadRequest, `{"errors":[{"msg":"Name has already been taken"}]}`),
+			want: want{conflict: true},
+		},
+		"BadRequestOther": {
+			err:  newSonarErrorResponse(http.StatusBadRequest, `{"errors":[{"msg":"Value of parameter 'name' is invalid"}]}`),
+			want: want{},
+		},
+		"TooManyRequests": {
+			err:  NewAPIError(&http.Response{StatusCode: http.StatusTooManyRequests}, errors.New("slow down")),
+			want: want{retryable: true},
+		},
+		"ServiceUnavailable": {
+			err:  NewAPIError(&http.Response{StatusCode: http.StatusServiceUnavailable}, errors.New("unavailable")),
+			want: want{retryable: true},
+		},
+		"TransportError": {
+			err:  NewAPIError(nil, errors.New("connection refused")),
+			want: want{retryable: true},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := want{
+				notFound:     IsNotFound(tc.err),
+				unauthorized: IsUnauthorized(tc.err),
+				conflict:     IsConflict(tc.err),
+				retryable:    IsRetryable(tc.err),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("classification mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,26 +34,42 @@ const (
 	ErrSecretKeyNotFound = "Cannot find key in referenced secret"
 	// ErrSecretSelectorNil is the error string used when a secret selector is nil.
 	ErrSecretSelectorNil = "Secret selector is nil"
+	// ErrConfigMapNotFound is the error string used when a config map cannot be found.
+	ErrConfigMapNotFound = "Cannot find referenced config map"
+	// ErrConfigMapKeyNotFound is the error string used when a key in a config map cannot be found.
+	ErrConfigMapKeyNotFound = "Cannot find key in referenced config map"
 )
 
 // GetTokenValueFromSecret retrieves the token value from the referenced secret.
 func GetTokenValueFromSecret(ctx context.Context, client client.Client, m resource.Managed, selector *xpv1.SecretKeySelector) (*string, error) {
+	value, _, err := getSecretValue(ctx, client, selector)
+	return value, err
+}
+
+// SecretVersion returns the version of a secret, made of its UID and resource version.
+// It changes whenever the secret is updated, or deleted and created again.
+func SecretVersion(secret *corev1.Secret) string {
+	return string(secret.UID) + "/" + secret.ResourceVersion
+}
+
+// getSecretValue retrieves the value of a key of the referenced secret, along with the version of the secret.
+func getSecretValue(ctx context.Context, client client.Client, selector *xpv1.SecretKeySelector) (*string, string, error) {
 	if selector == nil {
-		return nil, errors.Errorf(ErrSecretSelectorNil)
+		return nil, "", errors.Errorf(ErrSecretSelectorNil)
 	}
 
 	secret := &corev1.Secret{}
 	if err := client.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: selector.Namespace}, secret); err != nil {
-		return nil, errors.Wrap(err, ErrSecretNotFound)
+		return nil, "", errors.Wrap(err, ErrSecretNotFound)
 	}
 
 	value := secret.Data[selector.Key]
 	if value == nil {
-		return nil, errors.Errorf(ErrSecretKeyNotFound)
+		return nil, "", errors.Errorf(ErrSecretKeyNotFound)
 	}
 
 	data := string(value)
-	return &data, nil
+	return &data, SecretVersion(secret), nil
 }
 
 // GetTokenValueFromLocalSecret retrieves the token value from a local secret in the same namespace as the managed resource.
@@ -70,3 +86,36 @@ func GetTokenValueFromLocalSecret(ctx context.Context, client client.Client, m r
 		},
 	})
 }
+
+// GetTokenValueAndVersionFromLocalSecret retrieves the token value from a local secret in the same namespace as the managed resource, along with the version of the secret.
+// The version can be recorded in the status of the managed resource to detect a change of the secret without publishing anything derived from its value.
+func GetTokenValueAndVersionFromLocalSecret(ctx context.Context, client client.Client, m resource.Managed, l *xpv1.LocalSecretKeySelector) (*string, string, error) {
+	if l == nil {
+		return nil, "", errors.Errorf(ErrSecretSelectorNil)
+	}
+
+	return getSecretValue(ctx, client, &xpv1.SecretKeySelector{
+		Key: l.Key,
+		SecretReference: xpv1.SecretReference{
+			Name:      l.Name,
+			Namespace: m.GetNamespace(),
+		},
+	})
+}
+
+// GetValueFromLocalConfigMap retrieves the value of a key of a config map in the same namespace as the managed resource.
+func GetValueFromLocalConfigMap(ctx context.Context, client client.Client, m resource.Managed, name, key string) (*string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Name: name, Namespace: m.GetNamespace()}, configMap); err != nil {
+		return nil, errors.Wrap(err, ErrConfigMapNotFound)
+	}
+
+	if value, ok := configMap.Data[key]; ok {
+		return &value, nil
+	}
+	if value, ok := configMap.BinaryData[key]; ok {
+		data := string(value)
+		return &data, nil
+	}
+	return nil, errors.Errorf(ErrConfigMapKeyNotFound)
+}
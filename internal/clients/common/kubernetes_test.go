@@ -268,6 +268,106 @@ func TestGetTokenValueFromLocalSecret(t *testing.T) {
 	}
 }
 
+func TestGetTokenValueAndVersionFromLocalSecret(t *testing.T) {
+	managed := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns"}}
+	selector := &xpv1.LocalSecretKeySelector{
+		LocalSecretReference: xpv1.LocalSecretReference{Name: "local-secret"},
+		Key:                  "token",
+	}
+
+	client := newFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "local-secret", Namespace: "test-ns", UID: "secret-uid", ResourceVersion: "42"},
+		Data:       map[string][]byte{"token": []byte("local-token-value")},
+	})
+	got, version, err := GetTokenValueAndVersionFromLocalSecret(context.Background(), client, managed, selector)
+	if err != nil {
+		t.Fatalf("GetTokenValueAndVersionFromLocalSecret() error = %v", err)
+	}
+	if got == nil || *got != "local-token-value" {
+		t.Errorf("GetTokenValueAndVersionFromLocalSecret() = %v, want local-token-value", got)
+	}
+	if version != "secret-uid/42" {
+		t.Errorf("GetTokenValueAndVersionFromLocalSecret() version = %q, want %q", version, "secret-uid/42")
+	}
+
+	if _, _, err := GetTokenValueAndVersionFromLocalSecret(context.Background(), client, managed, nil); err == nil || !containsString(err.Error(), ErrSecretSelectorNil) {
+		t.Errorf("GetTokenValueAndVersionFromLocalSecret() error = %v, should contain %v", err, ErrSecretSelectorNil)
+	}
+	if _, _, err := GetTokenValueAndVersionFromLocalSecret(context.Background(), newFakeClient(), managed, selector); err == nil || !containsString(err.Error(), ErrSecretNotFound) {
+		t.Errorf("GetTokenValueAndVersionFromLocalSecret() error = %v, should contain %v", err, ErrSecretNotFound)
+	}
+}
+
+func TestGetValueFromLocalConfigMap(t *testing.T) {
+	managed := &fake.Managed{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+		},
+	}
+	client := newFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backups",
+			Namespace: "test-ns",
+		},
+		Data: map[string]string{
+			"backup.xml": "<profile/>",
+		},
+		BinaryData: map[string][]byte{
+			"binary.xml": []byte("<profile></profile>"),
+		},
+	})
+
+	tests := map[string]struct {
+		name        string
+		key         string
+		want        *string
+		wantErr     bool
+		errContains string
+	}{
+		"SuccessfulDataRetrieval": {
+			name: "backups",
+			key:  "backup.xml",
+			want: strPtr("<profile/>"),
+		},
+		"SuccessfulBinaryDataRetrieval": {
+			name: "backups",
+			key:  "binary.xml",
+			want: strPtr("<profile></profile>"),
+		},
+		"ConfigMapNotFoundReturnsError": {
+			name:        "nonexistent",
+			key:         "backup.xml",
+			wantErr:     true,
+			errContains: ErrConfigMapNotFound,
+		},
+		"KeyNotFoundReturnsError": {
+			name:        "backups",
+			key:         "missing.xml",
+			wantErr:     true,
+			errContains: ErrConfigMapKeyNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetValueFromLocalConfigMap(context.Background(), client, managed, tc.name, tc.key)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("GetValueFromLocalConfigMap() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			if tc.wantErr {
+				if !containsString(err.Error(), tc.errContains) {
+					t.Errorf("GetValueFromLocalConfigMap() error = %v, should contain %v", err, tc.errContains)
+				}
+				return
+			}
+			if got == nil || *got != *tc.want {
+				t.Errorf("GetValueFromLocalConfigMap() = %v, want %v", got, *tc.want)
+			}
+		})
+	}
+}
+
 // strPtr returns a pointer to the given string.
 func strPtr(s string) *string {
 	return &s
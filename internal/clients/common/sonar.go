@@ -111,6 +111,19 @@ func GetConfig(ctx context.Context, kubeClient client.Client, managedResource re
 	}
 }
 
+// ProviderConfigKey returns a key identifying the ProviderConfig or ClusterProviderConfig referenced by the managed resource
+// It can be used to cache data that is specific to a SonarQube instance
+func ProviderConfigKey(managedResource resource.ModernManaged) string {
+	providerConfigRef := managedResource.GetProviderConfigReference()
+	if providerConfigRef == nil {
+		return ""
+	}
+	if providerConfigRef.Kind == "ClusterProviderConfig" {
+		return providerConfigRef.Kind + "/" + providerConfigRef.Name
+	}
+	return "ProviderConfig/" + managedResource.GetNamespace() + "/" + providerConfigRef.Name
+}
+
 // UseProviderConfig uses the given ProviderConfig reference to construct a Config
 // that can be used to authenticate to SonarQube's API by the SonarQube Go client
 func UseProviderConfig(ctx context.Context, kubeClient client.Client, managedResource resource.ModernManaged) (*Config, error) {
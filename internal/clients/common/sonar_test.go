@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	instancev1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestProviderConfigKey(t *testing.T) {
+	tests := map[string]struct {
+		ref  *xpv1.ProviderConfigReference
+		want string
+	}{
+		"NoReference": {
+			ref:  nil,
+			want: "",
+		},
+		"ProviderConfig": {
+			ref:  &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "example"},
+			want: "ProviderConfig/team-a/example",
+		},
+		"DefaultKind": {
+			ref:  &xpv1.ProviderConfigReference{Name: "example"},
+			want: "ProviderConfig/team-a/example",
+		},
+		"ClusterProviderConfig": {
+			ref:  &xpv1.ProviderConfigReference{Kind: "ClusterProviderConfig", Name: "example"},
+			want: "ClusterProviderConfig/example",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			qg := &instancev1alpha1.QualityGate{ObjectMeta: metav1.ObjectMeta{Name: "gate", Namespace: "team-a"}}
+			qg.SetProviderConfigReference(tc.ref)
+			if got := ProviderConfigKey(qg); got != tc.want {
+				t.Errorf("ProviderConfigKey() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
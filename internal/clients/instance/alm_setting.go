@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"maps"
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// AlmSettingsClient is the interface for interacting with SonarQube DevOps Platform Settings API
+// It handles all the operations related to the DevOps Platform integrations in SonarQube, such as creating, updating and deleting them,
+// as well as binding Projects to their repository.
+type AlmSettingsClient interface {
+	CreateAzure(opt *sonargo.AlmSettingsCreateAzureOption) (resp *http.Response, err error)
+	CreateBitbucket(opt *sonargo.AlmSettingsCreateBitbucketOption) (resp *http.Response, err error)
+	CreateBitbucketcloud(opt *sonargo.AlmSettingsCreateBitbucketcloudOption) (resp *http.Response, err error)
+	CreateGithub(opt *sonargo.AlmSettingsCreateGithubOption) (resp *http.Response, err error)
+	CreateGitlab(opt *sonargo.AlmSettingsCreateGitlabOption) (resp *http.Response, err error)
+	Delete(opt *sonargo.AlmSettingsDeleteOption) (resp *http.Response, err error)
+	DeleteBinding(opt *AlmSettingsDeleteBindingOption) (resp *http.Response, err error)
+	GetBinding(opt *sonargo.AlmSettingsGetBindingOption) (v *AlmSettingsGetBindingObject, resp *http.Response, err error)
+	List(opt *sonargo.AlmSettingsListOption) (v *sonargo.AlmSettingsListObject, resp *http.Response, err error)
+	ListDefinitions() (v *AlmSettingsListDefinitionsObject, resp *http.Response, err error)
+	SetAzureBinding(opt *AlmSettingsSetAzureBindingOption) (resp *http.Response, err error)
+	SetBitbucketBinding(opt *AlmSettingsSetBitbucketBindingOption) (resp *http.Response, err error)
+	SetBitbucketcloudBinding(opt *AlmSettingsSetBitbucketcloudBindingOption) (resp *http.Response, err error)
+	SetGithubBinding(opt *AlmSettingsSetGithubBindingOption) (resp *http.Response, err error)
+	SetGitlabBinding(opt *AlmSettingsSetGitlabBindingOption) (resp *http.Response, err error)
+	UpdateAzure(opt *sonargo.AlmSettingsUpdateAzureOption) (resp *http.Response, err error)
+	UpdateBitbucket(opt *sonargo.AlmSettingsUpdateBitbucketOption) (resp *http.Response, err error)
+	UpdateBitbucketcloud(opt *sonargo.AlmSettingsUpdateBitbucketcloudOption) (resp *http.Response, err error)
+	UpdateGithub(opt *sonargo.AlmSettingsUpdateGithubOption) (resp *http.Response, err error)
+	UpdateGitlab(opt *sonargo.AlmSettingsUpdateGitlabOption) (resp *http.Response, err error)
+}
+
+// NewAlmSettingsClient creates a new AlmSettingsClient with the provided SonarQube client configuration.
+func NewAlmSettingsClient(clientConfig common.Config) AlmSettingsClient {
+	newClient := common.NewClient(clientConfig)
+	return &almSettingsClient{
+		AlmSettingsService: newClient.AlmSettings,
+		client:             newClient,
+	}
+}
+
+// AlmSettingsListDefinitionsObject is the response of the alm_settings/list_definitions endpoint
+// The SonarQube client AlmSettingsListDefinitionsObject only types the GitHub definitions
+type AlmSettingsListDefinitionsObject struct {
+	Azure          []AlmSettingDefinitionObject `json:"azure,omitempty"`
+	Bitbucket      []AlmSettingDefinitionObject `json:"bitbucket,omitempty"`
+	Bitbucketcloud []AlmSettingDefinitionObject `json:"bitbucketcloud,omitempty"`
+	Github         []AlmSettingDefinitionObject `json:"github,omitempty"`
+	Gitlab         []AlmSettingDefinitionObject `json:"gitlab,omitempty"`
+}
+
+// AlmSettingDefinitionObject is the definition of a DevOps Platform Setting, without its credentials
+type AlmSettingDefinitionObject struct {
+	AppID     string `json:"appId,omitempty"`
+	ClientID  string `json:"clientId,omitempty"`
+	Key       string `json:"key,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Workspace string `json:"workspace,omitempty"`
+}
+
+// almSettingsClient extends the SonarQube client AlmSettingsService with the endpoints it does not provide properly
+type almSettingsClient struct {
+	*sonargo.AlmSettingsService
+	client *sonargo.Client
+}
+
+// ListDefinitions retrieves the definitions of all the DevOps Platform Settings
+func (c *almSettingsClient) ListDefinitions() (v *AlmSettingsListDefinitionsObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "alm_settings/list_definitions", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(AlmSettingsListDefinitionsObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// post sends a request without response body to an endpoint the SonarQube client does not provide
+func (c *almSettingsClient) post(path string, opt any) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, path, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// AlmSettingCredentials are the credentials of a DevOps Platform Setting, read from the Secrets referenced by AlmSettingParameters
+type AlmSettingCredentials struct {
+	ClientSecret        *string
+	PersonalAccessToken *string
+	PrivateKey          *string
+	WebhookSecret       *string
+	// SecretVersions are the versions of the referenced Secrets, keyed by the name of the credential they hold
+	SecretVersions map[string]string
+}
+
+// GenerateAlmSettingCreateGithubOption generates SonarQube AlmSettingsCreateGithubOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingCreateGithubOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsCreateGithubOption {
+	return &sonargo.AlmSettingsCreateGithubOption{
+		AppId:         ptr.Deref(spec.AppID, ""),
+		ClientId:      ptr.Deref(spec.ClientID, ""),
+		ClientSecret:  ptr.Deref(credentials.ClientSecret, ""),
+		Key:           spec.Key,
+		PrivateKey:    ptr.Deref(credentials.PrivateKey, ""),
+		Url:           ptr.Deref(spec.URL, ""),
+		WebhookSecret: ptr.Deref(credentials.WebhookSecret, ""),
+	}
+}
+
+// GenerateAlmSettingUpdateGithubOption generates SonarQube AlmSettingsUpdateGithubOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingUpdateGithubOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsUpdateGithubOption {
+	return &sonargo.AlmSettingsUpdateGithubOption{
+		AppId:         ptr.Deref(spec.AppID, ""),
+		ClientId:      ptr.Deref(spec.ClientID, ""),
+		ClientSecret:  ptr.Deref(credentials.ClientSecret, ""),
+		Key:           spec.Key,
+		PrivateKey:    ptr.Deref(credentials.PrivateKey, ""),
+		Url:           ptr.Deref(spec.URL, ""),
+		WebhookSecret: ptr.Deref(credentials.WebhookSecret, ""),
+	}
+}
+
+// GenerateAlmSettingCreateGitlabOption generates SonarQube AlmSettingsCreateGitlabOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingCreateGitlabOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsCreateGitlabOption {
+	return &sonargo.AlmSettingsCreateGitlabOption{
+		Key:                 spec.Key,
+		PersonalAccessToken: ptr.Deref(credentials.PersonalAccessToken, ""),
+		Url:                 ptr.Deref(spec.URL, ""),
+	}
+}
+
+// GenerateAlmSettingUpdateGitlabOption generates SonarQube AlmSettingsUpdateGitlabOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingUpdateGitlabOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsUpdateGitlabOption {
+	return &sonargo.AlmSettingsUpdateGitlabOption{
+		Key:                 spec.Key,
+		PersonalAccessToken: ptr.Deref(credentials.PersonalAccessToken, ""),
+		Url:                 ptr.Deref(spec.URL, ""),
+	}
+}
+
+// GenerateAlmSettingCreateAzureOption generates SonarQube AlmSettingsCreateAzureOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingCreateAzureOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsCreateAzureOption {
+	return &sonargo.AlmSettingsCreateAzureOption{
+		Key:                 spec.Key,
+		PersonalAccessToken: ptr.Deref(credentials.PersonalAccessToken, ""),
+		Url:                 ptr.Deref(spec.URL, ""),
+	}
+}
+
+// GenerateAlmSettingUpdateAzureOption generates SonarQube AlmSettingsUpdateAzureOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingUpdateAzureOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsUpdateAzureOption {
+	return &sonargo.AlmSettingsUpdateAzureOption{
+		Key:                 spec.Key,
+		PersonalAccessToken: ptr.Deref(credentials.PersonalAccessToken, ""),
+		Url:                 ptr.Deref(spec.URL, ""),
+	}
+}
+
+// GenerateAlmSettingCreateBitbucketOption generates SonarQube AlmSettingsCreateBitbucketOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingCreateBitbucketOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsCreateBitbucketOption {
+	return &sonargo.AlmSettingsCreateBitbucketOption{
+		Key:                 spec.Key,
+		PersonalAccessToken: ptr.Deref(credentials.PersonalAccessToken, ""),
+		Url:                 ptr.Deref(spec.URL, ""),
+	}
+}
+
+// GenerateAlmSettingUpdateBitbucketOption generates SonarQube AlmSettingsUpdateBitbucketOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingUpdateBitbucketOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsUpdateBitbucketOption {
+	return &sonargo.AlmSettingsUpdateBitbucketOption{
+		Key:                 spec.Key,
+		PersonalAccessToken: ptr.Deref(credentials.PersonalAccessToken, ""),
+		Url:                 ptr.Deref(spec.URL, ""),
+	}
+}
+
+// GenerateAlmSettingCreateBitbucketcloudOption generates SonarQube AlmSettingsCreateBitbucketcloudOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingCreateBitbucketcloudOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsCreateBitbucketcloudOption {
+	return &sonargo.AlmSettingsCreateBitbucketcloudOption{
+		ClientId:     ptr.Deref(spec.ClientID, ""),
+		ClientSecret: ptr.Deref(credentials.ClientSecret, ""),
+		Key:          spec.Key,
+		Workspace:    ptr.Deref(spec.Workspace, ""),
+	}
+}
+
+// GenerateAlmSettingUpdateBitbucketcloudOption generates SonarQube AlmSettingsUpdateBitbucketcloudOption from AlmSettingParameters and their credentials
+func GenerateAlmSettingUpdateBitbucketcloudOption(spec v1alpha1.AlmSettingParameters, credentials AlmSettingCredentials) *sonargo.AlmSettingsUpdateBitbucketcloudOption {
+	return &sonargo.AlmSettingsUpdateBitbucketcloudOption{
+		ClientId:     ptr.Deref(spec.ClientID, ""),
+		ClientSecret: ptr.Deref(credentials.ClientSecret, ""),
+		Key:          spec.Key,
+		Workspace:    ptr.Deref(spec.Workspace, ""),
+	}
+}
+
+// GenerateAlmSettingDeleteOption generates SonarQube AlmSettingsDeleteOption to delete the AlmSetting
+func GenerateAlmSettingDeleteOption(spec v1alpha1.AlmSettingParameters) *sonargo.AlmSettingsDeleteOption {
+	return &sonargo.AlmSettingsDeleteOption{
+		Key: spec.Key,
+	}
+}
+
+// FindAlmSetting finds the definition of the DevOps Platform Setting with the given DevOps Platform and key
+// It returns nil if there is no such definition
+func FindAlmSetting(definitions *AlmSettingsListDefinitionsObject, alm string, key string) *AlmSettingDefinitionObject {
+	if definitions == nil {
+		return nil
+	}
+	var candidates []AlmSettingDefinitionObject
+	switch alm {
+	case v1alpha1.AlmGitHub:
+		candidates = definitions.Github
+	case v1alpha1.AlmGitLab:
+		candidates = definitions.Gitlab
+	case v1alpha1.AlmAzureDevOps:
+		candidates = definitions.Azure
+	case v1alpha1.AlmBitbucketServer:
+		candidates = definitions.Bitbucket
+	case v1alpha1.AlmBitbucketCloud:
+		candidates = definitions.Bitbucketcloud
+	}
+	for i := range candidates {
+		if candidates[i].Key == key {
+			return &candidates[i]
+		}
+	}
+	return nil
+}
+
+// GenerateAlmSettingObservation generates AlmSettingObservation from an AlmSettingDefinitionObject
+// definition should not be nil, else it will panic
+func GenerateAlmSettingObservation(definition *AlmSettingDefinitionObject, alm string) v1alpha1.AlmSettingObservation {
+	return v1alpha1.AlmSettingObservation{
+		Alm:       alm,
+		AppID:     definition.AppID,
+		ClientID:  definition.ClientID,
+		Key:       definition.Key,
+		URL:       definition.URL,
+		Workspace: definition.Workspace,
+	}
+}
+
+// IsAlmSettingUpToDate checks whether the observed AlmSetting is up to date with the desired AlmSettingParameters and credentials
+// Credentials whose Secret versions were not recorded yet are considered out of date, so that they are set again
+func IsAlmSettingUpToDate(spec *v1alpha1.AlmSettingParameters, observation *v1alpha1.AlmSettingObservation, credentials AlmSettingCredentials) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	return helpers.IsComparablePtrEqualComparable(spec.URL, observation.URL) &&
+		helpers.IsComparablePtrEqualComparable(spec.AppID, observation.AppID) &&
+		helpers.IsComparablePtrEqualComparable(spec.ClientID, observation.ClientID) &&
+		helpers.IsComparablePtrEqualComparable(spec.Workspace, observation.Workspace) &&
+		maps.Equal(observation.SecretVersions, credentials.SecretVersions)
+}
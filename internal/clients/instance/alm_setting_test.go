@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestFindAlmSetting(t *testing.T) {
+	definitions := &AlmSettingsListDefinitionsObject{
+		Github: []AlmSettingDefinitionObject{{Key: "shared", URL: "https://api.github.com/"}},
+		Gitlab: []AlmSettingDefinitionObject{{Key: "shared", URL: "https://gitlab.com/api/v4"}, {Key: "gitlab"}},
+	}
+
+	tests := map[string]struct {
+		definitions *AlmSettingsListDefinitionsObject
+		alm         string
+		key         string
+		want        *AlmSettingDefinitionObject
+	}{
+		"Found": {
+			definitions: definitions,
+			alm:         v1alpha1.AlmGitLab,
+			key:         "gitlab",
+			want:        &AlmSettingDefinitionObject{Key: "gitlab"},
+		},
+		"SameKeyOtherAlm": {
+			definitions: definitions,
+			alm:         v1alpha1.AlmGitHub,
+			key:         "shared",
+			want:        &AlmSettingDefinitionObject{Key: "shared", URL: "https://api.github.com/"},
+		},
+		"NotFound": {
+			definitions: definitions,
+			alm:         v1alpha1.AlmAzureDevOps,
+			key:         "gitlab",
+		},
+		"NilDefinitions": {
+			alm: v1alpha1.AlmGitHub,
+			key: "github",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FindAlmSetting(tc.definitions, tc.alm, tc.key)); diff != "" {
+				t.Errorf("FindAlmSetting() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAlmSettingUpToDate(t *testing.T) {
+	credentials := AlmSettingCredentials{PersonalAccessToken: ptr.To("s3cr3t"), SecretVersions: map[string]string{"personalAccessToken": "gitlab-uid/3"}}
+
+	tests := map[string]struct {
+		spec        v1alpha1.AlmSettingParameters
+		observation v1alpha1.AlmSettingObservation
+		want        bool
+	}{
+		"UpToDate": {
+			spec:        v1alpha1.AlmSettingParameters{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: ptr.To("https://gitlab.com/api/v4")},
+			observation: v1alpha1.AlmSettingObservation{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: "https://gitlab.com/api/v4", SecretVersions: map[string]string{"personalAccessToken": "gitlab-uid/3"}},
+			want:        true,
+		},
+		"URLChanged": {
+			spec:        v1alpha1.AlmSettingParameters{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: ptr.To("https://gitlab.example.com/api/v4")},
+			observation: v1alpha1.AlmSettingObservation{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: "https://gitlab.com/api/v4", SecretVersions: map[string]string{"personalAccessToken": "gitlab-uid/3"}},
+		},
+		"SecretChanged": {
+			spec:        v1alpha1.AlmSettingParameters{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: ptr.To("https://gitlab.com/api/v4")},
+			observation: v1alpha1.AlmSettingObservation{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: "https://gitlab.com/api/v4", SecretVersions: map[string]string{"personalAccessToken": "gitlab-uid/2"}},
+		},
+		"SecretMovedToAnotherCredential": {
+			spec:        v1alpha1.AlmSettingParameters{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: ptr.To("https://gitlab.com/api/v4")},
+			observation: v1alpha1.AlmSettingObservation{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: "https://gitlab.com/api/v4", SecretVersions: map[string]string{"clientSecret": "gitlab-uid/3"}},
+		},
+		"SecretVersionsNotRecorded": {
+			spec:        v1alpha1.AlmSettingParameters{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: ptr.To("https://gitlab.com/api/v4")},
+			observation: v1alpha1.AlmSettingObservation{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: "https://gitlab.com/api/v4"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsAlmSettingUpToDate(&tc.spec, &tc.observation, credentials); got != tc.want {
+				t.Errorf("IsAlmSettingUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAlmSettingsClientListDefinitions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/alm_settings/list_definitions":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"github":[],"gitlab":[{"key":"gitlab","url":"https://gitlab.com/api/v4"}],"bitbucketcloud":[{"key":"bitbucket","clientId":"client","workspace":"my-workspace"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewAlmSettingsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+
+	definitions, resp, err := client.ListDefinitions()
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("ListDefinitions() error = %v", err)
+	}
+	definition := FindAlmSetting(definitions, v1alpha1.AlmBitbucketCloud, "bitbucket")
+	if definition == nil {
+		t.Fatalf("FindAlmSetting() = nil, want the Bitbucket Cloud definition")
+	}
+	want := v1alpha1.AlmSettingObservation{
+		Alm:       v1alpha1.AlmBitbucketCloud,
+		Key:       "bitbucket",
+		ClientID:  "client",
+		Workspace: "my-workspace",
+	}
+	if diff := cmp.Diff(want, GenerateAlmSettingObservation(definition, v1alpha1.AlmBitbucketCloud)); diff != "" {
+		t.Errorf("ListDefinitions() observation mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,447 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// ApplicationsClient is the interface for interacting with SonarQube Applications API
+// It handles all the operations related to Applications in SonarQube, such as creating, updating and deleting them,
+// as well as managing their Projects and branches. Applications require the Enterprise Edition or above.
+type ApplicationsClient interface {
+	AddProject(opt *ApplicationsAddProjectOption) (resp *http.Response, err error)
+	Create(opt *ApplicationsCreateOption) (resp *http.Response, err error)
+	CreateBranch(opt *ApplicationsCreateBranchOption) (resp *http.Response, err error)
+	Delete(opt *ApplicationsDeleteOption) (resp *http.Response, err error)
+	DeleteBranch(opt *ApplicationsDeleteBranchOption) (resp *http.Response, err error)
+	RemoveProject(opt *ApplicationsRemoveProjectOption) (resp *http.Response, err error)
+	Show(opt *ApplicationsShowOption) (v *ApplicationsShowObject, resp *http.Response, err error)
+	Update(opt *ApplicationsUpdateOption) (resp *http.Response, err error)
+	UpdateBranch(opt *ApplicationsUpdateBranchOption) (resp *http.Response, err error)
+}
+
+// NewApplicationsClient creates a new ApplicationsClient with the provided SonarQube client configuration.
+func NewApplicationsClient(clientConfig common.Config) ApplicationsClient {
+	return &applicationsClient{
+		client: common.NewClient(clientConfig),
+	}
+}
+
+// The SonarQube client does not provide the Applications API, which is only available in the Enterprise Edition and above,
+// so its options and responses are defined here.
+
+// ApplicationsCreateOption is the option of the applications/create endpoint
+type ApplicationsCreateOption struct {
+	Description string `url:"description,omitempty"` // Description:"Application description",ExampleValue:""
+	Key         string `url:"key,omitempty"`         // Description:"Application key",ExampleValue:""
+	Name        string `url:"name,omitempty"`        // Description:"Application name",ExampleValue:""
+}
+
+// ApplicationsShowOption is the option of the applications/show endpoint
+type ApplicationsShowOption struct {
+	Application string `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+	Branch      string `url:"branch,omitempty"`      // Description:"Branch key",ExampleValue:""
+}
+
+// ApplicationsUpdateOption is the option of the applications/update endpoint
+type ApplicationsUpdateOption struct {
+	Application string `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+	Description string `url:"description"`           // Description:"New description for the application",ExampleValue:""
+	Name        string `url:"name,omitempty"`        // Description:"New name for the application",ExampleValue:""
+}
+
+// ApplicationsDeleteOption is the option of the applications/delete endpoint
+type ApplicationsDeleteOption struct {
+	Application string `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+}
+
+// ApplicationsAddProjectOption is the option of the applications/add_project endpoint
+type ApplicationsAddProjectOption struct {
+	Application string `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+	Project     string `url:"project,omitempty"`     // Description:"Project key",ExampleValue:""
+}
+
+// ApplicationsRemoveProjectOption is the option of the applications/remove_project endpoint
+type ApplicationsRemoveProjectOption struct {
+	Application string `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+	Project     string `url:"project,omitempty"`     // Description:"Project key",ExampleValue:""
+}
+
+// ApplicationsCreateBranchOption is the option of the applications/create_branch endpoint
+// Project and ProjectBranch are repeated parameters, matched by their position.
+type ApplicationsCreateBranchOption struct {
+	Application   string   `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+	Branch        string   `url:"branch,omitempty"`      // Description:"Branch key",ExampleValue:""
+	Project       []string `url:"project"`               // Description:"Project keys",ExampleValue:""
+	ProjectBranch []string `url:"projectBranch"`         // Description:"Project branches, in the order of the project keys",ExampleValue:""
+}
+
+// ApplicationsUpdateBranchOption is the option of the applications/update_branch endpoint
+// Project and ProjectBranch are repeated parameters, matched by their position.
+type ApplicationsUpdateBranchOption struct {
+	Application   string   `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+	Branch        string   `url:"branch,omitempty"`      // Description:"Branch key",ExampleValue:""
+	Name          string   `url:"name,omitempty"`        // Description:"New name of the branch",ExampleValue:""
+	Project       []string `url:"project"`               // Description:"Project keys",ExampleValue:""
+	ProjectBranch []string `url:"projectBranch"`         // Description:"Project branches, in the order of the project keys",ExampleValue:""
+}
+
+// ApplicationsDeleteBranchOption is the option of the applications/delete_branch endpoint
+type ApplicationsDeleteBranchOption struct {
+	Application string `url:"application,omitempty"` // Description:"Application key",ExampleValue:""
+	Branch      string `url:"branch,omitempty"`      // Description:"Branch key",ExampleValue:""
+}
+
+// ApplicationsShowObject is the response of the applications/show endpoint
+type ApplicationsShowObject struct {
+	Application ApplicationObject `json:"application,omitempty"`
+}
+
+// ApplicationObject is an Application, as seen on one of its branches
+type ApplicationObject struct {
+	Branch      string                     `json:"branch,omitempty"`
+	Branches    []ApplicationBranchObject  `json:"branches,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	IsMain      bool                       `json:"isMain,omitempty"`
+	Key         string                     `json:"key,omitempty"`
+	Name        string                     `json:"name,omitempty"`
+	Projects    []ApplicationProjectObject `json:"projects,omitempty"`
+	Visibility  string                     `json:"visibility,omitempty"`
+}
+
+// ApplicationBranchObject is a branch of an Application
+type ApplicationBranchObject struct {
+	IsMain bool   `json:"isMain,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+// ApplicationProjectObject is a Project of an Application, with its branch aggregated by the shown branch of the Application
+// Selected is only returned for the branches of the Application, where Projects may be left out.
+type ApplicationProjectObject struct {
+	Branch   string `json:"branch,omitempty"`
+	Enabled  bool   `json:"enabled,omitempty"`
+	IsMain   bool   `json:"isMain,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Selected *bool  `json:"selected,omitempty"`
+}
+
+// applicationsClient implements the SonarQube Applications API, which the SonarQube client does not provide
+type applicationsClient struct {
+	client *sonargo.Client
+}
+
+// AddProject adds a Project to an Application
+func (c *applicationsClient) AddProject(opt *ApplicationsAddProjectOption) (resp *http.Response, err error) {
+	return c.post("applications/add_project", opt)
+}
+
+// Create creates an Application
+func (c *applicationsClient) Create(opt *ApplicationsCreateOption) (resp *http.Response, err error) {
+	return c.post("applications/create", opt)
+}
+
+// CreateBranch creates a branch of an Application
+func (c *applicationsClient) CreateBranch(opt *ApplicationsCreateBranchOption) (resp *http.Response, err error) {
+	return c.post("applications/create_branch", opt)
+}
+
+// Delete deletes an Application
+func (c *applicationsClient) Delete(opt *ApplicationsDeleteOption) (resp *http.Response, err error) {
+	return c.post("applications/delete", opt)
+}
+
+// DeleteBranch deletes a branch of an Application
+func (c *applicationsClient) DeleteBranch(opt *ApplicationsDeleteBranchOption) (resp *http.Response, err error) {
+	return c.post("applications/delete_branch", opt)
+}
+
+// RemoveProject removes a Project from an Application
+func (c *applicationsClient) RemoveProject(opt *ApplicationsRemoveProjectOption) (resp *http.Response, err error) {
+	return c.post("applications/remove_project", opt)
+}
+
+// Show retrieves an Application, as seen on one of its branches
+func (c *applicationsClient) Show(opt *ApplicationsShowOption) (v *ApplicationsShowObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "applications/show", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(ApplicationsShowObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// Update updates the name and description of an Application
+func (c *applicationsClient) Update(opt *ApplicationsUpdateOption) (resp *http.Response, err error) {
+	return c.post("applications/update", opt)
+}
+
+// UpdateBranch updates the Project branches aggregated by a branch of an Application
+func (c *applicationsClient) UpdateBranch(opt *ApplicationsUpdateBranchOption) (resp *http.Response, err error) {
+	return c.post("applications/update_branch", opt)
+}
+
+// post sends a request without response body to an endpoint of the Applications API
+func (c *applicationsClient) post(path string, opt any) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, path, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// GenerateApplicationCreateOption generates ApplicationsCreateOption from ApplicationParameters
+func GenerateApplicationCreateOption(spec v1alpha1.ApplicationParameters) *ApplicationsCreateOption {
+	return &ApplicationsCreateOption{
+		Description: ptr.Deref(spec.Description, ""),
+		Key:         spec.Key,
+		Name:        spec.Name,
+	}
+}
+
+// GenerateApplicationShowOption generates ApplicationsShowOption to retrieve the Application with the given key, on the given branch
+// The main branch of the Application is shown when branch is empty.
+func GenerateApplicationShowOption(key, branch string) *ApplicationsShowOption {
+	return &ApplicationsShowOption{
+		Application: key,
+		Branch:      branch,
+	}
+}
+
+// GenerateApplicationUpdateOption generates ApplicationsUpdateOption from ApplicationParameters
+// The observed description is kept when the desired one is not specified.
+func GenerateApplicationUpdateOption(spec v1alpha1.ApplicationParameters, observation v1alpha1.ApplicationObservation) *ApplicationsUpdateOption {
+	return &ApplicationsUpdateOption{
+		Application: spec.Key,
+		Description: ptr.Deref(spec.Description, observation.Description),
+		Name:        spec.Name,
+	}
+}
+
+// GenerateApplicationDeleteOption generates ApplicationsDeleteOption from ApplicationParameters
+func GenerateApplicationDeleteOption(spec v1alpha1.ApplicationParameters) *ApplicationsDeleteOption {
+	return &ApplicationsDeleteOption{
+		Application: spec.Key,
+	}
+}
+
+// GenerateApplicationAddProjectOption generates ApplicationsAddProjectOption to add the Project to the Application
+func GenerateApplicationAddProjectOption(key, project string) *ApplicationsAddProjectOption {
+	return &ApplicationsAddProjectOption{
+		Application: key,
+		Project:     project,
+	}
+}
+
+// GenerateApplicationRemoveProjectOption generates ApplicationsRemoveProjectOption to remove the Project from the Application
+func GenerateApplicationRemoveProjectOption(key, project string) *ApplicationsRemoveProjectOption {
+	return &ApplicationsRemoveProjectOption{
+		Application: key,
+		Project:     project,
+	}
+}
+
+// GenerateApplicationCreateBranchOption generates ApplicationsCreateBranchOption to create the branch of the Application
+func GenerateApplicationCreateBranchOption(key string, branch v1alpha1.ApplicationBranch) *ApplicationsCreateBranchOption {
+	projects, projectBranches := splitApplicationBranchProjects(branch.Projects)
+	return &ApplicationsCreateBranchOption{
+		Application:   key,
+		Branch:        branch.Name,
+		Project:       projects,
+		ProjectBranch: projectBranches,
+	}
+}
+
+// GenerateApplicationUpdateBranchOption generates ApplicationsUpdateBranchOption to update the Project branches of the branch of the Application
+func GenerateApplicationUpdateBranchOption(key string, branch v1alpha1.ApplicationBranch) *ApplicationsUpdateBranchOption {
+	projects, projectBranches := splitApplicationBranchProjects(branch.Projects)
+	return &ApplicationsUpdateBranchOption{
+		Application:   key,
+		Branch:        branch.Name,
+		Name:          branch.Name,
+		Project:       projects,
+		ProjectBranch: projectBranches,
+	}
+}
+
+// GenerateApplicationDeleteBranchOption generates ApplicationsDeleteBranchOption to delete the branch of the Application
+func GenerateApplicationDeleteBranchOption(key, branch string) *ApplicationsDeleteBranchOption {
+	return &ApplicationsDeleteBranchOption{
+		Application: key,
+		Branch:      branch,
+	}
+}
+
+// splitApplicationBranchProjects splits the Project branches of a branch of an Application into the matching repeated parameters
+func splitApplicationBranchProjects(branchProjects []v1alpha1.ApplicationBranchProject) (projects, projectBranches []string) {
+	projects = make([]string, 0, len(branchProjects))
+	projectBranches = make([]string, 0, len(branchProjects))
+	for _, project := range branchProjects {
+		projects = append(projects, project.ProjectKey)
+		projectBranches = append(projectBranches, project.Branch)
+	}
+	return projects, projectBranches
+}
+
+// FindApplicationBranchNames returns the names of the branches of the Application, other than its main branch
+func FindApplicationBranchNames(application *ApplicationObject) []string {
+	if application == nil {
+		return nil
+	}
+	var names []string
+	for _, branch := range application.Branches {
+		if !branch.IsMain {
+			names = append(names, branch.Name)
+		}
+	}
+	return names
+}
+
+// GenerateApplicationBranch generates the ApplicationBranch from the Application shown on this branch
+// Projects left out of the branch are skipped.
+func GenerateApplicationBranch(name string, application *ApplicationObject) v1alpha1.ApplicationBranch {
+	branch := v1alpha1.ApplicationBranch{Name: name}
+	if application == nil {
+		return branch
+	}
+	for _, project := range application.Projects {
+		if !ptr.Deref(project.Selected, true) || project.Branch == "" {
+			continue
+		}
+		branch.Projects = append(branch.Projects, v1alpha1.ApplicationBranchProject{
+			ProjectKey: project.Key,
+			Branch:     project.Branch,
+		})
+	}
+	return branch
+}
+
+// GenerateApplicationObservation generates ApplicationObservation from the Application shown on its main branch and its other branches
+// application should not be nil, else it will panic
+func GenerateApplicationObservation(application *ApplicationObject, branches []v1alpha1.ApplicationBranch) v1alpha1.ApplicationObservation {
+	observation := v1alpha1.ApplicationObservation{
+		Key:         application.Key,
+		Name:        application.Name,
+		Description: application.Description,
+		Visibility:  application.Visibility,
+		Branches:    branches,
+	}
+	for _, project := range application.Projects {
+		observation.Projects = append(observation.Projects, project.Key)
+	}
+	return observation
+}
+
+// LateInitializeApplication fills the empty fields of *ApplicationParameters with the values seen in ApplicationObservation
+func LateInitializeApplication(spec *v1alpha1.ApplicationParameters, observation *v1alpha1.ApplicationObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	helpers.AssignIfNil(&spec.Description, observation.Description)
+}
+
+// IsApplicationUpToDate checks whether the observed Application is up to date with the desired ApplicationParameters
+func IsApplicationUpToDate(spec *v1alpha1.ApplicationParameters, observation *v1alpha1.ApplicationObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	return spec.Name == observation.Name &&
+		helpers.IsComparablePtrEqualComparable(spec.Description, observation.Description) &&
+		(spec.Projects == nil || helpers.IsComparableSliceEqualIgnoringOrder(spec.Projects, observation.Projects)) &&
+		(spec.Branches == nil || (len(FindApplicationBranchesToCreate(spec.Branches, observation.Branches)) == 0 &&
+			len(FindApplicationBranchesToUpdate(spec.Branches, observation.Branches)) == 0 &&
+			len(FindApplicationBranchesToDelete(spec.Branches, observation.Branches)) == 0))
+}
+
+// FindApplicationProjectsToAdd returns the keys of the desired Projects missing from the Application
+func FindApplicationProjectsToAdd(specProjects, observedProjects []string) []string {
+	return findMissingStrings(specProjects, observedProjects)
+}
+
+// FindApplicationProjectsToRemove returns the keys of the Projects of the Application which are not desired
+// Nothing is removed when the Projects are not managed.
+func FindApplicationProjectsToRemove(specProjects, observedProjects []string) []string {
+	if specProjects == nil {
+		return nil
+	}
+	return findMissingStrings(observedProjects, specProjects)
+}
+
+// FindApplicationBranchesToCreate returns the desired branches missing from the Application
+func FindApplicationBranchesToCreate(specBranches, observedBranches []v1alpha1.ApplicationBranch) []v1alpha1.ApplicationBranch {
+	var missing []v1alpha1.ApplicationBranch
+	for _, branch := range specBranches {
+		if findApplicationBranch(observedBranches, branch.Name) == nil {
+			missing = append(missing, branch)
+		}
+	}
+	return missing
+}
+
+// FindApplicationBranchesToUpdate returns the desired branches of the Application whose Project branches differ
+func FindApplicationBranchesToUpdate(specBranches, observedBranches []v1alpha1.ApplicationBranch) []v1alpha1.ApplicationBranch {
+	var outdated []v1alpha1.ApplicationBranch
+	for _, branch := range specBranches {
+		observed := findApplicationBranch(observedBranches, branch.Name)
+		if observed != nil && !helpers.IsComparableSliceEqualIgnoringOrder(branch.Projects, observed.Projects) {
+			outdated = append(outdated, branch)
+		}
+	}
+	return outdated
+}
+
+// FindApplicationBranchesToDelete returns the names of the branches of the Application which are not desired
+// Nothing is deleted when the branches are not managed.
+func FindApplicationBranchesToDelete(specBranches, observedBranches []v1alpha1.ApplicationBranch) []string {
+	if specBranches == nil {
+		return nil
+	}
+	var extra []string
+	for _, branch := range observedBranches {
+		if findApplicationBranch(specBranches, branch.Name) == nil {
+			extra = append(extra, branch.Name)
+		}
+	}
+	return extra
+}
+
+// findApplicationBranch returns the branch with the given name, nil if there is none
+func findApplicationBranch(branches []v1alpha1.ApplicationBranch, name string) *v1alpha1.ApplicationBranch {
+	for i := range branches {
+		if branches[i].Name == name {
+			return &branches[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+var releaseBranch = v1alpha1.ApplicationBranch{
+	Name: "release",
+	Projects: []v1alpha1.ApplicationBranchProject{
+		{ProjectKey: "backend", Branch: "release-1.0"},
+		{ProjectKey: "frontend", Branch: "release-2.0"},
+	},
+}
+
+func TestGenerateApplicationBranch(t *testing.T) {
+	application := &ApplicationObject{
+		Branch: "release",
+		Projects: []ApplicationProjectObject{
+			{Key: "backend", Branch: "release-1.0", Selected: ptr.To(true)},
+			{Key: "frontend", Branch: "release-2.0"},
+			{Key: "docs", Branch: "main", Selected: ptr.To(false)},
+		},
+	}
+
+	if diff := cmp.Diff(releaseBranch, GenerateApplicationBranch("release", application)); diff != "" {
+		t.Errorf("GenerateApplicationBranch() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestApplicationBranchesDiff(t *testing.T) {
+	outdated := v1alpha1.ApplicationBranch{Name: "release", Projects: []v1alpha1.ApplicationBranchProject{{ProjectKey: "backend", Branch: "release-0.9"}}}
+	hotfix := v1alpha1.ApplicationBranch{Name: "hotfix", Projects: []v1alpha1.ApplicationBranchProject{{ProjectKey: "backend", Branch: "hotfix"}}}
+	legacy := v1alpha1.ApplicationBranch{Name: "legacy", Projects: []v1alpha1.ApplicationBranchProject{{ProjectKey: "backend", Branch: "legacy"}}}
+
+	tests := map[string]struct {
+		spec       []v1alpha1.ApplicationBranch
+		observed   []v1alpha1.ApplicationBranch
+		wantCreate []v1alpha1.ApplicationBranch
+		wantUpdate []v1alpha1.ApplicationBranch
+		wantDelete []string
+	}{
+		"UpToDate": {
+			spec:     []v1alpha1.ApplicationBranch{releaseBranch},
+			observed: []v1alpha1.ApplicationBranch{releaseBranch},
+		},
+		"Changed": {
+			spec:       []v1alpha1.ApplicationBranch{releaseBranch, hotfix},
+			observed:   []v1alpha1.ApplicationBranch{outdated, legacy},
+			wantCreate: []v1alpha1.ApplicationBranch{hotfix},
+			wantUpdate: []v1alpha1.ApplicationBranch{releaseBranch},
+			wantDelete: []string{"legacy"},
+		},
+		"Unmanaged": {
+			observed: []v1alpha1.ApplicationBranch{legacy},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantCreate, FindApplicationBranchesToCreate(tc.spec, tc.observed)); diff != "" {
+				t.Errorf("FindApplicationBranchesToCreate() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantUpdate, FindApplicationBranchesToUpdate(tc.spec, tc.observed)); diff != "" {
+				t.Errorf("FindApplicationBranchesToUpdate() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantDelete, FindApplicationBranchesToDelete(tc.spec, tc.observed)); diff != "" {
+				t.Errorf("FindApplicationBranchesToDelete() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsApplicationUpToDate(t *testing.T) {
+	observation := v1alpha1.ApplicationObservation{
+		Key:         "my-app",
+		Name:        "My Application",
+		Description: "Backend and frontend",
+		Projects:    []string{"frontend", "backend"},
+		Branches:    []v1alpha1.ApplicationBranch{releaseBranch},
+	}
+
+	tests := map[string]struct {
+		spec v1alpha1.ApplicationParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: v1alpha1.ApplicationParameters{Key: "my-app", Name: "My Application", Description: ptr.To("Backend and frontend"), Projects: []string{"backend", "frontend"}, Branches: []v1alpha1.ApplicationBranch{releaseBranch}},
+			want: true,
+		},
+		"ContentUnmanaged": {
+			spec: v1alpha1.ApplicationParameters{Key: "my-app", Name: "My Application"},
+			want: true,
+		},
+		"NameChanged": {
+			spec: v1alpha1.ApplicationParameters{Key: "my-app", Name: "Renamed Application"},
+		},
+		"ProjectsChanged": {
+			spec: v1alpha1.ApplicationParameters{Key: "my-app", Name: "My Application", Projects: []string{"backend"}},
+		},
+		"BranchesChanged": {
+			spec: v1alpha1.ApplicationParameters{Key: "my-app", Name: "My Application", Branches: []v1alpha1.ApplicationBranch{}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsApplicationUpToDate(&tc.spec, &observation); got != tc.want {
+				t.Errorf("IsApplicationUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestApplicationsClient(t *testing.T) {
+	var createBranchQuery map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/applications/show":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"application":{"key":"my-app","name":"My Application","description":"Backend and frontend","visibility":"private","isMain":true,"projects":[{"key":"backend","name":"Backend","branch":"main","isMain":true,"enabled":true},{"key":"frontend","name":"Frontend","branch":"main","isMain":true,"enabled":true}],"branches":[{"name":"main","isMain":true},{"name":"release","isMain":false}]}}`))
+		case "/api/applications/create_branch":
+			createBranchQuery = r.URL.Query()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewApplicationsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+
+	createResp, err := client.CreateBranch(GenerateApplicationCreateBranchOption("my-app", releaseBranch))
+	if createResp != nil {
+		defer createResp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("CreateBranch() error = %v", err)
+	}
+	wantQuery := map[string][]string{
+		"application":   {"my-app"},
+		"branch":        {"release"},
+		"project":       {"backend", "frontend"},
+		"projectBranch": {"release-1.0", "release-2.0"},
+	}
+	if diff := cmp.Diff(wantQuery, createBranchQuery); diff != "" {
+		t.Errorf("CreateBranch() parameters mismatch (-want +got):\n%s", diff)
+	}
+
+	application, resp, err := client.Show(GenerateApplicationShowOption("my-app", ""))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Show() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"release"}, FindApplicationBranchNames(&application.Application)); diff != "" {
+		t.Errorf("FindApplicationBranchNames() mismatch (-want +got):\n%s", diff)
+	}
+	want := v1alpha1.ApplicationObservation{
+		Key:         "my-app",
+		Name:        "My Application",
+		Description: "Backend and frontend",
+		Visibility:  "private",
+		Projects:    []string{"backend", "frontend"},
+		Branches:    []v1alpha1.ApplicationBranch{releaseBranch},
+	}
+	if diff := cmp.Diff(want, GenerateApplicationObservation(&application.Application, []v1alpha1.ApplicationBranch{releaseBranch})); diff != "" {
+		t.Errorf("Show() observation mismatch (-want +got):\n%s", diff)
+	}
+}
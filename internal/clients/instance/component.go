@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// ComponentsClient is the interface for interacting with SonarQube Components API
+// It is used to read information about components (projects, applications, portfolios) that is not exposed by their dedicated APIs.
+type ComponentsClient interface {
+	App(opt *sonargo.ComponentsAppOption) (v *sonargo.ComponentsAppObject, resp *http.Response, err error)
+	Search(opt *sonargo.ComponentsSearchOption) (v *sonargo.ComponentsSearchObject, resp *http.Response, err error)
+	SearchProjects(opt *sonargo.ComponentsSearchProjectsOption) (v *sonargo.ComponentsSearchProjectsObject, resp *http.Response, err error)
+	Show(opt *sonargo.ComponentsShowOption) (v *sonargo.ComponentsShowObject, resp *http.Response, err error)
+	Suggestions(opt *sonargo.ComponentsSuggestionsOption) (v *sonargo.ComponentsSuggestionsObject, resp *http.Response, err error)
+	Tree(opt *sonargo.ComponentsTreeOption) (v *sonargo.ComponentsTreeObject, resp *http.Response, err error)
+}
+
+// NewComponentsClient creates a new ComponentsClient with the provided SonarQube client configuration.
+func NewComponentsClient(clientConfig common.Config) ComponentsClient {
+	newClient := common.NewClient(clientConfig)
+	return newClient.Components
+}
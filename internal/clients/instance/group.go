@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// UserGroupsClient is the interface for interacting with SonarQube User Groups API
+// It handles all the operations related to Groups in SonarQube, such as creating, updating, deleting and retrieving Groups.
+type UserGroupsClient interface {
+	AddUser(opt *sonargo.UserGroupsAddUserOption) (resp *http.Response, err error)
+	Create(opt *sonargo.UserGroupsCreateOption) (v *sonargo.UserGroupsCreateObject, resp *http.Response, err error)
+	Delete(opt *sonargo.UserGroupsDeleteOption) (resp *http.Response, err error)
+	RemoveUser(opt *sonargo.UserGroupsRemoveUserOption) (resp *http.Response, err error)
+	Search(opt *sonargo.UserGroupsSearchOption) (v *sonargo.UserGroupsSearchObject, resp *http.Response, err error)
+	Update(opt *UserGroupsUpdateOption) (resp *http.Response, err error)
+	Users(opt *sonargo.UserGroupsUsersOption) (v *sonargo.UserGroupsUsersObject, resp *http.Response, err error)
+}
+
+// NewUserGroupsClient creates a new UserGroupsClient with the provided SonarQube client configuration.
+func NewUserGroupsClient(clientConfig common.Config) UserGroupsClient {
+	newClient := common.NewClient(clientConfig)
+	return &userGroupsClient{
+		UserGroupsService: newClient.UserGroups,
+		client:            newClient,
+	}
+}
+
+// UserGroupsUpdateOption is the option of the user_groups/update endpoint
+// The SonarQube client UserGroupsUpdateOption omits an empty description, so that a description cannot be cleared
+type UserGroupsUpdateOption struct {
+	CurrentName string  `url:"currentName,omitempty"` // Description:"Name of the group to be updated.",ExampleValue:"AU-Tpxb--iU5OvuD2FLy"
+	Description *string `url:"description,omitempty"` // Description:"New optional description for the group. A group description cannot be larger than 200 characters. If value is not defined, then description is not changed.",ExampleValue:"Default group for new users"
+	Name        string  `url:"name,omitempty"`        // Description:"New optional name for the group. A group name cannot be larger than 255 characters and must be unique. Value 'anyone' (whatever the case) is reserved and cannot be used. If value is empty or not defined, then name is not changed.",ExampleValue:"my-group"
+}
+
+// userGroupsClient extends the SonarQube client UserGroupsService with the endpoints it does not provide properly
+type userGroupsClient struct {
+	*sonargo.UserGroupsService
+	client *sonargo.Client
+}
+
+// Update updates the name and the description of a Group
+func (c *userGroupsClient) Update(opt *UserGroupsUpdateOption) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "user_groups/update", opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// GenerateGroupCreateOption generates SonarQube UserGroupsCreateOption from GroupParameters
+func GenerateGroupCreateOption(spec v1alpha1.GroupParameters) *sonargo.UserGroupsCreateOption {
+	option := &sonargo.UserGroupsCreateOption{
+		Name: spec.Name,
+	}
+	if spec.Description != nil {
+		option.Description = *spec.Description
+	}
+	return option
+}
+
+// GenerateGroupSearchOption generates SonarQube UserGroupsSearchOption to look up a Group by its name
+func GenerateGroupSearchOption(name string) *sonargo.UserGroupsSearchOption {
+	return &sonargo.UserGroupsSearchOption{
+		Q:  name,
+		Ps: "500",
+	}
+}
+
+// FindGroup finds the Group with the given name in a SonarQube UserGroupsSearchObject
+// The search API matches the name partially, so it returns nil if no Group has exactly this name
+func FindGroup(search *sonargo.UserGroupsSearchObject, name string) *sonargo.UserGroupsSearchObject_sub1 {
+	if search == nil {
+		return nil
+	}
+	for i := range search.Groups {
+		if search.Groups[i].Name == name {
+			return &search.Groups[i]
+		}
+	}
+	return nil
+}
+
+// GenerateGroupObservation generates GroupObservation from SonarQube UserGroupsSearchObject_sub1
+// group should not be nil, else it will panic
+func GenerateGroupObservation(group *sonargo.UserGroupsSearchObject_sub1) v1alpha1.GroupObservation {
+	return v1alpha1.GroupObservation{
+		Default:      group.Default,
+		Description:  group.Description,
+		Managed:      group.Managed,
+		MembersCount: group.MembersCount,
+		Name:         group.Name,
+	}
+}
+
+// IsGroupUpToDate checks if the Group spec is up to date with the observed state
+func IsGroupUpToDate(spec *v1alpha1.GroupParameters, observation *v1alpha1.GroupObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if spec.Name != observation.Name {
+		return false
+	}
+
+	if !helpers.IsComparablePtrEqualComparable(spec.Description, observation.Description) {
+		return false
+	}
+
+	return true
+}
+
+// LateInitializeGroup fills the spec with the observed state if the spec fields are nil
+func LateInitializeGroup(spec *v1alpha1.GroupParameters, observation *v1alpha1.GroupObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	if observation.Description != "" {
+		helpers.AssignIfNil(&spec.Description, observation.Description)
+	}
+}
+
+// GenerateGroupUpdateOption generates UserGroupsUpdateOption to update the Group currently named currentName from GroupParameters
+// The name is only sent when it changes, as SonarQube refuses to rename a Group to an existing name
+func GenerateGroupUpdateOption(currentName string, spec v1alpha1.GroupParameters) *UserGroupsUpdateOption {
+	option := &UserGroupsUpdateOption{
+		CurrentName: currentName,
+		Description: spec.Description,
+	}
+	if spec.Name != currentName {
+		option.Name = spec.Name
+	}
+	return option
+}
+
+// GenerateGroupDeleteOption generates SonarQube UserGroupsDeleteOption to delete a Group
+func GenerateGroupDeleteOption(name string) *sonargo.UserGroupsDeleteOption {
+	return &sonargo.UserGroupsDeleteOption{
+		Name: name,
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// GroupMembershipsClient is the interface for interacting with SonarQube v2 Group Memberships API
+// The v2 API identifies Groups and Users by their ID, so it also looks them up by name and login.
+// The SonarQube client does not provide the v2 API, so all the endpoints are implemented here.
+type GroupMembershipsClient interface {
+	Create(opt *GroupMembershipsCreateOption) (v *GroupMembershipObject, resp *http.Response, err error)
+	Delete(opt *GroupMembershipsDeleteOption) (resp *http.Response, err error)
+	Search(opt *GroupMembershipsSearchOption) (v *GroupMembershipsSearchObject, resp *http.Response, err error)
+	SearchGroups(opt *AuthorizationsGroupsSearchOption) (v *AuthorizationsGroupsSearchObject, resp *http.Response, err error)
+	SearchUsers(opt *UsersManagementSearchOption) (v *UsersManagementSearchObject, resp *http.Response, err error)
+}
+
+// NewGroupMembershipsClient creates a new GroupMembershipsClient with the provided SonarQube client configuration.
+func NewGroupMembershipsClient(clientConfig common.Config) GroupMembershipsClient {
+	return &groupMembershipsClient{
+		client: common.NewClient(clientConfig),
+	}
+}
+
+// PageObject is the paging of the responses of the v2 API
+type PageObject struct {
+	PageIndex int64 `json:"pageIndex,omitempty"`
+	PageSize  int64 `json:"pageSize,omitempty"`
+	Total     int64 `json:"total,omitempty"`
+}
+
+// GroupMembershipObject is a membership of a User in a Group
+type GroupMembershipObject struct {
+	GroupID string `json:"groupId,omitempty"`
+	ID      string `json:"id,omitempty"`
+	UserID  string `json:"userId,omitempty"`
+}
+
+// GroupMembershipsSearchObject is the response of the v2/authorizations/group-memberships endpoint
+type GroupMembershipsSearchObject struct {
+	GroupMemberships []GroupMembershipObject `json:"groupMemberships,omitempty"`
+	Page             PageObject              `json:"page,omitempty"`
+}
+
+// GroupMembershipsSearchOption is the option of the v2/authorizations/group-memberships endpoint
+type GroupMembershipsSearchOption struct {
+	GroupID   string `url:"groupId,omitempty"`   // Description:"ID of the group for which to list the memberships"
+	PageIndex string `url:"pageIndex,omitempty"` // Description:"1-based page number"
+	PageSize  string `url:"pageSize,omitempty"`  // Description:"Number of results per page"
+	UserID    string `url:"userId,omitempty"`    // Description:"ID of the user for which to list the memberships"
+}
+
+// GroupMembershipsCreateOption is the body of the creation of a membership
+type GroupMembershipsCreateOption struct {
+	GroupID string `json:"groupId"`
+	UserID  string `json:"userId"`
+}
+
+// GroupMembershipsDeleteOption identifies the membership to delete
+type GroupMembershipsDeleteOption struct {
+	ID string `url:"-"`
+}
+
+// AuthorizationsGroupObject is a Group as returned by the v2 API
+type AuthorizationsGroupObject struct {
+	Default     bool   `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Managed     bool   `json:"managed,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// AuthorizationsGroupsSearchObject is the response of the v2/authorizations/groups endpoint
+type AuthorizationsGroupsSearchObject struct {
+	Groups []AuthorizationsGroupObject `json:"groups,omitempty"`
+	Page   PageObject                  `json:"page,omitempty"`
+}
+
+// AuthorizationsGroupsSearchOption is the option of the v2/authorizations/groups endpoint
+type AuthorizationsGroupsSearchOption struct {
+	PageIndex string `url:"pageIndex,omitempty"` // Description:"1-based page number"
+	PageSize  string `url:"pageSize,omitempty"`  // Description:"Number of results per page"
+	Q         string `url:"q,omitempty"`         // Description:"Limit search to names that contain the supplied string"
+}
+
+// UsersManagementUserObject is a User as returned by the v2 API
+type UsersManagementUserObject struct {
+	Active bool   `json:"active,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Local  bool   `json:"local,omitempty"`
+	Login  string `json:"login,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+// UsersManagementSearchObject is the response of the v2/users-management/users endpoint
+type UsersManagementSearchObject struct {
+	Page  PageObject                  `json:"page,omitempty"`
+	Users []UsersManagementUserObject `json:"users,omitempty"`
+}
+
+// UsersManagementSearchOption is the option of the v2/users-management/users endpoint
+type UsersManagementSearchOption struct {
+	PageIndex string `url:"pageIndex,omitempty"` // Description:"1-based page number"
+	PageSize  string `url:"pageSize,omitempty"`  // Description:"Number of results per page"
+	Q         string `url:"q,omitempty"`         // Description:"Limit search to logins, names or emails that contain the supplied string"
+}
+
+// groupMembershipsClient implements GroupMembershipsClient on top of the SonarQube client
+type groupMembershipsClient struct {
+	client *sonargo.Client
+}
+
+// Create adds a User to a Group
+// The v2 API expects a JSON body, while the SonarQube client sends the options as query parameters
+func (c *groupMembershipsClient) Create(opt *GroupMembershipsCreateOption) (v *GroupMembershipObject, resp *http.Response, err error) {
+	body, err := json.Marshal(opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.client.NewRequest(http.MethodPost, "v2/authorizations/group-memberships", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	v = new(GroupMembershipObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// Delete removes a User from a Group
+func (c *groupMembershipsClient) Delete(opt *GroupMembershipsDeleteOption) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodDelete, "v2/authorizations/group-memberships/"+opt.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// Search lists the memberships of a Group or a User
+func (c *groupMembershipsClient) Search(opt *GroupMembershipsSearchOption) (v *GroupMembershipsSearchObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "v2/authorizations/group-memberships", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(GroupMembershipsSearchObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// SearchGroups searches Groups by name
+func (c *groupMembershipsClient) SearchGroups(opt *AuthorizationsGroupsSearchOption) (v *AuthorizationsGroupsSearchObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "v2/authorizations/groups", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(AuthorizationsGroupsSearchObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// SearchUsers searches active Users by login
+func (c *groupMembershipsClient) SearchUsers(opt *UsersManagementSearchOption) (v *UsersManagementSearchObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "v2/users-management/users", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(UsersManagementSearchObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GenerateAuthorizationsGroupsSearchOption generates AuthorizationsGroupsSearchOption to look up a Group by its name
+func GenerateAuthorizationsGroupsSearchOption(name string) *AuthorizationsGroupsSearchOption {
+	return &AuthorizationsGroupsSearchOption{
+		Q:        name,
+		PageSize: "500",
+	}
+}
+
+// FindAuthorizationsGroup finds the Group with the given name in an AuthorizationsGroupsSearchObject
+// The search API matches the name partially, so it returns nil if no Group has exactly this name
+func FindAuthorizationsGroup(search *AuthorizationsGroupsSearchObject, name string) *AuthorizationsGroupObject {
+	if search == nil {
+		return nil
+	}
+	for i := range search.Groups {
+		if search.Groups[i].Name == name {
+			return &search.Groups[i]
+		}
+	}
+	return nil
+}
+
+// GenerateUsersManagementSearchOption generates UsersManagementSearchOption to look up a User by its login
+func GenerateUsersManagementSearchOption(login string) *UsersManagementSearchOption {
+	return &UsersManagementSearchOption{
+		Q:        login,
+		PageSize: "500",
+	}
+}
+
+// FindUsersManagementUser finds the User with the given login in a UsersManagementSearchObject
+// The search API matches the login, name and email partially, so it returns nil if no User has exactly this login
+func FindUsersManagementUser(search *UsersManagementSearchObject, login string) *UsersManagementUserObject {
+	if search == nil {
+		return nil
+	}
+	for i := range search.Users {
+		if search.Users[i].Login == login {
+			return &search.Users[i]
+		}
+	}
+	return nil
+}
+
+// GenerateGroupMembershipsSearchOption generates GroupMembershipsSearchOption to look up the membership of a User in a Group
+func GenerateGroupMembershipsSearchOption(groupID, userID string) *GroupMembershipsSearchOption {
+	return &GroupMembershipsSearchOption{
+		GroupID: groupID,
+		UserID:  userID,
+	}
+}
+
+// FindGroupMembership finds the membership of the User in the Group in a GroupMembershipsSearchObject
+func FindGroupMembership(search *GroupMembershipsSearchObject, groupID, userID string) *GroupMembershipObject {
+	if search == nil {
+		return nil
+	}
+	for i := range search.GroupMemberships {
+		if search.GroupMemberships[i].GroupID == groupID && search.GroupMemberships[i].UserID == userID {
+			return &search.GroupMemberships[i]
+		}
+	}
+	return nil
+}
+
+// GenerateGroupMembershipsCreateOption generates GroupMembershipsCreateOption to add a User to a Group
+func GenerateGroupMembershipsCreateOption(groupID, userID string) *GroupMembershipsCreateOption {
+	return &GroupMembershipsCreateOption{
+		GroupID: groupID,
+		UserID:  userID,
+	}
+}
+
+// GenerateGroupMembershipsDeleteOption generates GroupMembershipsDeleteOption to remove a membership
+func GenerateGroupMembershipsDeleteOption(id string) *GroupMembershipsDeleteOption {
+	return &GroupMembershipsDeleteOption{
+		ID: id,
+	}
+}
+
+// GenerateGroupMembershipObservation generates GroupMembershipObservation from a GroupMembershipObject
+// membership should not be nil, else it will panic
+func GenerateGroupMembershipObservation(membership *GroupMembershipObject) v1alpha1.GroupMembershipObservation {
+	return v1alpha1.GroupMembershipObservation{
+		ID:      membership.ID,
+		GroupID: membership.GroupID,
+		UserID:  membership.UserID,
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestFindGroupMembership(t *testing.T) {
+	search := &GroupMembershipsSearchObject{
+		GroupMemberships: []GroupMembershipObject{
+			{ID: "m-1", GroupID: "g-1", UserID: "u-2"},
+			{ID: "m-2", GroupID: "g-1", UserID: "u-1"},
+		},
+	}
+
+	if got := FindGroupMembership(search, "g-1", "u-1"); got == nil || got.ID != "m-2" {
+		t.Errorf("FindGroupMembership() = %v, want the m-2 membership", got)
+	}
+	if got := FindGroupMembership(search, "g-2", "u-1"); got != nil {
+		t.Errorf("FindGroupMembership() = %v, want nil", got)
+	}
+	if got := FindGroupMembership(nil, "g-1", "u-1"); got != nil {
+		t.Errorf("FindGroupMembership() = %v, want nil", got)
+	}
+}
+
+func TestFindAuthorizationsGroupAndUser(t *testing.T) {
+	groups := &AuthorizationsGroupsSearchObject{Groups: []AuthorizationsGroupObject{{ID: "g-2", Name: "developers-admin"}, {ID: "g-1", Name: "developers"}}}
+	if got := FindAuthorizationsGroup(groups, "developers"); got == nil || got.ID != "g-1" {
+		t.Errorf("FindAuthorizationsGroup() = %v, want the developers Group", got)
+	}
+	if got := FindAuthorizationsGroup(groups, "dev"); got != nil {
+		t.Errorf("FindAuthorizationsGroup() = %v, want nil", got)
+	}
+
+	users := &UsersManagementSearchObject{Users: []UsersManagementUserObject{{ID: "u-2", Login: "jdoe-admin"}, {ID: "u-1", Login: "jdoe"}}}
+	if got := FindUsersManagementUser(users, "jdoe"); got == nil || got.ID != "u-1" {
+		t.Errorf("FindUsersManagementUser() = %v, want the jdoe User", got)
+	}
+	if got := FindUsersManagementUser(users, "jd"); got != nil {
+		t.Errorf("FindUsersManagementUser() = %v, want nil", got)
+	}
+}
+
+func TestGroupMembershipsClientRequests(t *testing.T) {
+	var created GroupMembershipsCreateOption
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/authorizations/group-memberships":
+			if r.URL.RawQuery != "" {
+				t.Errorf("the membership should not be sent as query parameters, got %q", r.URL.RawQuery)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("the membership should be sent as a JSON body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"id":"m-1","groupId":"g-1","userId":"u-1"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/authorizations/group-memberships/m-1":
+			deleted = "m-1"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewGroupMembershipsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+
+	got, resp, err := client.Create(GenerateGroupMembershipsCreateOption("g-1", "u-1"))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if diff := cmp.Diff(GroupMembershipsCreateOption{GroupID: "g-1", UserID: "u-1"}, created); diff != "" {
+		t.Errorf("Create() body mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&GroupMembershipObject{ID: "m-1", GroupID: "g-1", UserID: "u-1"}, got); diff != "" {
+		t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+	}
+
+	resp, err = client.Delete(GenerateGroupMembershipsDeleteOption("m-1"))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if deleted != "m-1" {
+		t.Errorf("Delete() should delete the m-1 membership")
+	}
+}
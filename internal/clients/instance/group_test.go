@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestFindGroup(t *testing.T) {
+	search := &sonargo.UserGroupsSearchObject{
+		Groups: []sonargo.UserGroupsSearchObject_sub1{
+			{Name: "developers-admin"},
+			{Name: "developers", Description: "All the developers"},
+		},
+	}
+
+	if got := FindGroup(search, "developers"); got == nil || got.Description != "All the developers" {
+		t.Errorf("FindGroup() = %v, want the developers Group", got)
+	}
+	if got := FindGroup(search, "dev"); got != nil {
+		t.Errorf("FindGroup() = %v, want nil", got)
+	}
+	if got := FindGroup(nil, "developers"); got != nil {
+		t.Errorf("FindGroup() = %v, want nil", got)
+	}
+}
+
+func TestIsGroupUpToDate(t *testing.T) {
+	observation := &v1alpha1.GroupObservation{Name: "developers", Description: "All the developers"}
+
+	tests := map[string]struct {
+		spec *v1alpha1.GroupParameters
+		want bool
+	}{
+		"NilSpec": {
+			spec: nil,
+			want: true,
+		},
+		"UnmanagedDescription": {
+			spec: &v1alpha1.GroupParameters{Name: "developers"},
+			want: true,
+		},
+		"UpToDate": {
+			spec: &v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("All the developers")},
+			want: true,
+		},
+		"NameDrift": {
+			spec: &v1alpha1.GroupParameters{Name: "engineers", Description: ptr.To("All the developers")},
+			want: false,
+		},
+		"DescriptionCleared": {
+			spec: &v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("")},
+			want: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsGroupUpToDate(tc.spec, observation); got != tc.want {
+				t.Errorf("IsGroupUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeGroup(t *testing.T) {
+	tests := map[string]struct {
+		spec        *v1alpha1.GroupParameters
+		observation *v1alpha1.GroupObservation
+		want        *v1alpha1.GroupParameters
+	}{
+		"FillsDescription": {
+			spec:        &v1alpha1.GroupParameters{Name: "developers"},
+			observation: &v1alpha1.GroupObservation{Description: "All the developers"},
+			want:        &v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("All the developers")},
+		},
+		"NoDescriptionIsNotLateInitialized": {
+			spec:        &v1alpha1.GroupParameters{Name: "developers"},
+			observation: &v1alpha1.GroupObservation{},
+			want:        &v1alpha1.GroupParameters{Name: "developers"},
+		},
+		"KeepsSpecifiedDescription": {
+			spec:        &v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("")},
+			observation: &v1alpha1.GroupObservation{Description: "All the developers"},
+			want:        &v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("")},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeGroup(tc.spec, tc.observation)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeGroup() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateGroupUpdateOption(t *testing.T) {
+	tests := map[string]struct {
+		currentName string
+		spec        v1alpha1.GroupParameters
+		want        *UserGroupsUpdateOption
+	}{
+		"SameNameIsNotSent": {
+			currentName: "developers",
+			spec:        v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("All the developers")},
+			want:        &UserGroupsUpdateOption{CurrentName: "developers", Description: ptr.To("All the developers")},
+		},
+		"Rename": {
+			currentName: "developers",
+			spec:        v1alpha1.GroupParameters{Name: "engineers"},
+			want:        &UserGroupsUpdateOption{CurrentName: "developers", Name: "engineers"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateGroupUpdateOption(tc.currentName, tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateGroupUpdateOption() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserGroupsClientUpdateClearsDescription(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/user_groups/update" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewUserGroupsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	resp, err := client.Update(GenerateGroupUpdateOption("developers", v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("")}))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	want := map[string][]string{"currentName": {"developers"}, "description": {""}}
+	if diff := cmp.Diff(want, query); diff != "" {
+		t.Errorf("Update() parameters mismatch (-want +got):\n%s", diff)
+	}
+}
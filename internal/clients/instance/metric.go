@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// MetricsClient is the interface for interacting with SonarQube Metrics API
+// It is used to retrieve the metrics catalogue of the SonarQube instance.
+type MetricsClient interface {
+	Search(opt *sonargo.MetricsSearchOption) (v *sonargo.MetricsSearchObject, resp *http.Response, err error)
+	Types() (v *sonargo.MetricsTypesObject, resp *http.Response, err error)
+}
+
+// NewMetricsClient creates a new MetricsClient with the provided SonarQube client configuration.
+func NewMetricsClient(clientConfig common.Config) MetricsClient {
+	newClient := common.NewClient(clientConfig)
+	return newClient.Metrics
+}
+
+// MetricsPageSize is the number of metrics requested per page when retrieving the metrics catalogue
+const MetricsPageSize = 500
+
+// Metric types that can be used in Quality Gate conditions
+const (
+	MetricTypeInt      = "INT"
+	MetricTypeMillisec = "MILLISEC"
+	MetricTypeRating   = "RATING"
+	MetricTypeWorkDur  = "WORK_DUR"
+	MetricTypeFloat    = "FLOAT"
+	MetricTypePercent  = "PERCENT"
+	MetricTypeLevel    = "LEVEL"
+)
+
+// qualityGateConditionMetricTypes are the metric types accepted by SonarQube in Quality Gate conditions
+var qualityGateConditionMetricTypes = map[string]bool{
+	MetricTypeInt:      true,
+	MetricTypeMillisec: true,
+	MetricTypeRating:   true,
+	MetricTypeWorkDur:  true,
+	MetricTypeFloat:    true,
+	MetricTypePercent:  true,
+	MetricTypeLevel:    true,
+}
+
+// forbiddenQualityGateConditionMetrics are the metrics rejected by SonarQube in Quality Gate conditions
+var forbiddenQualityGateConditionMetrics = map[string]bool{
+	"alert_status":          true,
+	"security_hotspots":     true,
+	"new_security_hotspots": true,
+}
+
+// MetricCatalog maps the metric keys of a SonarQube instance to their definition
+type MetricCatalog map[string]sonargo.MetricsSearchObject_sub1
+
+// GenerateMetricsSearchOption generates SonarQube MetricsSearchOption to list a page of the metrics catalogue
+func GenerateMetricsSearchOption(page int) *sonargo.MetricsSearchOption {
+	return &sonargo.MetricsSearchOption{
+		P:  strconv.Itoa(page),
+		Ps: strconv.Itoa(MetricsPageSize),
+	}
+}
+
+// HasMoreMetrics returns true if the SonarQube MetricsSearchObject is not the last page of results
+func HasMoreMetrics(search *sonargo.MetricsSearchObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Metrics), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// AddMetrics adds the metrics of a SonarQube MetricsSearchObject page to the catalogue
+func (catalog MetricCatalog) AddMetrics(search *sonargo.MetricsSearchObject) {
+	if search == nil {
+		return
+	}
+	for _, metric := range search.Metrics {
+		catalog[metric.Key] = metric
+	}
+}
+
+// ValidateQualityGateConditions validates the Quality Gate conditions against the metrics catalogue
+// The returned error names the index of the first invalid condition
+func ValidateQualityGateConditions(conditions []v1alpha1.QualityGateConditionParameters, catalog MetricCatalog) error {
+	for i := range conditions {
+		if err := ValidateQualityGateCondition(conditions[i], catalog); err != nil {
+			return fmt.Errorf("invalid Quality Gate condition at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateQualityGateCondition validates the metric, operator and error threshold of a Quality Gate condition against the metrics catalogue
+func ValidateQualityGateCondition(condition v1alpha1.QualityGateConditionParameters, catalog MetricCatalog) error {
+	if forbiddenQualityGateConditionMetrics[condition.Metric] {
+		return fmt.Errorf("metric %q cannot be used in a Quality Gate condition", condition.Metric)
+	}
+
+	metric, ok := catalog[condition.Metric]
+	if !ok {
+		return fmt.Errorf("unknown metric %q", condition.Metric)
+	}
+	if !qualityGateConditionMetricTypes[metric.Type] {
+		return fmt.Errorf("metric %q of type %s cannot be used in a Quality Gate condition", condition.Metric, metric.Type)
+	}
+
+	if condition.Op != nil {
+		if *condition.Op != "LT" && *condition.Op != "GT" {
+			return fmt.Errorf("operator %q is not supported, only LT and GT are", *condition.Op)
+		}
+		if metric.Type == MetricTypeRating && *condition.Op != "GT" {
+			return fmt.Errorf("operator %q is not supported for rating metric %q, only GT is", *condition.Op, condition.Metric)
+		}
+	}
+
+	return validateQualityGateConditionError(condition, metric.Type)
+}
+
+// validateQualityGateConditionError validates that the error threshold of a Quality Gate condition matches the type of its metric
+func validateQualityGateConditionError(condition v1alpha1.QualityGateConditionParameters, metricType string) error {
+	switch metricType {
+	case MetricTypeInt, MetricTypeMillisec, MetricTypeWorkDur:
+		if _, err := strconv.ParseInt(condition.Error, 10, 64); err != nil {
+			return fmt.Errorf("error threshold %q of metric %q must be an integer", condition.Error, condition.Metric)
+		}
+	case MetricTypeFloat, MetricTypePercent:
+		if _, err := strconv.ParseFloat(condition.Error, 64); err != nil {
+			return fmt.Errorf("error threshold %q of metric %q must be a number", condition.Error, condition.Metric)
+		}
+	case MetricTypeRating:
+		rating, err := strconv.Atoi(condition.Error)
+		if err != nil || rating < 1 || rating > 5 {
+			return fmt.Errorf("error threshold %q of rating metric %q must be between 1 (A) and 5 (E)", condition.Error, condition.Metric)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestMetricCatalogAddMetrics(t *testing.T) {
+	catalog := MetricCatalog{}
+	catalog.AddMetrics(nil)
+	catalog.AddMetrics(&sonargo.MetricsSearchObject{
+		Metrics: []sonargo.MetricsSearchObject_sub1{
+			{Key: "coverage", Type: "PERCENT"},
+			{Key: "bugs", Type: "INT"},
+		},
+	})
+
+	want := MetricCatalog{
+		"coverage": {Key: "coverage", Type: "PERCENT"},
+		"bugs":     {Key: "bugs", Type: "INT"},
+	}
+	if diff := cmp.Diff(want, catalog); diff != "" {
+		t.Errorf("AddMetrics() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHasMoreMetrics(t *testing.T) {
+	tests := map[string]struct {
+		search *sonargo.MetricsSearchObject
+		want   bool
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   false,
+		},
+		"LastPage": {
+			search: &sonargo.MetricsSearchObject{
+				Metrics: []sonargo.MetricsSearchObject_sub1{{Key: "coverage"}},
+				Paging:  sonargo.MetricsSearchObject_sub2{PageIndex: 1, PageSize: 500, Total: 1},
+			},
+			want: false,
+		},
+		"MorePages": {
+			search: &sonargo.MetricsSearchObject{
+				Metrics: []sonargo.MetricsSearchObject_sub1{{Key: "coverage"}},
+				Paging:  sonargo.MetricsSearchObject_sub2{PageIndex: 1, PageSize: 500, Total: 600},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := HasMoreMetrics(tc.search); got != tc.want {
+				t.Errorf("HasMoreMetrics() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateQualityGateCondition(t *testing.T) {
+	catalog := MetricCatalog{
+		"coverage":            {Key: "coverage", Type: "PERCENT"},
+		"new_violations":      {Key: "new_violations", Type: "INT"},
+		"new_security_rating": {Key: "new_security_rating", Type: "RATING"},
+		"ncloc_language":      {Key: "ncloc_language", Type: "DATA"},
+		"alert_status":        {Key: "alert_status", Type: "LEVEL"},
+	}
+
+	tests := map[string]struct {
+		condition v1alpha1.QualityGateConditionParameters
+		wantErr   bool
+	}{
+		"ValidPercent": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "coverage", Error: "80.5", Op: ptr.To("LT")},
+			wantErr:   false,
+		},
+		"ValidIntWithoutOperator": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "new_violations", Error: "0"},
+			wantErr:   false,
+		},
+		"ValidRating": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "new_security_rating", Error: "1", Op: ptr.To("GT")},
+			wantErr:   false,
+		},
+		"UnknownMetric": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "new_coverge", Error: "80", Op: ptr.To("LT")},
+			wantErr:   true,
+		},
+		"ForbiddenMetric": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "alert_status", Error: "ERROR"},
+			wantErr:   true,
+		},
+		"UnsupportedMetricType": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "ncloc_language", Error: "1"},
+			wantErr:   true,
+		},
+		"UnsupportedOperator": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "coverage", Error: "80", Op: ptr.To("EQ")},
+			wantErr:   true,
+		},
+		"RatingOnlySupportsGT": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "new_security_rating", Error: "1", Op: ptr.To("LT")},
+			wantErr:   true,
+		},
+		"RatingOutOfRange": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "new_security_rating", Error: "6", Op: ptr.To("GT")},
+			wantErr:   true,
+		},
+		"RatingLetter": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "new_security_rating", Error: "A", Op: ptr.To("GT")},
+			wantErr:   true,
+		},
+		"IntWithDecimals": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "new_violations", Error: "1.5", Op: ptr.To("GT")},
+			wantErr:   true,
+		},
+		"PercentNotANumber": {
+			condition: v1alpha1.QualityGateConditionParameters{Metric: "coverage", Error: "eighty", Op: ptr.To("LT")},
+			wantErr:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateQualityGateCondition(tc.condition, catalog)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateQualityGateCondition() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateQualityGateConditions(t *testing.T) {
+	catalog := MetricCatalog{
+		"coverage": {Key: "coverage", Type: "PERCENT"},
+	}
+
+	conditions := []v1alpha1.QualityGateConditionParameters{
+		{Metric: "coverage", Error: "80", Op: ptr.To("LT")},
+		{Metric: "new_coverge", Error: "80", Op: ptr.To("LT")},
+	}
+
+	err := ValidateQualityGateConditions(conditions, catalog)
+	want := `invalid Quality Gate condition at index 1: unknown metric "new_coverge"`
+	if err == nil || err.Error() != want {
+		t.Errorf("ValidateQualityGateConditions() error = %v, want %s", err, want)
+	}
+
+	if err := ValidateQualityGateConditions(conditions[:1], catalog); err != nil {
+		t.Errorf("ValidateQualityGateConditions() unexpected error = %v", err)
+	}
+}
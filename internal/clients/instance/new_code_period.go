@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// NewCodePeriodsClient is the interface for interacting with SonarQube New Code Periods API
+// It handles all the operations related to the new code definitions of the instance, Projects and branches in SonarQube, such as setting, unsetting and showing them.
+type NewCodePeriodsClient interface {
+	Set(opt *sonargo.NewCodePeriodsSetOption) (resp *http.Response, err error)
+	Show(opt *sonargo.NewCodePeriodsShowOption) (v *NewCodePeriodsShowObject, resp *http.Response, err error)
+	Unset(opt *sonargo.NewCodePeriodsUnsetOption) (resp *http.Response, err error)
+}
+
+// NewNewCodePeriodsClient creates a new NewCodePeriodsClient with the provided SonarQube client configuration.
+func NewNewCodePeriodsClient(clientConfig common.Config) NewCodePeriodsClient {
+	newClient := common.NewClient(clientConfig)
+	return &newCodePeriodsClient{
+		NewCodePeriodsService: newClient.NewCodePeriods,
+		client:                newClient,
+	}
+}
+
+// NewCodePeriodsShowObject is the response of the new_code_periods/show endpoint
+// The SonarQube client NewCodePeriodsShowObject omits the value and the effective value of the new code definition
+type NewCodePeriodsShowObject struct {
+	BranchKey      string `json:"branchKey,omitempty"`
+	EffectiveValue string `json:"effectiveValue,omitempty"`
+	Inherited      bool   `json:"inherited,omitempty"`
+	ProjectKey     string `json:"projectKey,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Value          string `json:"value,omitempty"`
+}
+
+// newCodePeriodsClient extends the SonarQube client NewCodePeriodsService with the endpoints it does not provide properly
+type newCodePeriodsClient struct {
+	*sonargo.NewCodePeriodsService
+	client *sonargo.Client
+}
+
+// Show retrieves the new code definition in effect for the instance, a Project or a branch
+func (c *newCodePeriodsClient) Show(opt *sonargo.NewCodePeriodsShowOption) (v *NewCodePeriodsShowObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "new_code_periods/show", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(NewCodePeriodsShowObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GenerateNewCodePeriodShowOption generates SonarQube NewCodePeriodsShowOption to retrieve the new code definition in effect in the scope
+func GenerateNewCodePeriodShowOption(spec v1alpha1.NewCodePeriodParameters) *sonargo.NewCodePeriodsShowOption {
+	return &sonargo.NewCodePeriodsShowOption{
+		Branch:  ptr.Deref(spec.Branch, ""),
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateNewCodePeriodSetOption generates SonarQube NewCodePeriodsSetOption from NewCodePeriodParameters
+func GenerateNewCodePeriodSetOption(spec v1alpha1.NewCodePeriodParameters) *sonargo.NewCodePeriodsSetOption {
+	return &sonargo.NewCodePeriodsSetOption{
+		Branch:  ptr.Deref(spec.Branch, ""),
+		Project: ptr.Deref(spec.ProjectKey, ""),
+		Type:    spec.Type,
+		Value:   ptr.Deref(spec.Value, ""),
+	}
+}
+
+// GenerateNewCodePeriodUnsetOption generates SonarQube NewCodePeriodsUnsetOption to unset the new code definition of the scope
+func GenerateNewCodePeriodUnsetOption(spec v1alpha1.NewCodePeriodParameters) *sonargo.NewCodePeriodsUnsetOption {
+	return &sonargo.NewCodePeriodsUnsetOption{
+		Branch:  ptr.Deref(spec.Branch, ""),
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateNewCodePeriodObservation generates NewCodePeriodObservation from a NewCodePeriodsShowObject
+func GenerateNewCodePeriodObservation(period *NewCodePeriodsShowObject) v1alpha1.NewCodePeriodObservation {
+	if period == nil {
+		return v1alpha1.NewCodePeriodObservation{}
+	}
+	return v1alpha1.NewCodePeriodObservation{
+		BranchKey:      period.BranchKey,
+		EffectiveValue: period.EffectiveValue,
+		Inherited:      period.Inherited,
+		ProjectKey:     period.ProjectKey,
+		Type:           period.Type,
+		Value:          period.Value,
+	}
+}
+
+// IsNewCodePeriodUnset checks whether the observed new code definition is not defined in the scope of NewCodePeriodParameters
+// The definition of the instance is never inherited, it is unset when it is the default PREVIOUS_VERSION one.
+func IsNewCodePeriodUnset(spec *v1alpha1.NewCodePeriodParameters, observation *v1alpha1.NewCodePeriodObservation) bool {
+	if observation == nil || observation.Inherited {
+		return true
+	}
+	if spec != nil && ptr.Deref(spec.Scope, v1alpha1.NewCodePeriodScopeInstance) == v1alpha1.NewCodePeriodScopeInstance {
+		return observation.Type == v1alpha1.NewCodePeriodTypePreviousVersion
+	}
+	return false
+}
+
+// IsNewCodePeriodUpToDate checks whether the observed new code definition is defined in the scope with the desired type and value
+// An inherited definition is never up to date, even if it equals the desired one, so that it is defined in the scope
+func IsNewCodePeriodUpToDate(spec *v1alpha1.NewCodePeriodParameters, observation *v1alpha1.NewCodePeriodObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil || observation.Inherited {
+		return false
+	}
+	return spec.Type == observation.Type && ptr.Deref(spec.Value, "") == observation.Value
+}
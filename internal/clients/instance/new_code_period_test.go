@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestIsNewCodePeriodUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		spec        v1alpha1.NewCodePeriodParameters
+		observation v1alpha1.NewCodePeriodObservation
+		want        bool
+	}{
+		"PreviousVersionUpToDate": {
+			spec:        v1alpha1.NewCodePeriodParameters{Type: v1alpha1.NewCodePeriodTypePreviousVersion},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypePreviousVersion},
+			want:        true,
+		},
+		"NumberOfDaysUpToDate": {
+			spec:        v1alpha1.NewCodePeriodParameters{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: ptr.To("30")},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "30", EffectiveValue: "30"},
+			want:        true,
+		},
+		"NumberOfDaysInherited": {
+			spec:        v1alpha1.NewCodePeriodParameters{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: ptr.To("30")},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "30", Inherited: true},
+		},
+		"ValueChanged": {
+			spec:        v1alpha1.NewCodePeriodParameters{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: ptr.To("30")},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "14"},
+		},
+		"TypeChanged": {
+			spec:        v1alpha1.NewCodePeriodParameters{Type: v1alpha1.NewCodePeriodTypeReferenceBranch, Value: ptr.To("main")},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypePreviousVersion},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNewCodePeriodUpToDate(&tc.spec, &tc.observation); got != tc.want {
+				t.Errorf("IsNewCodePeriodUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNewCodePeriodUnset(t *testing.T) {
+	tests := map[string]struct {
+		spec        v1alpha1.NewCodePeriodParameters
+		observation v1alpha1.NewCodePeriodObservation
+		want        bool
+	}{
+		"ProjectInherited": {
+			spec:        v1alpha1.NewCodePeriodParameters{Scope: ptr.To(v1alpha1.NewCodePeriodScopeProject), ProjectKey: ptr.To("my-project")},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypePreviousVersion, Inherited: true},
+			want:        true,
+		},
+		"ProjectDefined": {
+			spec:        v1alpha1.NewCodePeriodParameters{Scope: ptr.To(v1alpha1.NewCodePeriodScopeProject), ProjectKey: ptr.To("my-project")},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypePreviousVersion},
+		},
+		"InstanceDefault": {
+			spec:        v1alpha1.NewCodePeriodParameters{},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypePreviousVersion},
+			want:        true,
+		},
+		"InstanceDefined": {
+			spec:        v1alpha1.NewCodePeriodParameters{Scope: ptr.To(v1alpha1.NewCodePeriodScopeInstance)},
+			observation: v1alpha1.NewCodePeriodObservation{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "30"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNewCodePeriodUnset(&tc.spec, &tc.observation); got != tc.want {
+				t.Errorf("IsNewCodePeriodUnset() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewCodePeriodsClient(t *testing.T) {
+	var showQuery map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/new_code_periods/show":
+			showQuery = r.URL.Query()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"projectKey":"my-project","branchKey":"main","type":"REFERENCE_BRANCH","value":"develop","effectiveValue":"develop","inherited":false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewNewCodePeriodsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	spec := v1alpha1.NewCodePeriodParameters{
+		Scope:      ptr.To(v1alpha1.NewCodePeriodScopeBranch),
+		ProjectKey: ptr.To("my-project"),
+		Branch:     ptr.To("main"),
+		Type:       v1alpha1.NewCodePeriodTypeReferenceBranch,
+		Value:      ptr.To("develop"),
+	}
+
+	period, resp, err := client.Show(GenerateNewCodePeriodShowOption(spec))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Show() error = %v", err)
+	}
+	wantQuery := map[string][]string{
+		"branch":  {"main"},
+		"project": {"my-project"},
+	}
+	if diff := cmp.Diff(wantQuery, showQuery); diff != "" {
+		t.Errorf("Show() parameters mismatch (-want +got):\n%s", diff)
+	}
+	want := v1alpha1.NewCodePeriodObservation{
+		ProjectKey:     "my-project",
+		BranchKey:      "main",
+		Type:           v1alpha1.NewCodePeriodTypeReferenceBranch,
+		Value:          "develop",
+		EffectiveValue: "develop",
+	}
+	observation := GenerateNewCodePeriodObservation(period)
+	if diff := cmp.Diff(want, observation); diff != "" {
+		t.Errorf("Show() observation mismatch (-want +got):\n%s", diff)
+	}
+	if !IsNewCodePeriodUpToDate(&spec, &observation) {
+		t.Errorf("IsNewCodePeriodUpToDate() = false, want true")
+	}
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// PermissionsSearchPageSize is the number of items requested per page when listing the permissions of users or groups, the maximum allowed by SonarQube
+const PermissionsSearchPageSize = 100
+
+// permissionsQueryMinLength is the minimum length of the query accepted by SonarQube to search users or groups by name
+const permissionsQueryMinLength = 3
+
+// PermissionsClient is the interface for interacting with SonarQube Permissions API
+// It handles all the operations related to the global and project permissions of users and groups in SonarQube, and to the Permission Templates.
+type PermissionsClient interface {
+	AddGroup(opt *sonargo.PermissionsAddGroupOption) (resp *http.Response, err error)
+	AddGroupToTemplate(opt *sonargo.PermissionsAddGroupToTemplateOption) (resp *http.Response, err error)
+	AddProjectCreatorToTemplate(opt *sonargo.PermissionsAddProjectCreatorToTemplateOption) (resp *http.Response, err error)
+	AddUser(opt *sonargo.PermissionsAddUserOption) (resp *http.Response, err error)
+	AddUserToTemplate(opt *sonargo.PermissionsAddUserToTemplateOption) (resp *http.Response, err error)
+	CreateTemplate(opt *sonargo.PermissionsCreateTemplateOption) (v *PermissionsCreateTemplateObject, resp *http.Response, err error)
+	DeleteTemplate(opt *sonargo.PermissionsDeleteTemplateOption) (resp *http.Response, err error)
+	Groups(opt *sonargo.PermissionsGroupsOption) (v *PermissionsGroupsObject, resp *http.Response, err error)
+	RemoveGroup(opt *sonargo.PermissionsRemoveGroupOption) (resp *http.Response, err error)
+	RemoveGroupFromTemplate(opt *sonargo.PermissionsRemoveGroupFromTemplateOption) (resp *http.Response, err error)
+	RemoveProjectCreatorFromTemplate(opt *sonargo.PermissionsRemoveProjectCreatorFromTemplateOption) (resp *http.Response, err error)
+	RemoveUser(opt *sonargo.PermissionsRemoveUserOption) (resp *http.Response, err error)
+	RemoveUserFromTemplate(opt *sonargo.PermissionsRemoveUserFromTemplateOption) (resp *http.Response, err error)
+	SearchTemplates(opt *sonargo.PermissionsSearchTemplatesOption) (v *PermissionsSearchTemplatesObject, resp *http.Response, err error)
+	SetDefaultTemplate(opt *sonargo.PermissionsSetDefaultTemplateOption) (resp *http.Response, err error)
+	TemplateGroups(opt *sonargo.PermissionsTemplateGroupsOption) (v *sonargo.PermissionsTemplateGroupsObject, resp *http.Response, err error)
+	TemplateUsers(opt *sonargo.PermissionsTemplateUsersOption) (v *sonargo.PermissionsTemplateUsersObject, resp *http.Response, err error)
+	UpdateTemplate(opt *PermissionsUpdateTemplateOption) (resp *http.Response, err error)
+	Users(opt *sonargo.PermissionsUsersOption) (v *sonargo.PermissionsUsersObject, resp *http.Response, err error)
+}
+
+// NewPermissionsClient creates a new PermissionsClient with the provided SonarQube client configuration.
+func NewPermissionsClient(clientConfig common.Config) PermissionsClient {
+	newClient := common.NewClient(clientConfig)
+	return &permissionsClient{
+		PermissionsService: newClient.Permissions,
+		client:             newClient,
+	}
+}
+
+// PermissionsGroupsObject is the response of the permissions/groups endpoint
+// The SonarQube client PermissionsGroupsObject does not type the permissions of the groups
+type PermissionsGroupsObject struct {
+	Groups []PermissionsGroupObject             `json:"groups,omitempty"`
+	Paging sonargo.PermissionsGroupsObject_sub2 `json:"paging,omitempty"`
+}
+
+// PermissionsGroupObject is a group with its permissions
+type PermissionsGroupObject struct {
+	Description string   `json:"description,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Managed     bool     `json:"managed,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// permissionsClient extends the SonarQube client PermissionsService with the endpoints it does not provide properly
+type permissionsClient struct {
+	*sonargo.PermissionsService
+	client *sonargo.Client
+}
+
+// Groups lists the groups with their permissions
+func (c *permissionsClient) Groups(opt *sonargo.PermissionsGroupsOption) (v *PermissionsGroupsObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "permissions/groups", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(PermissionsGroupsObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// permissionProjectKey returns the key of the Project the permissions are granted on, empty for global permissions
+func permissionProjectKey(spec *v1alpha1.PermissionParameters) string {
+	if ptr.Deref(spec.Scope, v1alpha1.PermissionScopeGlobal) != v1alpha1.PermissionScopeProject {
+		return ""
+	}
+	return ptr.Deref(spec.ProjectKey, "")
+}
+
+// permissionsQuery returns the query narrowing the search down to the given login or group name, if it is long enough for SonarQube
+func permissionsQuery(name string) string {
+	if len(name) < permissionsQueryMinLength {
+		return ""
+	}
+	return name
+}
+
+// IsUserPermission returns true if the permissions are granted to a User rather than a Group
+func IsUserPermission(spec *v1alpha1.PermissionParameters) bool {
+	return spec.Login != nil
+}
+
+// GeneratePermissionUsersSearchOption generates SonarQube PermissionsUsersOption to list a page of the users with their permissions in the scope
+func GeneratePermissionUsersSearchOption(spec *v1alpha1.PermissionParameters, page int) *sonargo.PermissionsUsersOption {
+	return &sonargo.PermissionsUsersOption{
+		P:          strconv.Itoa(page),
+		ProjectKey: permissionProjectKey(spec),
+		Ps:         strconv.Itoa(PermissionsSearchPageSize),
+		Q:          permissionsQuery(ptr.Deref(spec.Login, "")),
+	}
+}
+
+// GeneratePermissionGroupsSearchOption generates SonarQube PermissionsGroupsOption to list a page of the groups with their permissions in the scope
+func GeneratePermissionGroupsSearchOption(spec *v1alpha1.PermissionParameters, page int) *sonargo.PermissionsGroupsOption {
+	return &sonargo.PermissionsGroupsOption{
+		P:          strconv.Itoa(page),
+		ProjectKey: permissionProjectKey(spec),
+		Ps:         strconv.Itoa(PermissionsSearchPageSize),
+		Q:          permissionsQuery(ptr.Deref(spec.GroupName, "")),
+	}
+}
+
+// FindUserPermissions finds the permissions of the user with the given login in a SonarQube PermissionsUsersObject
+// The boolean is false if the user is not in this page of results
+func FindUserPermissions(search *sonargo.PermissionsUsersObject, login string) ([]string, bool) {
+	if search == nil {
+		return nil, false
+	}
+	for _, user := range search.Users {
+		if user.Login == login {
+			return user.Permissions, true
+		}
+	}
+	return nil, false
+}
+
+// FindGroupPermissions finds the permissions of the group with the given name in a PermissionsGroupsObject
+// The special group 'anyone' is returned by SonarQube as 'Anyone', so names are compared ignoring case
+// The boolean is false if the group is not in this page of results
+func FindGroupPermissions(search *PermissionsGroupsObject, name string) ([]string, bool) {
+	if search == nil {
+		return nil, false
+	}
+	for _, group := range search.Groups {
+		if strings.EqualFold(group.Name, name) {
+			return group.Permissions, true
+		}
+	}
+	return nil, false
+}
+
+// HasMorePermissionUsers returns true if the SonarQube PermissionsUsersObject is not the last page of results
+func HasMorePermissionUsers(search *sonargo.PermissionsUsersObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Users), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// HasMorePermissionGroups returns true if the PermissionsGroupsObject is not the last page of results
+func HasMorePermissionGroups(search *PermissionsGroupsObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Groups), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// GeneratePermissionObservation generates PermissionObservation from the permissions held by the User or Group in the scope
+func GeneratePermissionObservation(permissions []string) v1alpha1.PermissionObservation {
+	return v1alpha1.PermissionObservation{
+		Permissions: permissions,
+	}
+}
+
+// PermissionExists checks whether the User or Group holds any of the permissions managed by the Permission
+// When exclusive, every permission in the scope is managed, so that it is removed on deletion
+func PermissionExists(spec *v1alpha1.PermissionParameters, observation *v1alpha1.PermissionObservation) bool {
+	return len(FindPermissionsToRevoke(spec, observation)) > 0
+}
+
+// FindPermissionsToAdd finds the desired permissions that the User or Group does not hold yet
+func FindPermissionsToAdd(spec *v1alpha1.PermissionParameters, observation *v1alpha1.PermissionObservation) []string {
+	return findMissingStrings(spec.Permissions, observation.Permissions)
+}
+
+// FindPermissionsToRemove finds the permissions held by the User or Group that are not desired
+// Permissions are only removed when exclusive, otherwise the permissions not listed are left untouched
+func FindPermissionsToRemove(spec *v1alpha1.PermissionParameters, observation *v1alpha1.PermissionObservation) []string {
+	if !ptr.Deref(spec.Exclusive, false) {
+		return nil
+	}
+	return findMissingStrings(observation.Permissions, spec.Permissions)
+}
+
+// FindPermissionsToRevoke finds the permissions to remove when the Permission is deleted
+// Those are all the permissions in the scope when exclusive, and the held desired permissions otherwise
+func FindPermissionsToRevoke(spec *v1alpha1.PermissionParameters, observation *v1alpha1.PermissionObservation) []string {
+	if ptr.Deref(spec.Exclusive, false) {
+		return observation.Permissions
+	}
+	held := make(map[string]bool, len(observation.Permissions))
+	for _, permission := range observation.Permissions {
+		held[permission] = true
+	}
+	var permissions []string
+	for _, permission := range spec.Permissions {
+		if held[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// IsPermissionUpToDate checks whether the permissions held by the User or Group match the desired ones
+func IsPermissionUpToDate(spec *v1alpha1.PermissionParameters, observation *v1alpha1.PermissionObservation) bool {
+	return len(FindPermissionsToAdd(spec, observation)) == 0 && len(FindPermissionsToRemove(spec, observation)) == 0
+}
+
+// GeneratePermissionAddUserOption generates SonarQube PermissionsAddUserOption to grant a permission to the User
+func GeneratePermissionAddUserOption(spec *v1alpha1.PermissionParameters, permission string) *sonargo.PermissionsAddUserOption {
+	return &sonargo.PermissionsAddUserOption{
+		Login:      ptr.Deref(spec.Login, ""),
+		Permission: permission,
+		ProjectKey: permissionProjectKey(spec),
+	}
+}
+
+// GeneratePermissionRemoveUserOption generates SonarQube PermissionsRemoveUserOption to revoke a permission from the User
+func GeneratePermissionRemoveUserOption(spec *v1alpha1.PermissionParameters, permission string) *sonargo.PermissionsRemoveUserOption {
+	return &sonargo.PermissionsRemoveUserOption{
+		Login:      ptr.Deref(spec.Login, ""),
+		Permission: permission,
+		ProjectKey: permissionProjectKey(spec),
+	}
+}
+
+// GeneratePermissionAddGroupOption generates SonarQube PermissionsAddGroupOption to grant a permission to the Group
+func GeneratePermissionAddGroupOption(spec *v1alpha1.PermissionParameters, permission string) *sonargo.PermissionsAddGroupOption {
+	return &sonargo.PermissionsAddGroupOption{
+		GroupName:  ptr.Deref(spec.GroupName, ""),
+		Permission: permission,
+		ProjectKey: permissionProjectKey(spec),
+	}
+}
+
+// GeneratePermissionRemoveGroupOption generates SonarQube PermissionsRemoveGroupOption to revoke a permission from the Group
+func GeneratePermissionRemoveGroupOption(spec *v1alpha1.PermissionParameters, permission string) *sonargo.PermissionsRemoveGroupOption {
+	return &sonargo.PermissionsRemoveGroupOption{
+		GroupName:  ptr.Deref(spec.GroupName, ""),
+		Permission: permission,
+		ProjectKey: permissionProjectKey(spec),
+	}
+}
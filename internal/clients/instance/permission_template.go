@@ -0,0 +1,481 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// permissionTemplateQualifiers maps the qualifiers of the PermissionTemplate API to the ones of SonarQube
+var permissionTemplateQualifiers = map[string]string{
+	"Project":     "TRK",
+	"Application": "APP",
+	"Portfolio":   "VW",
+}
+
+// PermissionTemplateObject is a Permission Template
+// The SonarQube client objects omit the ID of a created Permission Template and the project key pattern of searched ones
+type PermissionTemplateObject struct {
+	CreatedAt         string                                          `json:"createdAt,omitempty"`
+	Description       string                                          `json:"description,omitempty"`
+	ID                string                                          `json:"id,omitempty"`
+	Name              string                                          `json:"name,omitempty"`
+	Permissions       []sonargo.PermissionsSearchTemplatesObject_sub2 `json:"permissions,omitempty"`
+	ProjectKeyPattern string                                          `json:"projectKeyPattern,omitempty"`
+	UpdatedAt         string                                          `json:"updatedAt,omitempty"`
+}
+
+// PermissionsCreateTemplateObject is the response of the permissions/create_template endpoint
+type PermissionsCreateTemplateObject struct {
+	PermissionTemplate PermissionTemplateObject `json:"permissionTemplate,omitempty"`
+}
+
+// PermissionsSearchTemplatesObject is the response of the permissions/search_templates endpoint
+type PermissionsSearchTemplatesObject struct {
+	DefaultTemplates    []sonargo.PermissionsSearchTemplatesObject_sub1 `json:"defaultTemplates,omitempty"`
+	PermissionTemplates []PermissionTemplateObject                      `json:"permissionTemplates,omitempty"`
+}
+
+// PermissionsUpdateTemplateOption is the option of the permissions/update_template endpoint
+// The SonarQube client PermissionsUpdateTemplateOption omits an empty description and project key pattern, so that they cannot be cleared
+type PermissionsUpdateTemplateOption struct {
+	Description       *string `url:"description,omitempty"`       // Description:"Description",ExampleValue:"Permissions for all projects related to the financial service"
+	Id                string  `url:"id,omitempty"`                // Description:"Id",ExampleValue:"af8cb8cc-1e78-4c4e-8c00-ee8e814009a5"
+	Name              string  `url:"name,omitempty"`              // Description:"Name",ExampleValue:"Financial Service Permissions"
+	ProjectKeyPattern *string `url:"projectKeyPattern,omitempty"` // Description:"Project key pattern. Must be a valid Java regular expression",ExampleValue:".*\\.finance\\..*"
+}
+
+// CreateTemplate creates a Permission Template
+func (c *permissionsClient) CreateTemplate(opt *sonargo.PermissionsCreateTemplateOption) (v *PermissionsCreateTemplateObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "permissions/create_template", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(PermissionsCreateTemplateObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// SearchTemplates lists the Permission Templates and the default ones
+func (c *permissionsClient) SearchTemplates(opt *sonargo.PermissionsSearchTemplatesOption) (v *PermissionsSearchTemplatesObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "permissions/search_templates", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(PermissionsSearchTemplatesObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// UpdateTemplate updates the name, the description and the project key pattern of a Permission Template
+func (c *permissionsClient) UpdateTemplate(opt *PermissionsUpdateTemplateOption) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "permissions/update_template", opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// GeneratePermissionTemplateCreateOption generates SonarQube PermissionsCreateTemplateOption from PermissionTemplateParameters
+func GeneratePermissionTemplateCreateOption(spec v1alpha1.PermissionTemplateParameters) *sonargo.PermissionsCreateTemplateOption {
+	return &sonargo.PermissionsCreateTemplateOption{
+		Description:       ptr.Deref(spec.Description, ""),
+		Name:              spec.Name,
+		ProjectKeyPattern: ptr.Deref(spec.ProjectKeyPattern, ""),
+	}
+}
+
+// GeneratePermissionTemplateSearchOption generates SonarQube PermissionsSearchTemplatesOption to list all the Permission Templates
+func GeneratePermissionTemplateSearchOption() *sonargo.PermissionsSearchTemplatesOption {
+	return &sonargo.PermissionsSearchTemplatesOption{}
+}
+
+// GeneratePermissionTemplateUpdateOption generates PermissionsUpdateTemplateOption from PermissionTemplateParameters
+// The description and the project key pattern are only sent when specified, so that they can be cleared by specifying them empty
+func GeneratePermissionTemplateUpdateOption(id string, spec v1alpha1.PermissionTemplateParameters) *PermissionsUpdateTemplateOption {
+	return &PermissionsUpdateTemplateOption{
+		Description:       spec.Description,
+		Id:                id,
+		Name:              spec.Name,
+		ProjectKeyPattern: spec.ProjectKeyPattern,
+	}
+}
+
+// GeneratePermissionTemplateDeleteOption generates SonarQube PermissionsDeleteTemplateOption to delete a Permission Template
+func GeneratePermissionTemplateDeleteOption(id string) *sonargo.PermissionsDeleteTemplateOption {
+	return &sonargo.PermissionsDeleteTemplateOption{
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateSetDefaultOption generates SonarQube PermissionsSetDefaultTemplateOption to make a Permission Template the default one for a qualifier
+func GeneratePermissionTemplateSetDefaultOption(id, qualifier string) *sonargo.PermissionsSetDefaultTemplateOption {
+	return &sonargo.PermissionsSetDefaultTemplateOption{
+		Qualifier:  permissionTemplateQualifiers[qualifier],
+		TemplateId: id,
+	}
+}
+
+// FindPermissionTemplateByID finds the Permission Template with the given ID in a PermissionsSearchTemplatesObject
+func FindPermissionTemplateByID(search *PermissionsSearchTemplatesObject, id string) *PermissionTemplateObject {
+	if search == nil {
+		return nil
+	}
+	for i := range search.PermissionTemplates {
+		if search.PermissionTemplates[i].ID == id {
+			return &search.PermissionTemplates[i]
+		}
+	}
+	return nil
+}
+
+// FindPermissionTemplateByName finds the Permission Template with the given name in a PermissionsSearchTemplatesObject
+func FindPermissionTemplateByName(search *PermissionsSearchTemplatesObject, name string) *PermissionTemplateObject {
+	if search == nil {
+		return nil
+	}
+	for i := range search.PermissionTemplates {
+		if search.PermissionTemplates[i].Name == name {
+			return &search.PermissionTemplates[i]
+		}
+	}
+	return nil
+}
+
+// GeneratePermissionTemplateObservation generates PermissionTemplateObservation from a PermissionTemplateObject and the default Permission Templates
+// template should not be nil, else it will panic
+func GeneratePermissionTemplateObservation(template *PermissionTemplateObject, defaults []sonargo.PermissionsSearchTemplatesObject_sub1) v1alpha1.PermissionTemplateObservation {
+	observation := v1alpha1.PermissionTemplateObservation{
+		CreatedAt:         template.CreatedAt,
+		Description:       template.Description,
+		ID:                template.ID,
+		Name:              template.Name,
+		ProjectKeyPattern: template.ProjectKeyPattern,
+		UpdatedAt:         template.UpdatedAt,
+	}
+	for _, permission := range template.Permissions {
+		if permission.WithProjectCreator {
+			observation.ProjectCreatorPermissions = append(observation.ProjectCreatorPermissions, permission.Key)
+		}
+	}
+	for _, qualifier := range []string{"Project", "Application", "Portfolio"} {
+		for _, def := range defaults {
+			if def.TemplateID == template.ID && def.Qualifier == permissionTemplateQualifiers[qualifier] {
+				observation.DefaultFor = append(observation.DefaultFor, qualifier)
+			}
+		}
+	}
+	return observation
+}
+
+// GeneratePermissionTemplateUsersSearchOption generates SonarQube PermissionsTemplateUsersOption to list a page of the users granted permissions by a Permission Template
+func GeneratePermissionTemplateUsersSearchOption(id string, page int) *sonargo.PermissionsTemplateUsersOption {
+	return &sonargo.PermissionsTemplateUsersOption{
+		P:          strconv.Itoa(page),
+		Ps:         strconv.Itoa(PermissionsSearchPageSize),
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateGroupsSearchOption generates SonarQube PermissionsTemplateGroupsOption to list a page of the groups granted permissions by a Permission Template
+func GeneratePermissionTemplateGroupsSearchOption(id string, page int) *sonargo.PermissionsTemplateGroupsOption {
+	return &sonargo.PermissionsTemplateGroupsOption{
+		P:          strconv.Itoa(page),
+		Ps:         strconv.Itoa(PermissionsSearchPageSize),
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateUsersObservation extracts the users granted permissions from a SonarQube PermissionsTemplateUsersObject
+func GeneratePermissionTemplateUsersObservation(search *sonargo.PermissionsTemplateUsersObject) []v1alpha1.PermissionTemplateUserObservation {
+	if search == nil {
+		return nil
+	}
+	users := make([]v1alpha1.PermissionTemplateUserObservation, 0, len(search.Users))
+	for _, user := range search.Users {
+		if len(user.Permissions) > 0 {
+			users = append(users, v1alpha1.PermissionTemplateUserObservation{Login: user.Login, Permissions: user.Permissions})
+		}
+	}
+	return users
+}
+
+// GeneratePermissionTemplateGroupsObservation extracts the groups granted permissions from a SonarQube PermissionsTemplateGroupsObject
+func GeneratePermissionTemplateGroupsObservation(search *sonargo.PermissionsTemplateGroupsObject) []v1alpha1.PermissionTemplateGroupObservation {
+	if search == nil {
+		return nil
+	}
+	groups := make([]v1alpha1.PermissionTemplateGroupObservation, 0, len(search.Groups))
+	for _, group := range search.Groups {
+		if len(group.Permissions) > 0 {
+			groups = append(groups, v1alpha1.PermissionTemplateGroupObservation{GroupName: group.Name, Permissions: group.Permissions})
+		}
+	}
+	return groups
+}
+
+// HasMorePermissionTemplateUsers returns true if the SonarQube PermissionsTemplateUsersObject is not the last page of results
+func HasMorePermissionTemplateUsers(search *sonargo.PermissionsTemplateUsersObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Users), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// HasMorePermissionTemplateGroups returns true if the SonarQube PermissionsTemplateGroupsObject is not the last page of results
+func HasMorePermissionTemplateGroups(search *sonargo.PermissionsTemplateGroupsObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Groups), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// LateInitializePermissionTemplate fills the empty fields in *PermissionTemplateParameters with
+// the values seen in PermissionTemplateObservation.
+func LateInitializePermissionTemplate(spec *v1alpha1.PermissionTemplateParameters, observation *v1alpha1.PermissionTemplateObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	helpers.AssignIfNil(&spec.Description, observation.Description)
+	helpers.AssignIfNil(&spec.ProjectKeyPattern, observation.ProjectKeyPattern)
+}
+
+// PermissionTemplateEntryAssociation associates the permissions a Permission Template grants to a user or group with the desired ones
+// Spec is nil when the user or group is not desired, and Observation is nil when it is not granted any permission yet
+type PermissionTemplateEntryAssociation struct {
+	Name        string
+	Observation []string
+	Spec        []string
+	UpToDate    bool
+}
+
+// GeneratePermissionTemplateUsersAssociation generates associations between the desired and observed users of a Permission Template, keyed by login
+// If the desired users are nil, they are not managed and no association is generated
+func GeneratePermissionTemplateUsersAssociation(specs []v1alpha1.PermissionTemplateUserParameters, observations []v1alpha1.PermissionTemplateUserObservation) map[string]PermissionTemplateEntryAssociation {
+	associations := make(map[string]PermissionTemplateEntryAssociation)
+	if specs == nil {
+		return associations
+	}
+	for _, observation := range observations {
+		associations[observation.Login] = PermissionTemplateEntryAssociation{Name: observation.Login, Observation: observation.Permissions}
+	}
+	for _, spec := range specs {
+		assoc := associations[spec.Login]
+		assoc.Name = spec.Login
+		assoc.Spec = spec.Permissions
+		associations[spec.Login] = assoc
+	}
+	return updatePermissionTemplateEntriesUpToDate(associations)
+}
+
+// GeneratePermissionTemplateGroupsAssociation generates associations between the desired and observed groups of a Permission Template, keyed by lowercase name
+// The special group 'anyone' is returned by SonarQube as 'Anyone', so names are compared ignoring case
+// If the desired groups are nil, they are not managed and no association is generated
+func GeneratePermissionTemplateGroupsAssociation(specs []v1alpha1.PermissionTemplateGroupParameters, observations []v1alpha1.PermissionTemplateGroupObservation) map[string]PermissionTemplateEntryAssociation {
+	associations := make(map[string]PermissionTemplateEntryAssociation)
+	if specs == nil {
+		return associations
+	}
+	for _, observation := range observations {
+		associations[strings.ToLower(observation.GroupName)] = PermissionTemplateEntryAssociation{Name: observation.GroupName, Observation: observation.Permissions}
+	}
+	for _, spec := range specs {
+		key := strings.ToLower(spec.GroupName)
+		assoc := associations[key]
+		assoc.Name = spec.GroupName
+		assoc.Spec = spec.Permissions
+		associations[key] = assoc
+	}
+	return updatePermissionTemplateEntriesUpToDate(associations)
+}
+
+// updatePermissionTemplateEntriesUpToDate computes whether each association is up to date
+func updatePermissionTemplateEntriesUpToDate(associations map[string]PermissionTemplateEntryAssociation) map[string]PermissionTemplateEntryAssociation {
+	for key, assoc := range associations {
+		assoc.UpToDate = assoc.Spec != nil && assoc.Observation != nil && helpers.IsComparableSliceEqualIgnoringOrder(assoc.Spec, assoc.Observation)
+		associations[key] = assoc
+	}
+	return associations
+}
+
+// ArePermissionTemplateEntriesUpToDate checks whether the users or groups of a Permission Template are up to date with the desired ones
+func ArePermissionTemplateEntriesUpToDate(associations map[string]PermissionTemplateEntryAssociation) bool {
+	for _, assoc := range associations {
+		if !assoc.UpToDate {
+			return false
+		}
+	}
+	return true
+}
+
+// FindNonExistingPermissionTemplateEntries finds the desired users or groups that are not granted any permission by the Permission Template yet
+func FindNonExistingPermissionTemplateEntries(associations map[string]PermissionTemplateEntryAssociation) []PermissionTemplateEntryAssociation {
+	var nonExisting []PermissionTemplateEntryAssociation
+	for _, assoc := range associations {
+		if assoc.Observation == nil && assoc.Spec != nil {
+			nonExisting = append(nonExisting, assoc)
+		}
+	}
+	return nonExisting
+}
+
+// FindMissingPermissionTemplateEntries finds the users or groups granted permissions by the Permission Template that are no longer desired
+func FindMissingPermissionTemplateEntries(associations map[string]PermissionTemplateEntryAssociation) []PermissionTemplateEntryAssociation {
+	var missing []PermissionTemplateEntryAssociation
+	for _, assoc := range associations {
+		if assoc.Spec == nil && assoc.Observation != nil {
+			missing = append(missing, assoc)
+		}
+	}
+	return missing
+}
+
+// FindNotUpToDatePermissionTemplateEntries finds the users or groups whose permissions differ from the desired ones
+// This ignores associations where either Spec or Observation is nil
+func FindNotUpToDatePermissionTemplateEntries(associations map[string]PermissionTemplateEntryAssociation) []PermissionTemplateEntryAssociation {
+	var notUpToDate []PermissionTemplateEntryAssociation
+	for _, assoc := range associations {
+		if !assoc.UpToDate && assoc.Spec != nil && assoc.Observation != nil {
+			notUpToDate = append(notUpToDate, assoc)
+		}
+	}
+	return notUpToDate
+}
+
+// FindPermissionTemplateEntryPermissionsToAdd finds the desired permissions not granted to the user or group yet
+func FindPermissionTemplateEntryPermissionsToAdd(assoc PermissionTemplateEntryAssociation) []string {
+	return findMissingStrings(assoc.Spec, assoc.Observation)
+}
+
+// FindPermissionTemplateEntryPermissionsToRemove finds the permissions granted to the user or group that are not desired
+func FindPermissionTemplateEntryPermissionsToRemove(assoc PermissionTemplateEntryAssociation) []string {
+	return findMissingStrings(assoc.Observation, assoc.Spec)
+}
+
+// FindProjectCreatorPermissionsToAdd finds the desired permissions of the Project creator not granted by the Permission Template yet
+func FindProjectCreatorPermissionsToAdd(spec *v1alpha1.PermissionTemplateParameters, observation *v1alpha1.PermissionTemplateObservation) []string {
+	return findMissingStrings(spec.ProjectCreatorPermissions, observation.ProjectCreatorPermissions)
+}
+
+// FindProjectCreatorPermissionsToRemove finds the permissions of the Project creator granted by the Permission Template that are not desired
+// If the desired permissions are nil, they are not managed and nothing is removed
+func FindProjectCreatorPermissionsToRemove(spec *v1alpha1.PermissionTemplateParameters, observation *v1alpha1.PermissionTemplateObservation) []string {
+	if spec.ProjectCreatorPermissions == nil {
+		return nil
+	}
+	return findMissingStrings(observation.ProjectCreatorPermissions, spec.ProjectCreatorPermissions)
+}
+
+// FindPermissionTemplateDefaultsToSet finds the qualifiers the Permission Template should be, but is not yet, the default one for
+func FindPermissionTemplateDefaultsToSet(spec *v1alpha1.PermissionTemplateParameters, observation *v1alpha1.PermissionTemplateObservation) []string {
+	return findMissingStrings(spec.DefaultFor, observation.DefaultFor)
+}
+
+// IsPermissionTemplateUpToDate checks whether the observed Permission Template is up to date with the desired PermissionTemplateParameters
+func IsPermissionTemplateUpToDate(spec *v1alpha1.PermissionTemplateParameters, observation *v1alpha1.PermissionTemplateObservation, users, groups map[string]PermissionTemplateEntryAssociation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if spec.Name != observation.Name {
+		return false
+	}
+	if !helpers.IsComparablePtrEqualComparable(spec.Description, observation.Description) {
+		return false
+	}
+	if !helpers.IsComparablePtrEqualComparable(spec.ProjectKeyPattern, observation.ProjectKeyPattern) {
+		return false
+	}
+	if len(FindProjectCreatorPermissionsToAdd(spec, observation)) > 0 || len(FindProjectCreatorPermissionsToRemove(spec, observation)) > 0 {
+		return false
+	}
+	if len(FindPermissionTemplateDefaultsToSet(spec, observation)) > 0 {
+		return false
+	}
+
+	return ArePermissionTemplateEntriesUpToDate(users) && ArePermissionTemplateEntriesUpToDate(groups)
+}
+
+// GeneratePermissionTemplateAddUserOption generates SonarQube PermissionsAddUserToTemplateOption to grant a permission to a user
+func GeneratePermissionTemplateAddUserOption(id, login, permission string) *sonargo.PermissionsAddUserToTemplateOption {
+	return &sonargo.PermissionsAddUserToTemplateOption{
+		Login:      login,
+		Permission: permission,
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateRemoveUserOption generates SonarQube PermissionsRemoveUserFromTemplateOption to revoke a permission from a user
+func GeneratePermissionTemplateRemoveUserOption(id, login, permission string) *sonargo.PermissionsRemoveUserFromTemplateOption {
+	return &sonargo.PermissionsRemoveUserFromTemplateOption{
+		Login:      login,
+		Permission: permission,
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateAddGroupOption generates SonarQube PermissionsAddGroupToTemplateOption to grant a permission to a group
+func GeneratePermissionTemplateAddGroupOption(id, groupName, permission string) *sonargo.PermissionsAddGroupToTemplateOption {
+	return &sonargo.PermissionsAddGroupToTemplateOption{
+		GroupName:  groupName,
+		Permission: permission,
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateRemoveGroupOption generates SonarQube PermissionsRemoveGroupFromTemplateOption to revoke a permission from a group
+func GeneratePermissionTemplateRemoveGroupOption(id, groupName, permission string) *sonargo.PermissionsRemoveGroupFromTemplateOption {
+	return &sonargo.PermissionsRemoveGroupFromTemplateOption{
+		GroupName:  groupName,
+		Permission: permission,
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateAddProjectCreatorOption generates SonarQube PermissionsAddProjectCreatorToTemplateOption to grant a permission to the Project creator
+func GeneratePermissionTemplateAddProjectCreatorOption(id, permission string) *sonargo.PermissionsAddProjectCreatorToTemplateOption {
+	return &sonargo.PermissionsAddProjectCreatorToTemplateOption{
+		Permission: permission,
+		TemplateId: id,
+	}
+}
+
+// GeneratePermissionTemplateRemoveProjectCreatorOption generates SonarQube PermissionsRemoveProjectCreatorFromTemplateOption to revoke a permission from the Project creator
+func GeneratePermissionTemplateRemoveProjectCreatorOption(id, permission string) *sonargo.PermissionsRemoveProjectCreatorFromTemplateOption {
+	return &sonargo.PermissionsRemoveProjectCreatorFromTemplateOption{
+		Permission: permission,
+		TemplateId: id,
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestGeneratePermissionTemplateObservation(t *testing.T) {
+	template := &PermissionTemplateObject{
+		ID:                "tpl-1",
+		Name:              "Default",
+		ProjectKeyPattern: "finance.*",
+		Permissions: []sonargo.PermissionsSearchTemplatesObject_sub2{
+			{Key: "admin", WithProjectCreator: true},
+			{Key: "scan"},
+			{Key: "user", WithProjectCreator: true},
+		},
+	}
+	defaults := []sonargo.PermissionsSearchTemplatesObject_sub1{
+		{Qualifier: "VW", TemplateID: "tpl-1"},
+		{Qualifier: "APP", TemplateID: "tpl-2"},
+		{Qualifier: "TRK", TemplateID: "tpl-1"},
+	}
+
+	want := v1alpha1.PermissionTemplateObservation{
+		ID:                        "tpl-1",
+		Name:                      "Default",
+		ProjectKeyPattern:         "finance.*",
+		ProjectCreatorPermissions: []string{"admin", "user"},
+		DefaultFor:                []string{"Project", "Portfolio"},
+	}
+	if diff := cmp.Diff(want, GeneratePermissionTemplateObservation(template, defaults)); diff != "" {
+		t.Errorf("GeneratePermissionTemplateObservation() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPermissionTemplateEntriesDiff(t *testing.T) {
+	type want struct {
+		nonExisting []string
+		missing     []string
+		notUpToDate []string
+		upToDate    bool
+	}
+
+	names := func(associations []PermissionTemplateEntryAssociation) []string {
+		var result []string
+		for _, assoc := range associations {
+			result = append(result, assoc.Name)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	tests := map[string]struct {
+		specs        []v1alpha1.PermissionTemplateGroupParameters
+		observations []v1alpha1.PermissionTemplateGroupObservation
+		want         want
+	}{
+		"Unmanaged": {
+			observations: []v1alpha1.PermissionTemplateGroupObservation{{GroupName: "developers", Permissions: []string{"user"}}},
+			want:         want{upToDate: true},
+		},
+		"UpToDateIgnoringCaseAndOrder": {
+			specs:        []v1alpha1.PermissionTemplateGroupParameters{{GroupName: "anyone", Permissions: []string{"user", "codeviewer"}}},
+			observations: []v1alpha1.PermissionTemplateGroupObservation{{GroupName: "Anyone", Permissions: []string{"codeviewer", "user"}}},
+			want:         want{upToDate: true},
+		},
+		"Drift": {
+			specs: []v1alpha1.PermissionTemplateGroupParameters{
+				{GroupName: "developers", Permissions: []string{"user", "scan"}},
+				{GroupName: "qa", Permissions: []string{"issueadmin"}},
+			},
+			observations: []v1alpha1.PermissionTemplateGroupObservation{
+				{GroupName: "developers", Permissions: []string{"user"}},
+				{GroupName: "legacy", Permissions: []string{"admin"}},
+			},
+			want: want{nonExisting: []string{"qa"}, missing: []string{"legacy"}, notUpToDate: []string{"developers"}},
+		},
+		"EmptyRemovesAll": {
+			specs:        []v1alpha1.PermissionTemplateGroupParameters{},
+			observations: []v1alpha1.PermissionTemplateGroupObservation{{GroupName: "developers", Permissions: []string{"user"}}},
+			want:         want{missing: []string{"developers"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			associations := GeneratePermissionTemplateGroupsAssociation(tc.specs, tc.observations)
+			got := want{
+				nonExisting: names(FindNonExistingPermissionTemplateEntries(associations)),
+				missing:     names(FindMissingPermissionTemplateEntries(associations)),
+				notUpToDate: names(FindNotUpToDatePermissionTemplateEntries(associations)),
+				upToDate:    ArePermissionTemplateEntriesUpToDate(associations),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("permission template entries diff mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPermissionTemplateUpToDate(t *testing.T) {
+	observation := &v1alpha1.PermissionTemplateObservation{
+		Name:                      "Default",
+		Description:               "For all projects",
+		ProjectCreatorPermissions: []string{"admin"},
+		DefaultFor:                []string{"Project"},
+	}
+
+	tests := map[string]struct {
+		spec v1alpha1.PermissionTemplateParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: v1alpha1.PermissionTemplateParameters{Name: "Default", Description: ptr.To("For all projects"), DefaultFor: []string{"Project"}},
+			want: true,
+		},
+		"Renamed": {
+			spec: v1alpha1.PermissionTemplateParameters{Name: "Finance"},
+		},
+		"ProjectCreatorPermissionRemoved": {
+			spec: v1alpha1.PermissionTemplateParameters{Name: "Default", ProjectCreatorPermissions: []string{}},
+		},
+		"NotDefaultForApplications": {
+			spec: v1alpha1.PermissionTemplateParameters{Name: "Default", DefaultFor: []string{"Project", "Application"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPermissionTemplateUpToDate(&tc.spec, observation, nil, nil); got != tc.want {
+				t.Errorf("IsPermissionTemplateUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPermissionsClientTemplates(t *testing.T) {
+	var updateQuery map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/permissions/create_template":
+			_, _ = w.Write([]byte(`{"permissionTemplate":{"id":"tpl-1","name":"Finance","projectKeyPattern":"finance.*"}}`))
+		case "/api/permissions/search_templates":
+			_, _ = w.Write([]byte(`{"permissionTemplates":[{"id":"tpl-1","name":"Finance","projectKeyPattern":"finance.*"}],"defaultTemplates":[{"templateId":"tpl-1","qualifier":"TRK"}]}`))
+		case "/api/permissions/update_template":
+			updateQuery = r.URL.Query()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewPermissionsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	spec := v1alpha1.PermissionTemplateParameters{Name: "Finance", Description: ptr.To(""), ProjectKeyPattern: ptr.To("finance.*")}
+
+	created, resp, err := client.CreateTemplate(GeneratePermissionTemplateCreateOption(spec))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("CreateTemplate() error = %v", err)
+	}
+	if created.PermissionTemplate.ID != "tpl-1" {
+		t.Errorf("CreateTemplate() ID = %q, want tpl-1", created.PermissionTemplate.ID)
+	}
+
+	search, resp, err := client.SearchTemplates(GeneratePermissionTemplateSearchOption())
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("SearchTemplates() error = %v", err)
+	}
+	template := FindPermissionTemplateByID(search, "tpl-1")
+	if template == nil {
+		t.Fatalf("FindPermissionTemplateByID() did not find tpl-1")
+	}
+	want := v1alpha1.PermissionTemplateObservation{ID: "tpl-1", Name: "Finance", ProjectKeyPattern: "finance.*", DefaultFor: []string{"Project"}}
+	if diff := cmp.Diff(want, GeneratePermissionTemplateObservation(template, search.DefaultTemplates)); diff != "" {
+		t.Errorf("SearchTemplates() observation mismatch (-want +got):\n%s", diff)
+	}
+
+	resp, err = client.UpdateTemplate(GeneratePermissionTemplateUpdateOption("tpl-1", spec))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("UpdateTemplate() error = %v", err)
+	}
+	// An empty description is sent so that it is cleared
+	wantQuery := map[string][]string{"description": {""}, "id": {"tpl-1"}, "name": {"Finance"}, "projectKeyPattern": {"finance.*"}}
+	if diff := cmp.Diff(wantQuery, updateQuery); diff != "" {
+		t.Errorf("UpdateTemplate() parameters mismatch (-want +got):\n%s", diff)
+	}
+}
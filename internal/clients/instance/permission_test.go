@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestGeneratePermissionSearchOptions(t *testing.T) {
+	project := &v1alpha1.PermissionParameters{Scope: ptr.To(v1alpha1.PermissionScopeProject), ProjectKey: ptr.To("my-project"), Login: ptr.To("jdoe")}
+	if diff := cmp.Diff(&sonargo.PermissionsUsersOption{P: "2", Ps: "100", ProjectKey: "my-project", Q: "jdoe"}, GeneratePermissionUsersSearchOption(project, 2)); diff != "" {
+		t.Errorf("GeneratePermissionUsersSearchOption() mismatch (-want +got):\n%s", diff)
+	}
+
+	global := &v1alpha1.PermissionParameters{Scope: ptr.To(v1alpha1.PermissionScopeGlobal), ProjectKey: ptr.To("ignored"), GroupName: ptr.To("qa")}
+	if diff := cmp.Diff(&sonargo.PermissionsGroupsOption{P: "1", Ps: "100"}, GeneratePermissionGroupsSearchOption(global, 1)); diff != "" {
+		t.Errorf("GeneratePermissionGroupsSearchOption() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindGroupPermissions(t *testing.T) {
+	search := &PermissionsGroupsObject{
+		Groups: []PermissionsGroupObject{
+			{Name: "Anyone", Permissions: []string{"scan"}},
+			{Name: "developers", Permissions: []string{"provisioning"}},
+		},
+	}
+
+	if got, ok := FindGroupPermissions(search, "anyone"); !ok || !cmp.Equal(got, []string{"scan"}) {
+		t.Errorf("FindGroupPermissions() = %v, %v, want the permissions of Anyone", got, ok)
+	}
+	if _, ok := FindGroupPermissions(search, "missing"); ok {
+		t.Errorf("FindGroupPermissions() found a missing group")
+	}
+	if _, ok := FindGroupPermissions(nil, "developers"); ok {
+		t.Errorf("FindGroupPermissions() found a group in a nil search")
+	}
+}
+
+func TestPermissionDrift(t *testing.T) {
+	type want struct {
+		exists   bool
+		toAdd    []string
+		toRemove []string
+		toRevoke []string
+		upToDate bool
+	}
+
+	tests := map[string]struct {
+		spec        v1alpha1.PermissionParameters
+		observation v1alpha1.PermissionObservation
+		want        want
+	}{
+		"UpToDate": {
+			spec:        v1alpha1.PermissionParameters{Permissions: []string{"admin", "scan"}},
+			observation: v1alpha1.PermissionObservation{Permissions: []string{"scan", "admin"}},
+			want:        want{exists: true, toRevoke: []string{"admin", "scan"}, upToDate: true},
+		},
+		"MissingPermission": {
+			spec:        v1alpha1.PermissionParameters{Permissions: []string{"admin", "scan"}},
+			observation: v1alpha1.PermissionObservation{Permissions: []string{"scan"}},
+			want:        want{exists: true, toAdd: []string{"admin"}, toRevoke: []string{"scan"}},
+		},
+		"ExtraPermissionIsKeptWhenNotExclusive": {
+			spec:        v1alpha1.PermissionParameters{Permissions: []string{"scan"}},
+			observation: v1alpha1.PermissionObservation{Permissions: []string{"scan", "provisioning"}},
+			want:        want{exists: true, toRevoke: []string{"scan"}, upToDate: true},
+		},
+		"ExtraPermissionIsRemovedWhenExclusive": {
+			spec:        v1alpha1.PermissionParameters{Permissions: []string{"scan"}, Exclusive: ptr.To(true)},
+			observation: v1alpha1.PermissionObservation{Permissions: []string{"scan", "provisioning"}},
+			want:        want{exists: true, toRemove: []string{"provisioning"}, toRevoke: []string{"scan", "provisioning"}},
+		},
+		"OnlyUnmanagedPermissions": {
+			spec:        v1alpha1.PermissionParameters{Permissions: []string{"scan"}},
+			observation: v1alpha1.PermissionObservation{Permissions: []string{"provisioning"}},
+			want:        want{toAdd: []string{"scan"}},
+		},
+		"OnlyUnmanagedPermissionsWhenExclusive": {
+			spec:        v1alpha1.PermissionParameters{Permissions: []string{"scan"}, Exclusive: ptr.To(true)},
+			observation: v1alpha1.PermissionObservation{Permissions: []string{"provisioning"}},
+			want:        want{exists: true, toAdd: []string{"scan"}, toRemove: []string{"provisioning"}, toRevoke: []string{"provisioning"}},
+		},
+		"NoPermission": {
+			spec:        v1alpha1.PermissionParameters{Permissions: []string{"scan"}, Exclusive: ptr.To(true)},
+			observation: v1alpha1.PermissionObservation{},
+			want:        want{toAdd: []string{"scan"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := want{
+				exists:   PermissionExists(&tc.spec, &tc.observation),
+				toAdd:    FindPermissionsToAdd(&tc.spec, &tc.observation),
+				toRemove: FindPermissionsToRemove(&tc.spec, &tc.observation),
+				toRevoke: FindPermissionsToRevoke(&tc.spec, &tc.observation),
+				upToDate: IsPermissionUpToDate(&tc.spec, &tc.observation),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("permission drift mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPermissionsClientGroupsDecodesPermissions(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/permissions/groups" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"paging":{"pageIndex":1,"pageSize":100,"total":1},"groups":[{"name":"developers","permissions":["admin","scan"]}]}`))
+	}))
+	defer server.Close()
+
+	client := NewPermissionsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	spec := &v1alpha1.PermissionParameters{Scope: ptr.To(v1alpha1.PermissionScopeProject), ProjectKey: ptr.To("my-project"), GroupName: ptr.To("developers")}
+	search, resp, err := client.Groups(GeneratePermissionGroupsSearchOption(spec, 1))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Groups() error = %v", err)
+	}
+
+	wantQuery := map[string][]string{"p": {"1"}, "ps": {"100"}, "projectKey": {"my-project"}, "q": {"developers"}}
+	if diff := cmp.Diff(wantQuery, query); diff != "" {
+		t.Errorf("Groups() parameters mismatch (-want +got):\n%s", diff)
+	}
+	if got, ok := FindGroupPermissions(search, "developers"); !ok || !cmp.Equal(got, []string{"admin", "scan"}) {
+		t.Errorf("Groups() permissions = %v, want [admin scan]", got)
+	}
+	if HasMorePermissionGroups(search) {
+		t.Errorf("HasMorePermissionGroups() = true, want false")
+	}
+}
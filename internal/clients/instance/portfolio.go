@@ -0,0 +1,460 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// PortfoliosClient is the interface for interacting with SonarQube Views API, which manages Portfolios
+// It handles all the operations related to Portfolios in SonarQube, such as creating, updating and deleting them,
+// as well as selecting their Projects and sub-portfolios. Portfolios require the Enterprise Edition or above.
+type PortfoliosClient interface {
+	AddPortfolio(opt *ViewsAddPortfolioOption) (resp *http.Response, err error)
+	AddProject(opt *ViewsAddProjectOption) (resp *http.Response, err error)
+	Create(opt *ViewsCreateOption) (resp *http.Response, err error)
+	Delete(opt *ViewsDeleteOption) (resp *http.Response, err error)
+	RemovePortfolio(opt *ViewsRemovePortfolioOption) (resp *http.Response, err error)
+	RemoveProject(opt *ViewsRemoveProjectOption) (resp *http.Response, err error)
+	SetManualMode(opt *ViewsSetManualModeOption) (resp *http.Response, err error)
+	SetNoneMode(opt *ViewsSetNoneModeOption) (resp *http.Response, err error)
+	SetRegexpMode(opt *ViewsSetRegexpModeOption) (resp *http.Response, err error)
+	SetRemainingProjectsMode(opt *ViewsSetRemainingProjectsModeOption) (resp *http.Response, err error)
+	SetTagsMode(opt *ViewsSetTagsModeOption) (resp *http.Response, err error)
+	Show(opt *ViewsShowOption) (v *ViewsShowObject, resp *http.Response, err error)
+	Update(opt *ViewsUpdateOption) (resp *http.Response, err error)
+}
+
+// NewPortfoliosClient creates a new PortfoliosClient with the provided SonarQube client configuration.
+func NewPortfoliosClient(clientConfig common.Config) PortfoliosClient {
+	return &portfoliosClient{
+		client: common.NewClient(clientConfig),
+	}
+}
+
+// The SonarQube client does not provide the Views API, which is only available in the Enterprise Edition and above,
+// so its options and responses are defined here.
+
+// ViewsCreateOption is the option of the views/create endpoint
+type ViewsCreateOption struct {
+	Description string `url:"description,omitempty"` // Description:"Description for the new portfolio",ExampleValue:""
+	Key         string `url:"key,omitempty"`         // Description:"Key for the new portfolio",ExampleValue:""
+	Name        string `url:"name,omitempty"`        // Description:"Name for the new portfolio",ExampleValue:""
+}
+
+// ViewsShowOption is the option of the views/show endpoint
+type ViewsShowOption struct {
+	Key string `url:"key,omitempty"` // Description:"The key of the portfolio",ExampleValue:""
+}
+
+// ViewsUpdateOption is the option of the views/update endpoint
+type ViewsUpdateOption struct {
+	Description string `url:"description"`    // Description:"New description",ExampleValue:""
+	Key         string `url:"key,omitempty"`  // Description:"Key of the portfolio to update",ExampleValue:""
+	Name        string `url:"name,omitempty"` // Description:"New name",ExampleValue:""
+}
+
+// ViewsDeleteOption is the option of the views/delete endpoint
+type ViewsDeleteOption struct {
+	Key string `url:"key,omitempty"` // Description:"Key of the portfolio to delete",ExampleValue:""
+}
+
+// ViewsAddProjectOption is the option of the views/add_project endpoint
+type ViewsAddProjectOption struct {
+	Key     string `url:"key,omitempty"`     // Description:"Key of the portfolio",ExampleValue:""
+	Project string `url:"project,omitempty"` // Description:"Key of the project to add",ExampleValue:""
+}
+
+// ViewsRemoveProjectOption is the option of the views/remove_project endpoint
+type ViewsRemoveProjectOption struct {
+	Key     string `url:"key,omitempty"`     // Description:"Key of the portfolio",ExampleValue:""
+	Project string `url:"project,omitempty"` // Description:"Key of the project to remove",ExampleValue:""
+}
+
+// ViewsAddPortfolioOption is the option of the views/add_portfolio endpoint
+type ViewsAddPortfolioOption struct {
+	Portfolio string `url:"portfolio,omitempty"` // Description:"Key of the portfolio to add the reference to",ExampleValue:""
+	Reference string `url:"reference,omitempty"` // Description:"Key of the referenced portfolio",ExampleValue:""
+}
+
+// ViewsRemovePortfolioOption is the option of the views/remove_portfolio endpoint
+type ViewsRemovePortfolioOption struct {
+	Portfolio string `url:"portfolio,omitempty"` // Description:"Key of the portfolio to remove the reference from",ExampleValue:""
+	Reference string `url:"reference,omitempty"` // Description:"Key of the referenced portfolio",ExampleValue:""
+}
+
+// ViewsSetManualModeOption is the option of the views/set_manual_mode endpoint
+type ViewsSetManualModeOption struct {
+	Portfolio string `url:"portfolio,omitempty"` // Description:"Key of the portfolio",ExampleValue:""
+}
+
+// ViewsSetNoneModeOption is the option of the views/set_none_mode endpoint
+type ViewsSetNoneModeOption struct {
+	Portfolio string `url:"portfolio,omitempty"` // Description:"Key of the portfolio",ExampleValue:""
+}
+
+// ViewsSetRegexpModeOption is the option of the views/set_regexp_mode endpoint
+type ViewsSetRegexpModeOption struct {
+	Branch    string `url:"branch,omitempty"`    // Description:"Key of the branch selected in the projects",ExampleValue:""
+	Portfolio string `url:"portfolio,omitempty"` // Description:"Key of the portfolio",ExampleValue:""
+	Regexp    string `url:"regexp,omitempty"`    // Description:"Regular expression matching the projects",ExampleValue:""
+}
+
+// ViewsSetRemainingProjectsModeOption is the option of the views/set_remaining_projects_mode endpoint
+type ViewsSetRemainingProjectsModeOption struct {
+	Branch    string `url:"branch,omitempty"`    // Description:"Key of the branch selected in the projects",ExampleValue:""
+	Portfolio string `url:"portfolio,omitempty"` // Description:"Key of the portfolio",ExampleValue:""
+}
+
+// ViewsSetTagsModeOption is the option of the views/set_tags_mode endpoint
+type ViewsSetTagsModeOption struct {
+	Branch    string `url:"branch,omitempty"`    // Description:"Key of the branch selected in the projects",ExampleValue:""
+	Portfolio string `url:"portfolio,omitempty"` // Description:"Key of the portfolio",ExampleValue:""
+	Tags      string `url:"tags,omitempty"`      // Description:"Comma-separated list of tags of the projects",ExampleValue:""
+}
+
+// ViewsShowObject is the response of the views/show endpoint
+type ViewsShowObject struct {
+	Branch           string                       `json:"branch,omitempty"`
+	Desc             string                       `json:"desc,omitempty"`
+	Key              string                       `json:"key,omitempty"`
+	Name             string                       `json:"name,omitempty"`
+	Qualifier        string                       `json:"qualifier,omitempty"`
+	Regexp           string                       `json:"regexp,omitempty"`
+	SelectedProjects []ViewsSelectedProjectObject `json:"selectedProjects,omitempty"`
+	SelectionMode    string                       `json:"selectionMode,omitempty"`
+	SubViews         []ViewsSubViewObject         `json:"subViews,omitempty"`
+	Tags             []string                     `json:"tags,omitempty"`
+	Visibility       string                       `json:"visibility,omitempty"`
+}
+
+// ViewsSelectedProjectObject is a Project selected by a Portfolio with the MANUAL selection mode
+type ViewsSelectedProjectObject struct {
+	ProjectKey       string   `json:"projectKey,omitempty"`
+	SelectedBranches []string `json:"selectedBranches,omitempty"`
+}
+
+// ViewsSubViewObject is a sub-portfolio of a Portfolio
+// A sub-portfolio referencing another Portfolio returns the key of this Portfolio as OriginalKey.
+type ViewsSubViewObject struct {
+	Key         string `json:"key,omitempty"`
+	Name        string `json:"name,omitempty"`
+	OriginalKey string `json:"originalKey,omitempty"`
+	Qualifier   string `json:"qualifier,omitempty"`
+}
+
+// portfoliosClient implements the SonarQube Views API, which the SonarQube client does not provide
+type portfoliosClient struct {
+	client *sonargo.Client
+}
+
+// AddPortfolio adds a reference to another Portfolio as a sub-portfolio of a Portfolio
+func (c *portfoliosClient) AddPortfolio(opt *ViewsAddPortfolioOption) (resp *http.Response, err error) {
+	return c.post("views/add_portfolio", opt)
+}
+
+// AddProject adds a Project to a Portfolio with the MANUAL selection mode
+func (c *portfoliosClient) AddProject(opt *ViewsAddProjectOption) (resp *http.Response, err error) {
+	return c.post("views/add_project", opt)
+}
+
+// Create creates a Portfolio
+func (c *portfoliosClient) Create(opt *ViewsCreateOption) (resp *http.Response, err error) {
+	return c.post("views/create", opt)
+}
+
+// Delete deletes a Portfolio
+func (c *portfoliosClient) Delete(opt *ViewsDeleteOption) (resp *http.Response, err error) {
+	return c.post("views/delete", opt)
+}
+
+// RemovePortfolio removes the reference to another Portfolio from the sub-portfolios of a Portfolio
+func (c *portfoliosClient) RemovePortfolio(opt *ViewsRemovePortfolioOption) (resp *http.Response, err error) {
+	return c.post("views/remove_portfolio", opt)
+}
+
+// RemoveProject removes a Project from a Portfolio with the MANUAL selection mode
+func (c *portfoliosClient) RemoveProject(opt *ViewsRemoveProjectOption) (resp *http.Response, err error) {
+	return c.post("views/remove_project", opt)
+}
+
+// SetManualMode selects the Projects of a Portfolio manually
+func (c *portfoliosClient) SetManualMode(opt *ViewsSetManualModeOption) (resp *http.Response, err error) {
+	return c.post("views/set_manual_mode", opt)
+}
+
+// SetNoneMode selects no Project in a Portfolio
+func (c *portfoliosClient) SetNoneMode(opt *ViewsSetNoneModeOption) (resp *http.Response, err error) {
+	return c.post("views/set_none_mode", opt)
+}
+
+// SetRegexpMode selects the Projects of a Portfolio whose name or key matches a regular expression
+func (c *portfoliosClient) SetRegexpMode(opt *ViewsSetRegexpModeOption) (resp *http.Response, err error) {
+	return c.post("views/set_regexp_mode", opt)
+}
+
+// SetRemainingProjectsMode selects the Projects of a Portfolio not selected by any other Portfolio
+func (c *portfoliosClient) SetRemainingProjectsMode(opt *ViewsSetRemainingProjectsModeOption) (resp *http.Response, err error) {
+	return c.post("views/set_remaining_projects_mode", opt)
+}
+
+// SetTagsMode selects the Projects of a Portfolio having any of the given tags
+func (c *portfoliosClient) SetTagsMode(opt *ViewsSetTagsModeOption) (resp *http.Response, err error) {
+	return c.post("views/set_tags_mode", opt)
+}
+
+// Show retrieves a Portfolio along with its selection and sub-portfolios
+func (c *portfoliosClient) Show(opt *ViewsShowOption) (v *ViewsShowObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "views/show", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(ViewsShowObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// Update updates the name and description of a Portfolio
+func (c *portfoliosClient) Update(opt *ViewsUpdateOption) (resp *http.Response, err error) {
+	return c.post("views/update", opt)
+}
+
+// post sends a request without response body to an endpoint of the Views API
+func (c *portfoliosClient) post(path string, opt any) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, path, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// GeneratePortfolioCreateOption generates ViewsCreateOption from PortfolioParameters
+func GeneratePortfolioCreateOption(spec v1alpha1.PortfolioParameters) *ViewsCreateOption {
+	return &ViewsCreateOption{
+		Description: ptr.Deref(spec.Description, ""),
+		Key:         spec.Key,
+		Name:        spec.Name,
+	}
+}
+
+// GeneratePortfolioShowOption generates ViewsShowOption from PortfolioParameters
+func GeneratePortfolioShowOption(spec v1alpha1.PortfolioParameters) *ViewsShowOption {
+	return &ViewsShowOption{
+		Key: spec.Key,
+	}
+}
+
+// GeneratePortfolioUpdateOption generates ViewsUpdateOption from PortfolioParameters
+// The observed description is kept when the desired one is not specified.
+func GeneratePortfolioUpdateOption(spec v1alpha1.PortfolioParameters, observation v1alpha1.PortfolioObservation) *ViewsUpdateOption {
+	return &ViewsUpdateOption{
+		Description: ptr.Deref(spec.Description, observation.Description),
+		Key:         spec.Key,
+		Name:        spec.Name,
+	}
+}
+
+// GeneratePortfolioDeleteOption generates ViewsDeleteOption from PortfolioParameters
+func GeneratePortfolioDeleteOption(spec v1alpha1.PortfolioParameters) *ViewsDeleteOption {
+	return &ViewsDeleteOption{
+		Key: spec.Key,
+	}
+}
+
+// GeneratePortfolioAddProjectOption generates ViewsAddProjectOption to add the Project to the Portfolio
+func GeneratePortfolioAddProjectOption(key, project string) *ViewsAddProjectOption {
+	return &ViewsAddProjectOption{
+		Key:     key,
+		Project: project,
+	}
+}
+
+// GeneratePortfolioRemoveProjectOption generates ViewsRemoveProjectOption to remove the Project from the Portfolio
+func GeneratePortfolioRemoveProjectOption(key, project string) *ViewsRemoveProjectOption {
+	return &ViewsRemoveProjectOption{
+		Key:     key,
+		Project: project,
+	}
+}
+
+// GeneratePortfolioAddPortfolioOption generates ViewsAddPortfolioOption to add the sub-portfolio to the Portfolio
+func GeneratePortfolioAddPortfolioOption(key, subPortfolio string) *ViewsAddPortfolioOption {
+	return &ViewsAddPortfolioOption{
+		Portfolio: key,
+		Reference: subPortfolio,
+	}
+}
+
+// GeneratePortfolioRemovePortfolioOption generates ViewsRemovePortfolioOption to remove the sub-portfolio from the Portfolio
+func GeneratePortfolioRemovePortfolioOption(key, subPortfolio string) *ViewsRemovePortfolioOption {
+	return &ViewsRemovePortfolioOption{
+		Portfolio: key,
+		Reference: subPortfolio,
+	}
+}
+
+// GeneratePortfolioSetManualModeOption generates ViewsSetManualModeOption from PortfolioParameters
+func GeneratePortfolioSetManualModeOption(spec v1alpha1.PortfolioParameters) *ViewsSetManualModeOption {
+	return &ViewsSetManualModeOption{
+		Portfolio: spec.Key,
+	}
+}
+
+// GeneratePortfolioSetNoneModeOption generates ViewsSetNoneModeOption from PortfolioParameters
+func GeneratePortfolioSetNoneModeOption(spec v1alpha1.PortfolioParameters) *ViewsSetNoneModeOption {
+	return &ViewsSetNoneModeOption{
+		Portfolio: spec.Key,
+	}
+}
+
+// GeneratePortfolioSetRegexpModeOption generates ViewsSetRegexpModeOption from PortfolioParameters
+func GeneratePortfolioSetRegexpModeOption(spec v1alpha1.PortfolioParameters) *ViewsSetRegexpModeOption {
+	return &ViewsSetRegexpModeOption{
+		Branch:    ptr.Deref(spec.Branch, ""),
+		Portfolio: spec.Key,
+		Regexp:    ptr.Deref(spec.Regexp, ""),
+	}
+}
+
+// GeneratePortfolioSetRemainingProjectsModeOption generates ViewsSetRemainingProjectsModeOption from PortfolioParameters
+func GeneratePortfolioSetRemainingProjectsModeOption(spec v1alpha1.PortfolioParameters) *ViewsSetRemainingProjectsModeOption {
+	return &ViewsSetRemainingProjectsModeOption{
+		Branch:    ptr.Deref(spec.Branch, ""),
+		Portfolio: spec.Key,
+	}
+}
+
+// GeneratePortfolioSetTagsModeOption generates ViewsSetTagsModeOption from PortfolioParameters
+func GeneratePortfolioSetTagsModeOption(spec v1alpha1.PortfolioParameters) *ViewsSetTagsModeOption {
+	return &ViewsSetTagsModeOption{
+		Branch:    ptr.Deref(spec.Branch, ""),
+		Portfolio: spec.Key,
+		Tags:      strings.Join(spec.Tags, ","),
+	}
+}
+
+// GeneratePortfolioObservation generates PortfolioObservation from a ViewsShowObject
+// portfolio should not be nil, else it will panic
+func GeneratePortfolioObservation(portfolio *ViewsShowObject) v1alpha1.PortfolioObservation {
+	observation := v1alpha1.PortfolioObservation{
+		Key:           portfolio.Key,
+		Name:          portfolio.Name,
+		Description:   portfolio.Desc,
+		Qualifier:     portfolio.Qualifier,
+		Visibility:    portfolio.Visibility,
+		SelectionMode: portfolio.SelectionMode,
+		Tags:          portfolio.Tags,
+		Regexp:        portfolio.Regexp,
+		Branch:        portfolio.Branch,
+	}
+	for _, project := range portfolio.SelectedProjects {
+		observation.Projects = append(observation.Projects, project.ProjectKey)
+	}
+	for _, subView := range portfolio.SubViews {
+		key := subView.OriginalKey
+		if key == "" {
+			key = subView.Key
+		}
+		observation.SubPortfolios = append(observation.SubPortfolios, key)
+	}
+	return observation
+}
+
+// LateInitializePortfolio fills the empty fields of *PortfolioParameters with the values seen in PortfolioObservation
+func LateInitializePortfolio(spec *v1alpha1.PortfolioParameters, observation *v1alpha1.PortfolioObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	helpers.AssignIfNil(&spec.Description, observation.Description)
+}
+
+// IsPortfolioSelectionModeUpToDate checks whether the selection mode of the observed Portfolio, along with its tags, regular expression and branch,
+// is up to date with the desired PortfolioParameters. The Projects selected manually are compared separately.
+func IsPortfolioSelectionModeUpToDate(spec *v1alpha1.PortfolioParameters, observation *v1alpha1.PortfolioObservation) bool {
+	mode := ptr.Deref(spec.SelectionMode, v1alpha1.PortfolioSelectionModeNone)
+	if mode != observation.SelectionMode {
+		return false
+	}
+
+	switch mode {
+	case v1alpha1.PortfolioSelectionModeTags:
+		return helpers.IsComparableSliceEqualIgnoringOrder(spec.Tags, observation.Tags) && ptr.Deref(spec.Branch, "") == observation.Branch
+	case v1alpha1.PortfolioSelectionModeRegexp:
+		return ptr.Deref(spec.Regexp, "") == observation.Regexp && ptr.Deref(spec.Branch, "") == observation.Branch
+	case v1alpha1.PortfolioSelectionModeRest:
+		return ptr.Deref(spec.Branch, "") == observation.Branch
+	default:
+		return true
+	}
+}
+
+// IsPortfolioUpToDate checks whether the observed Portfolio is up to date with the desired PortfolioParameters
+func IsPortfolioUpToDate(spec *v1alpha1.PortfolioParameters, observation *v1alpha1.PortfolioObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	return spec.Name == observation.Name &&
+		helpers.IsComparablePtrEqualComparable(spec.Description, observation.Description) &&
+		IsPortfolioSelectionModeUpToDate(spec, observation) &&
+		(observation.SelectionMode != v1alpha1.PortfolioSelectionModeManual || spec.Projects == nil ||
+			helpers.IsComparableSliceEqualIgnoringOrder(spec.Projects, observation.Projects)) &&
+		(spec.SubPortfolios == nil || helpers.IsComparableSliceEqualIgnoringOrder(spec.SubPortfolios, observation.SubPortfolios))
+}
+
+// FindPortfolioProjectsToAdd returns the keys of the desired Projects missing from the Portfolio
+func FindPortfolioProjectsToAdd(specProjects, observedProjects []string) []string {
+	return findMissingStrings(specProjects, observedProjects)
+}
+
+// FindPortfolioProjectsToRemove returns the keys of the Projects of the Portfolio which are not desired
+// Nothing is removed when the Projects are not managed.
+func FindPortfolioProjectsToRemove(specProjects, observedProjects []string) []string {
+	if specProjects == nil {
+		return nil
+	}
+	return findMissingStrings(observedProjects, specProjects)
+}
+
+// FindSubPortfoliosToAdd returns the keys of the desired sub-portfolios missing from the Portfolio
+func FindSubPortfoliosToAdd(specSubPortfolios, observedSubPortfolios []string) []string {
+	return findMissingStrings(specSubPortfolios, observedSubPortfolios)
+}
+
+// FindSubPortfoliosToRemove returns the keys of the sub-portfolios of the Portfolio which are not desired
+// Nothing is removed when the sub-portfolios are not managed.
+func FindSubPortfoliosToRemove(specSubPortfolios, observedSubPortfolios []string) []string {
+	if specSubPortfolios == nil {
+		return nil
+	}
+	return findMissingStrings(observedSubPortfolios, specSubPortfolios)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestIsPortfolioSelectionModeUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		spec        v1alpha1.PortfolioParameters
+		observation v1alpha1.PortfolioObservation
+		want        bool
+	}{
+		"DefaultNone": {
+			observation: v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeNone},
+			want:        true,
+		},
+		"ModeChanged": {
+			spec:        v1alpha1.PortfolioParameters{SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeRest)},
+			observation: v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeNone},
+		},
+		"TagsUpToDate": {
+			spec:        v1alpha1.PortfolioParameters{SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeTags), Tags: []string{"payments", "backend"}},
+			observation: v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeTags, Tags: []string{"backend", "payments"}},
+			want:        true,
+		},
+		"TagsChanged": {
+			spec:        v1alpha1.PortfolioParameters{SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeTags), Tags: []string{"backend"}},
+			observation: v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeTags, Tags: []string{"backend", "payments"}},
+		},
+		"RegexpChanged": {
+			spec:        v1alpha1.PortfolioParameters{SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeRegexp), Regexp: ptr.To("^payments-.*")},
+			observation: v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeRegexp, Regexp: "^billing-.*"},
+		},
+		"BranchChanged": {
+			spec:        v1alpha1.PortfolioParameters{SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeRest), Branch: ptr.To("develop")},
+			observation: v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeRest},
+		},
+		"ManualIgnoresProjects": {
+			spec:        v1alpha1.PortfolioParameters{SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual), Projects: []string{"backend"}},
+			observation: v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeManual},
+			want:        true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPortfolioSelectionModeUpToDate(&tc.spec, &tc.observation); got != tc.want {
+				t.Errorf("IsPortfolioSelectionModeUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsPortfolioUpToDate(t *testing.T) {
+	observation := v1alpha1.PortfolioObservation{
+		Key:           "my-portfolio",
+		Name:          "My Portfolio",
+		SelectionMode: v1alpha1.PortfolioSelectionModeManual,
+		Projects:      []string{"backend", "frontend"},
+		SubPortfolios: []string{"team-a"},
+	}
+
+	tests := map[string]struct {
+		spec v1alpha1.PortfolioParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: v1alpha1.PortfolioParameters{Key: "my-portfolio", Name: "My Portfolio", SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual), Projects: []string{"frontend", "backend"}, SubPortfolios: []string{"team-a"}},
+			want: true,
+		},
+		"ProjectsChanged": {
+			spec: v1alpha1.PortfolioParameters{Key: "my-portfolio", Name: "My Portfolio", SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual), Projects: []string{"backend"}},
+		},
+		"SubPortfoliosChanged": {
+			spec: v1alpha1.PortfolioParameters{Key: "my-portfolio", Name: "My Portfolio", SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual), SubPortfolios: []string{"team-a", "team-b"}},
+		},
+		"DescriptionChanged": {
+			spec: v1alpha1.PortfolioParameters{Key: "my-portfolio", Name: "My Portfolio", Description: ptr.To("All teams"), SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPortfolioUpToDate(&tc.spec, &observation); got != tc.want {
+				t.Errorf("IsPortfolioUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPortfoliosClient(t *testing.T) {
+	var tagsQuery map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/views/show":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key":"my-portfolio","name":"My Portfolio","desc":"All teams","qualifier":"VW","visibility":"public","selectionMode":"TAGS","tags":["backend","payments"],"branch":"develop","subViews":[{"key":"my-portfolio:team-a","name":"Team A","qualifier":"VW","originalKey":"team-a"},{"key":"local","name":"Local","qualifier":"SVW"}]}`))
+		case "/api/views/set_tags_mode":
+			tagsQuery = r.URL.Query()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewPortfoliosClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	spec := v1alpha1.PortfolioParameters{
+		Key:           "my-portfolio",
+		Name:          "My Portfolio",
+		SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeTags),
+		Tags:          []string{"backend", "payments"},
+		Branch:        ptr.To("develop"),
+	}
+
+	tagsResp, err := client.SetTagsMode(GeneratePortfolioSetTagsModeOption(spec))
+	if tagsResp != nil {
+		defer tagsResp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("SetTagsMode() error = %v", err)
+	}
+	wantQuery := map[string][]string{
+		"branch":    {"develop"},
+		"portfolio": {"my-portfolio"},
+		"tags":      {"backend,payments"},
+	}
+	if diff := cmp.Diff(wantQuery, tagsQuery); diff != "" {
+		t.Errorf("SetTagsMode() parameters mismatch (-want +got):\n%s", diff)
+	}
+
+	portfolio, resp, err := client.Show(GeneratePortfolioShowOption(spec))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Show() error = %v", err)
+	}
+	want := v1alpha1.PortfolioObservation{
+		Key:           "my-portfolio",
+		Name:          "My Portfolio",
+		Description:   "All teams",
+		Qualifier:     "VW",
+		Visibility:    "public",
+		SelectionMode: v1alpha1.PortfolioSelectionModeTags,
+		Tags:          []string{"backend", "payments"},
+		Branch:        "develop",
+		SubPortfolios: []string{"team-a", "local"},
+	}
+	observation := GeneratePortfolioObservation(portfolio)
+	if diff := cmp.Diff(want, observation); diff != "" {
+		t.Errorf("Show() observation mismatch (-want +got):\n%s", diff)
+	}
+	if !IsPortfolioSelectionModeUpToDate(&spec, &observation) {
+		t.Errorf("IsPortfolioSelectionModeUpToDate() = false, want true")
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// ProjectsClient is the interface for interacting with SonarQube Projects API
+// It handles all the operations related to Projects in SonarQube, such as creating, deleting, searching Projects and updating their key or visibility.
+type ProjectsClient interface {
+	BulkDelete(opt *sonargo.ProjectsBulkDeleteOption) (resp *http.Response, err error)
+	Create(opt *sonargo.ProjectsCreateOption) (v *sonargo.ProjectsCreateObject, resp *http.Response, err error)
+	Delete(opt *sonargo.ProjectsDeleteOption) (resp *http.Response, err error)
+	Search(opt *sonargo.ProjectsSearchOption) (v *sonargo.ProjectsSearchObject, resp *http.Response, err error)
+	SearchMyProjects(opt *sonargo.ProjectsSearchMyProjectsOption) (v *sonargo.ProjectsSearchMyProjectsObject, resp *http.Response, err error)
+	SearchMyScannableProjects(opt *sonargo.ProjectsSearchMyScannableProjectsOption) (v *sonargo.ProjectsSearchMyScannableProjectsObject, resp *http.Response, err error)
+	UpdateDefaultVisibility(opt *sonargo.ProjectsUpdateDefaultVisibilityOption) (resp *http.Response, err error)
+	UpdateKey(opt *sonargo.ProjectsUpdateKeyOption) (resp *http.Response, err error)
+	UpdateVisibility(opt *sonargo.ProjectsUpdateVisibilityOption) (resp *http.Response, err error)
+}
+
+// ProjectTagsClient is the interface for interacting with SonarQube Project Tags API
+type ProjectTagsClient interface {
+	Search(opt *sonargo.ProjectTagsSearchOption) (v *sonargo.ProjectTagsSearchObject, resp *http.Response, err error)
+	Set(opt *sonargo.ProjectTagsSetOption) (resp *http.Response, err error)
+}
+
+// NewProjectsClient creates a new ProjectsClient with the provided SonarQube client configuration.
+func NewProjectsClient(clientConfig common.Config) ProjectsClient {
+	newClient := common.NewClient(clientConfig)
+	return newClient.Projects
+}
+
+// NewProjectTagsClient creates a new ProjectTagsClient with the provided SonarQube client configuration.
+func NewProjectTagsClient(clientConfig common.Config) ProjectTagsClient {
+	newClient := common.NewClient(clientConfig)
+	return newClient.ProjectTags
+}
+
+// GenerateProjectCreateOptions generates SonarQube ProjectsCreateOption from ProjectParameters
+func GenerateProjectCreateOptions(spec v1alpha1.ProjectParameters) *sonargo.ProjectsCreateOption {
+	option := &sonargo.ProjectsCreateOption{
+		Project: spec.Key,
+		Name:    spec.Name,
+	}
+	if spec.Visibility != nil {
+		option.Visibility = *spec.Visibility
+	}
+	if spec.MainBranch != nil {
+		option.MainBranch = *spec.MainBranch
+	}
+	return option
+}
+
+// GenerateProjectSearchOptions generates SonarQube ProjectsSearchOption to look up a single Project by its key
+func GenerateProjectSearchOptions(key string) *sonargo.ProjectsSearchOption {
+	return &sonargo.ProjectsSearchOption{
+		Projects: key,
+	}
+}
+
+// GenerateProjectComponentSearchOptions generates SonarQube ComponentsSearchProjectsOption to look up the tags of a single Project by its key
+func GenerateProjectComponentSearchOptions(key string) *sonargo.ComponentsSearchProjectsOption {
+	return &sonargo.ComponentsSearchProjectsOption{
+		Filter: fmt.Sprintf("query = %q", key),
+		F:      "tags",
+		Ps:     "500",
+	}
+}
+
+// GenerateProjectTagsSetOptions generates SonarQube ProjectTagsSetOption from the desired tags of a Project
+func GenerateProjectTagsSetOptions(key string, tags []string) *sonargo.ProjectTagsSetOption {
+	return &sonargo.ProjectTagsSetOption{
+		Project: key,
+		Tags:    strings.Join(tags, ","),
+	}
+}
+
+// FindProject returns the Project matching the key from the SonarQube search results, nil if it is not found
+func FindProject(projects *sonargo.ProjectsSearchObject, key string) *sonargo.ProjectsSearchObject_sub1 {
+	if projects == nil {
+		return nil
+	}
+	for i := range projects.Components {
+		if projects.Components[i].Key == key {
+			return &projects.Components[i]
+		}
+	}
+	return nil
+}
+
+// FindProjectTags returns the tags of the Project matching the key from the SonarQube components search results
+func FindProjectTags(components *sonargo.ComponentsSearchProjectsObject, key string) []string {
+	if components == nil {
+		return nil
+	}
+	for i := range components.Components {
+		if components.Components[i].Key == key {
+			return components.Components[i].Tags
+		}
+	}
+	return nil
+}
+
+// FindMainBranch returns the name of the main branch from the SonarQube branches list, empty if there is none
+func FindMainBranch(branches *sonargo.ProjectBranchesListObject) string {
+	if branches == nil {
+		return ""
+	}
+	for i := range branches.Branches {
+		if branches.Branches[i].IsMain {
+			return branches.Branches[i].Name
+		}
+	}
+	return ""
+}
+
+// GenerateProjectObservation generates ProjectObservation from SonarQube ProjectsSearchObject_sub1
+// project should not be nil, else it will panic
+func GenerateProjectObservation(project *sonargo.ProjectsSearchObject_sub1, mainBranch string, tags []string) v1alpha1.ProjectObservation {
+	return v1alpha1.ProjectObservation{
+		Key:              project.Key,
+		LastAnalysisDate: project.LastAnalysisDate,
+		MainBranch:       mainBranch,
+		Managed:          project.Managed,
+		Name:             project.Name,
+		Qualifier:        project.Qualifier,
+		Revision:         project.Revision,
+		Tags:             tags,
+		UUID:             project.ProjectUUID,
+		Visibility:       project.Visibility,
+	}
+}
+
+// IsProjectUpToDate checks if the Project spec is up to date with the observed state
+// The Project name is not compared since SonarQube does not allow updating it.
+func IsProjectUpToDate(spec *v1alpha1.ProjectParameters, observation *v1alpha1.ProjectObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if spec.Key != observation.Key {
+		return false
+	}
+
+	if !helpers.IsComparablePtrEqualComparable(spec.Visibility, observation.Visibility) {
+		return false
+	}
+
+	if !helpers.IsComparablePtrEqualComparable(spec.MainBranch, observation.MainBranch) {
+		return false
+	}
+
+	if spec.Tags != nil && !helpers.IsComparableSliceEqualIgnoringOrder(spec.Tags, observation.Tags) {
+		return false
+	}
+
+	return true
+}
+
+// LateInitializeProject fills the spec with the observed state if the spec fields are nil
+func LateInitializeProject(spec *v1alpha1.ProjectParameters, observation *v1alpha1.ProjectObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	if observation.Visibility != "" {
+		helpers.AssignIfNil(&spec.Visibility, observation.Visibility)
+	}
+	if observation.MainBranch != "" {
+		helpers.AssignIfNil(&spec.MainBranch, observation.MainBranch)
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// AlmSettingsGetBindingObject is the response of the alm_settings/get_binding endpoint
+// The SonarQube client AlmSettingsGetBindingObject omits the slug of the repository
+type AlmSettingsGetBindingObject struct {
+	Alm                   string `json:"alm,omitempty"`
+	Key                   string `json:"key,omitempty"`
+	Monorepo              bool   `json:"monorepo,omitempty"`
+	Repository            string `json:"repository,omitempty"`
+	Slug                  string `json:"slug,omitempty"`
+	SummaryCommentEnabled bool   `json:"summaryCommentEnabled,omitempty"`
+	URL                   string `json:"url,omitempty"`
+}
+
+// AlmSettingsSetGithubBindingOption is the option of the alm_settings/set_github_binding endpoint, which the SonarQube client does not provide
+type AlmSettingsSetGithubBindingOption struct {
+	AlmSetting            string `url:"almSetting,omitempty"`            // Description:"GitHub Setting key",ExampleValue:""
+	Monorepo              bool   `url:"monorepo"`                        // Description:"Is this project part of a monorepo",ExampleValue:""
+	Project               string `url:"project,omitempty"`               // Description:"Project key",ExampleValue:""
+	Repository            string `url:"repository,omitempty"`            // Description:"GitHub Repository",ExampleValue:""
+	SummaryCommentEnabled *bool  `url:"summaryCommentEnabled,omitempty"` // Description:"Enable/disable summary in PR discussion tab",ExampleValue:""
+}
+
+// AlmSettingsSetGitlabBindingOption is the option of the alm_settings/set_gitlab_binding endpoint, which the SonarQube client does not provide
+type AlmSettingsSetGitlabBindingOption struct {
+	AlmSetting string `url:"almSetting,omitempty"` // Description:"GitLab setting key",ExampleValue:""
+	Monorepo   bool   `url:"monorepo"`             // Description:"Is this project part of a monorepo",ExampleValue:""
+	Project    string `url:"project,omitempty"`    // Description:"Project key",ExampleValue:""
+	Repository string `url:"repository,omitempty"` // Description:"GitLab project ID",ExampleValue:""
+}
+
+// AlmSettingsSetAzureBindingOption is the option of the alm_settings/set_azure_binding endpoint, which the SonarQube client does not provide
+type AlmSettingsSetAzureBindingOption struct {
+	AlmSetting     string `url:"almSetting,omitempty"`     // Description:"DevOps Platform setting key",ExampleValue:""
+	Monorepo       bool   `url:"monorepo"`                 // Description:"Is this project part of a monorepo",ExampleValue:""
+	Project        string `url:"project,omitempty"`        // Description:"Project key",ExampleValue:""
+	ProjectName    string `url:"projectName,omitempty"`    // Description:"Azure project name",ExampleValue:""
+	RepositoryName string `url:"repositoryName,omitempty"` // Description:"Azure repository name",ExampleValue:""
+}
+
+// AlmSettingsSetBitbucketBindingOption is the option of the alm_settings/set_bitbucket_binding endpoint, which the SonarQube client does not provide
+type AlmSettingsSetBitbucketBindingOption struct {
+	AlmSetting string `url:"almSetting,omitempty"` // Description:"Bitbucket setting key",ExampleValue:""
+	Monorepo   bool   `url:"monorepo"`             // Description:"Is this project part of a monorepo",ExampleValue:""
+	Project    string `url:"project,omitempty"`    // Description:"Project key",ExampleValue:""
+	Repository string `url:"repository,omitempty"` // Description:"Bitbucket project key",ExampleValue:""
+	Slug       string `url:"slug,omitempty"`       // Description:"Bitbucket repository slug",ExampleValue:""
+}
+
+// AlmSettingsSetBitbucketcloudBindingOption is the option of the alm_settings/set_bitbucketcloud_binding endpoint, which the SonarQube client does not provide
+type AlmSettingsSetBitbucketcloudBindingOption struct {
+	AlmSetting string `url:"almSetting,omitempty"` // Description:"Bitbucket Cloud setting key",ExampleValue:""
+	Monorepo   bool   `url:"monorepo"`             // Description:"Is this project part of a monorepo",ExampleValue:""
+	Project    string `url:"project,omitempty"`    // Description:"Project key",ExampleValue:""
+	Repository string `url:"repository,omitempty"` // Description:"Bitbucket Cloud repository slug",ExampleValue:""
+}
+
+// AlmSettingsDeleteBindingOption is the option of the alm_settings/delete_binding endpoint, which the SonarQube client does not provide
+type AlmSettingsDeleteBindingOption struct {
+	Project string `url:"project,omitempty"` // Description:"Project key",ExampleValue:""
+}
+
+// DeleteBinding deletes the binding of a Project to its repository
+func (c *almSettingsClient) DeleteBinding(opt *AlmSettingsDeleteBindingOption) (resp *http.Response, err error) {
+	return c.post("alm_settings/delete_binding", opt)
+}
+
+// GetBinding retrieves the binding of a Project to its repository
+func (c *almSettingsClient) GetBinding(opt *sonargo.AlmSettingsGetBindingOption) (v *AlmSettingsGetBindingObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "alm_settings/get_binding", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(AlmSettingsGetBindingObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// SetAzureBinding binds a Project to an Azure DevOps repository
+func (c *almSettingsClient) SetAzureBinding(opt *AlmSettingsSetAzureBindingOption) (resp *http.Response, err error) {
+	return c.post("alm_settings/set_azure_binding", opt)
+}
+
+// SetBitbucketBinding binds a Project to a Bitbucket Server repository
+func (c *almSettingsClient) SetBitbucketBinding(opt *AlmSettingsSetBitbucketBindingOption) (resp *http.Response, err error) {
+	return c.post("alm_settings/set_bitbucket_binding", opt)
+}
+
+// SetBitbucketcloudBinding binds a Project to a Bitbucket Cloud repository
+func (c *almSettingsClient) SetBitbucketcloudBinding(opt *AlmSettingsSetBitbucketcloudBindingOption) (resp *http.Response, err error) {
+	return c.post("alm_settings/set_bitbucketcloud_binding", opt)
+}
+
+// SetGithubBinding binds a Project to a GitHub repository
+func (c *almSettingsClient) SetGithubBinding(opt *AlmSettingsSetGithubBindingOption) (resp *http.Response, err error) {
+	return c.post("alm_settings/set_github_binding", opt)
+}
+
+// SetGitlabBinding binds a Project to a GitLab project
+func (c *almSettingsClient) SetGitlabBinding(opt *AlmSettingsSetGitlabBindingOption) (resp *http.Response, err error) {
+	return c.post("alm_settings/set_gitlab_binding", opt)
+}
+
+// GenerateProjectAlmBindingGetOption generates SonarQube AlmSettingsGetBindingOption to retrieve the binding of the Project
+func GenerateProjectAlmBindingGetOption(spec v1alpha1.ProjectAlmBindingParameters) *sonargo.AlmSettingsGetBindingOption {
+	return &sonargo.AlmSettingsGetBindingOption{
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateProjectAlmBindingListOption generates SonarQube AlmSettingsListOption to list the DevOps Platform Settings the Project can be bound through
+func GenerateProjectAlmBindingListOption(spec v1alpha1.ProjectAlmBindingParameters) *sonargo.AlmSettingsListOption {
+	return &sonargo.AlmSettingsListOption{
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateProjectAlmBindingSetGithubOption generates AlmSettingsSetGithubBindingOption from ProjectAlmBindingParameters
+func GenerateProjectAlmBindingSetGithubOption(spec v1alpha1.ProjectAlmBindingParameters) *AlmSettingsSetGithubBindingOption {
+	return &AlmSettingsSetGithubBindingOption{
+		AlmSetting:            ptr.Deref(spec.AlmSetting, ""),
+		Monorepo:              ptr.Deref(spec.Monorepo, false),
+		Project:               ptr.Deref(spec.ProjectKey, ""),
+		Repository:            spec.Repository,
+		SummaryCommentEnabled: spec.SummaryCommentEnabled,
+	}
+}
+
+// GenerateProjectAlmBindingSetGitlabOption generates AlmSettingsSetGitlabBindingOption from ProjectAlmBindingParameters
+func GenerateProjectAlmBindingSetGitlabOption(spec v1alpha1.ProjectAlmBindingParameters) *AlmSettingsSetGitlabBindingOption {
+	return &AlmSettingsSetGitlabBindingOption{
+		AlmSetting: ptr.Deref(spec.AlmSetting, ""),
+		Monorepo:   ptr.Deref(spec.Monorepo, false),
+		Project:    ptr.Deref(spec.ProjectKey, ""),
+		Repository: spec.Repository,
+	}
+}
+
+// GenerateProjectAlmBindingSetAzureOption generates AlmSettingsSetAzureBindingOption from ProjectAlmBindingParameters
+// The slug of the binding is the name of the Azure DevOps project
+func GenerateProjectAlmBindingSetAzureOption(spec v1alpha1.ProjectAlmBindingParameters) *AlmSettingsSetAzureBindingOption {
+	return &AlmSettingsSetAzureBindingOption{
+		AlmSetting:     ptr.Deref(spec.AlmSetting, ""),
+		Monorepo:       ptr.Deref(spec.Monorepo, false),
+		Project:        ptr.Deref(spec.ProjectKey, ""),
+		ProjectName:    ptr.Deref(spec.Slug, ""),
+		RepositoryName: spec.Repository,
+	}
+}
+
+// GenerateProjectAlmBindingSetBitbucketOption generates AlmSettingsSetBitbucketBindingOption from ProjectAlmBindingParameters
+func GenerateProjectAlmBindingSetBitbucketOption(spec v1alpha1.ProjectAlmBindingParameters) *AlmSettingsSetBitbucketBindingOption {
+	return &AlmSettingsSetBitbucketBindingOption{
+		AlmSetting: ptr.Deref(spec.AlmSetting, ""),
+		Monorepo:   ptr.Deref(spec.Monorepo, false),
+		Project:    ptr.Deref(spec.ProjectKey, ""),
+		Repository: spec.Repository,
+		Slug:       ptr.Deref(spec.Slug, ""),
+	}
+}
+
+// GenerateProjectAlmBindingSetBitbucketcloudOption generates AlmSettingsSetBitbucketcloudBindingOption from ProjectAlmBindingParameters
+func GenerateProjectAlmBindingSetBitbucketcloudOption(spec v1alpha1.ProjectAlmBindingParameters) *AlmSettingsSetBitbucketcloudBindingOption {
+	return &AlmSettingsSetBitbucketcloudBindingOption{
+		AlmSetting: ptr.Deref(spec.AlmSetting, ""),
+		Monorepo:   ptr.Deref(spec.Monorepo, false),
+		Project:    ptr.Deref(spec.ProjectKey, ""),
+		Repository: spec.Repository,
+	}
+}
+
+// GenerateProjectAlmBindingDeleteOption generates AlmSettingsDeleteBindingOption to delete the binding of the Project
+func GenerateProjectAlmBindingDeleteOption(spec v1alpha1.ProjectAlmBindingParameters) *AlmSettingsDeleteBindingOption {
+	return &AlmSettingsDeleteBindingOption{
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// FindAlmSettingAlm returns the DevOps Platform of the DevOps Platform Setting with the given key, empty if there is none
+func FindAlmSettingAlm(list *sonargo.AlmSettingsListObject, key string) string {
+	if list == nil {
+		return ""
+	}
+	for i := range list.AlmSettings {
+		if list.AlmSettings[i].Key == key {
+			return list.AlmSettings[i].Alm
+		}
+	}
+	return ""
+}
+
+// GenerateProjectAlmBindingObservation generates ProjectAlmBindingObservation from an AlmSettingsGetBindingObject
+// binding should not be nil, else it will panic
+func GenerateProjectAlmBindingObservation(binding *AlmSettingsGetBindingObject) v1alpha1.ProjectAlmBindingObservation {
+	return v1alpha1.ProjectAlmBindingObservation{
+		Alm:                   binding.Alm,
+		AlmSetting:            binding.Key,
+		Monorepo:              binding.Monorepo,
+		Repository:            binding.Repository,
+		Slug:                  binding.Slug,
+		SummaryCommentEnabled: binding.SummaryCommentEnabled,
+		URL:                   binding.URL,
+	}
+}
+
+// LateInitializeProjectAlmBinding fills the empty fields of *ProjectAlmBindingParameters with the values seen in ProjectAlmBindingObservation
+// SummaryCommentEnabled is only late-initialized for GitHub, the only DevOps Platform supporting it.
+func LateInitializeProjectAlmBinding(spec *v1alpha1.ProjectAlmBindingParameters, observation *v1alpha1.ProjectAlmBindingObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	helpers.AssignIfNil(&spec.Monorepo, observation.Monorepo)
+	if observation.Alm == v1alpha1.AlmGitHub {
+		helpers.AssignIfNil(&spec.SummaryCommentEnabled, observation.SummaryCommentEnabled)
+	}
+}
+
+// IsProjectAlmBindingUpToDate checks whether the observed binding is up to date with the desired ProjectAlmBindingParameters
+func IsProjectAlmBindingUpToDate(spec *v1alpha1.ProjectAlmBindingParameters, observation *v1alpha1.ProjectAlmBindingObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	return ptr.Deref(spec.AlmSetting, "") == observation.AlmSetting &&
+		spec.Repository == observation.Repository &&
+		helpers.IsComparablePtrEqualComparable(spec.Slug, observation.Slug) &&
+		helpers.IsComparablePtrEqualComparable(spec.SummaryCommentEnabled, observation.SummaryCommentEnabled) &&
+		helpers.IsComparablePtrEqualComparable(spec.Monorepo, observation.Monorepo)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestFindAlmSettingAlm(t *testing.T) {
+	list := &sonargo.AlmSettingsListObject{
+		AlmSettings: []sonargo.AlmSettingsListObject_sub1{
+			{Alm: v1alpha1.AlmGitHub, Key: "github"},
+			{Alm: v1alpha1.AlmAzureDevOps, Key: "azure"},
+		},
+	}
+
+	tests := map[string]struct {
+		list *sonargo.AlmSettingsListObject
+		key  string
+		want string
+	}{
+		"Found": {
+			list: list,
+			key:  "azure",
+			want: v1alpha1.AlmAzureDevOps,
+		},
+		"NotFound": {
+			list: list,
+			key:  "gitlab",
+		},
+		"NilList": {
+			key: "github",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := FindAlmSettingAlm(tc.list, tc.key); got != tc.want {
+				t.Errorf("FindAlmSettingAlm() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeProjectAlmBinding(t *testing.T) {
+	tests := map[string]struct {
+		observation v1alpha1.ProjectAlmBindingObservation
+		want        v1alpha1.ProjectAlmBindingParameters
+	}{
+		"GitHub": {
+			observation: v1alpha1.ProjectAlmBindingObservation{Alm: v1alpha1.AlmGitHub, SummaryCommentEnabled: true},
+			want:        v1alpha1.ProjectAlmBindingParameters{SummaryCommentEnabled: ptr.To(true), Monorepo: ptr.To(false)},
+		},
+		"GitLab": {
+			observation: v1alpha1.ProjectAlmBindingObservation{Alm: v1alpha1.AlmGitLab, Monorepo: true},
+			want:        v1alpha1.ProjectAlmBindingParameters{Monorepo: ptr.To(true)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := v1alpha1.ProjectAlmBindingParameters{}
+			LateInitializeProjectAlmBinding(&spec, &tc.observation)
+			if diff := cmp.Diff(tc.want, spec); diff != "" {
+				t.Errorf("LateInitializeProjectAlmBinding() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsProjectAlmBindingUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		spec        v1alpha1.ProjectAlmBindingParameters
+		observation v1alpha1.ProjectAlmBindingObservation
+		want        bool
+	}{
+		"UpToDate": {
+			spec:        v1alpha1.ProjectAlmBindingParameters{AlmSetting: ptr.To("github"), Repository: "my-org/my-repo", SummaryCommentEnabled: ptr.To(true)},
+			observation: v1alpha1.ProjectAlmBindingObservation{AlmSetting: "github", Repository: "my-org/my-repo", SummaryCommentEnabled: true},
+			want:        true,
+		},
+		"AlmSettingChanged": {
+			spec:        v1alpha1.ProjectAlmBindingParameters{AlmSetting: ptr.To("github-enterprise"), Repository: "my-org/my-repo"},
+			observation: v1alpha1.ProjectAlmBindingObservation{AlmSetting: "github", Repository: "my-org/my-repo"},
+		},
+		"SlugChanged": {
+			spec:        v1alpha1.ProjectAlmBindingParameters{AlmSetting: ptr.To("bitbucket"), Repository: "my-repo", Slug: ptr.To("PROJ")},
+			observation: v1alpha1.ProjectAlmBindingObservation{AlmSetting: "bitbucket", Repository: "my-repo", Slug: "OTHER"},
+		},
+		"MonorepoChanged": {
+			spec:        v1alpha1.ProjectAlmBindingParameters{AlmSetting: ptr.To("gitlab"), Repository: "12345", Monorepo: ptr.To(true)},
+			observation: v1alpha1.ProjectAlmBindingObservation{AlmSetting: "gitlab", Repository: "12345"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsProjectAlmBindingUpToDate(&tc.spec, &tc.observation); got != tc.want {
+				t.Errorf("IsProjectAlmBindingUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAlmSettingsClientBinding(t *testing.T) {
+	var setQuery map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/alm_settings/get_binding":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key":"github","alm":"github","repository":"my-org/my-repo","url":"https://api.github.com/","summaryCommentEnabled":true,"monorepo":false}`))
+		case "/api/alm_settings/set_github_binding":
+			setQuery = r.URL.Query()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewAlmSettingsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	spec := v1alpha1.ProjectAlmBindingParameters{
+		ProjectKey:            ptr.To("my-project"),
+		AlmSetting:            ptr.To("github"),
+		Repository:            "my-org/my-repo",
+		SummaryCommentEnabled: ptr.To(true),
+	}
+
+	setResp, err := client.SetGithubBinding(GenerateProjectAlmBindingSetGithubOption(spec))
+	if setResp != nil {
+		defer setResp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("SetGithubBinding() error = %v", err)
+	}
+	wantQuery := map[string][]string{
+		"almSetting":            {"github"},
+		"monorepo":              {"false"},
+		"project":               {"my-project"},
+		"repository":            {"my-org/my-repo"},
+		"summaryCommentEnabled": {"true"},
+	}
+	if diff := cmp.Diff(wantQuery, setQuery); diff != "" {
+		t.Errorf("SetGithubBinding() parameters mismatch (-want +got):\n%s", diff)
+	}
+
+	binding, resp, err := client.GetBinding(GenerateProjectAlmBindingGetOption(spec))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("GetBinding() error = %v", err)
+	}
+	observation := GenerateProjectAlmBindingObservation(binding)
+	if !IsProjectAlmBindingUpToDate(&spec, &observation) {
+		t.Errorf("IsProjectAlmBindingUpToDate() = false, want true")
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"strconv"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// ProjectBranchesClient is the interface for interacting with SonarQube Project Branches API
+// It handles listing, renaming and deleting the branches of a Project, as well as selecting its main branch.
+type ProjectBranchesClient interface {
+	Delete(opt *sonargo.ProjectBranchesDeleteOption) (resp *http.Response, err error)
+	List(opt *sonargo.ProjectBranchesListOption) (v *sonargo.ProjectBranchesListObject, resp *http.Response, err error)
+	Rename(opt *sonargo.ProjectBranchesRenameOption) (resp *http.Response, err error)
+	SetAutomaticDeletionProtection(opt *sonargo.ProjectBranchesSetAutomaticDeletionProtectionOption) (resp *http.Response, err error)
+	SetMain(opt *sonargo.ProjectBranchesSetMainOption) (resp *http.Response, err error)
+}
+
+// NewProjectBranchesClient creates a new ProjectBranchesClient with the provided SonarQube client configuration.
+func NewProjectBranchesClient(clientConfig common.Config) ProjectBranchesClient {
+	newClient := common.NewClient(clientConfig)
+	return newClient.ProjectBranches
+}
+
+// GenerateProjectBranchListOption generates SonarQube ProjectBranchesListOption to list the branches of the Project of the ProjectBranch
+func GenerateProjectBranchListOption(spec v1alpha1.ProjectBranchParameters) *sonargo.ProjectBranchesListOption {
+	return &sonargo.ProjectBranchesListOption{
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateProjectBranchRenameOption generates SonarQube ProjectBranchesRenameOption to rename the main branch of the Project to the ProjectBranch name
+func GenerateProjectBranchRenameOption(spec v1alpha1.ProjectBranchParameters) *sonargo.ProjectBranchesRenameOption {
+	return &sonargo.ProjectBranchesRenameOption{
+		Name:    spec.Name,
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateProjectBranchSetMainOption generates SonarQube ProjectBranchesSetMainOption to make the ProjectBranch the main branch of its Project
+func GenerateProjectBranchSetMainOption(spec v1alpha1.ProjectBranchParameters) *sonargo.ProjectBranchesSetMainOption {
+	return &sonargo.ProjectBranchesSetMainOption{
+		Branch:  spec.Name,
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateProjectBranchSetAutomaticDeletionProtectionOption generates SonarQube ProjectBranchesSetAutomaticDeletionProtectionOption
+// to protect the ProjectBranch from automatic deletion, or not
+func GenerateProjectBranchSetAutomaticDeletionProtectionOption(spec v1alpha1.ProjectBranchParameters) *sonargo.ProjectBranchesSetAutomaticDeletionProtectionOption {
+	return &sonargo.ProjectBranchesSetAutomaticDeletionProtectionOption{
+		Branch:  spec.Name,
+		Project: ptr.Deref(spec.ProjectKey, ""),
+		Value:   strconv.FormatBool(ptr.Deref(spec.ExcludedFromPurge, false)),
+	}
+}
+
+// GenerateProjectBranchDeleteOption generates SonarQube ProjectBranchesDeleteOption to delete the ProjectBranch
+func GenerateProjectBranchDeleteOption(spec v1alpha1.ProjectBranchParameters) *sonargo.ProjectBranchesDeleteOption {
+	return &sonargo.ProjectBranchesDeleteOption{
+		Branch:  spec.Name,
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// FindProjectBranch finds the branch of the ProjectBranch in the SonarQube branches list
+// When the branch does not exist yet and IsMain is true, the main branch is returned instead, so that it is renamed
+// The main branch is only returned if it has the observed ID, if any, so that another branch is never renamed
+// It returns nil if there is no such branch
+func FindProjectBranch(branches *sonargo.ProjectBranchesListObject, spec *v1alpha1.ProjectBranchParameters, observation *v1alpha1.ProjectBranchObservation) *sonargo.ProjectBranchesListObject_sub2 {
+	if branches == nil || spec == nil {
+		return nil
+	}
+	for i := range branches.Branches {
+		if branches.Branches[i].Name == spec.Name {
+			return &branches.Branches[i]
+		}
+	}
+	if !ptr.Deref(spec.IsMain, false) {
+		return nil
+	}
+	for i := range branches.Branches {
+		if !branches.Branches[i].IsMain {
+			continue
+		}
+		if observation != nil && observation.BranchID != "" && branches.Branches[i].BranchID != observation.BranchID {
+			return nil
+		}
+		return &branches.Branches[i]
+	}
+	return nil
+}
+
+// GenerateProjectBranchObservation generates ProjectBranchObservation from SonarQube ProjectBranchesListObject_sub2
+// branch should not be nil, else it will panic
+func GenerateProjectBranchObservation(branch *sonargo.ProjectBranchesListObject_sub2) v1alpha1.ProjectBranchObservation {
+	return v1alpha1.ProjectBranchObservation{
+		AnalysisDate:      branch.AnalysisDate,
+		BranchID:          branch.BranchID,
+		ExcludedFromPurge: branch.ExcludedFromPurge,
+		IsMain:            branch.IsMain,
+		Name:              branch.Name,
+		QualityGateStatus: branch.Status.QualityGateStatus,
+		Type:              branch.Type,
+	}
+}
+
+// LateInitializeProjectBranch fills the empty fields of *ProjectBranchParameters with the values seen in ProjectBranchObservation
+// IsMain is not late-initialized, so that the ProjectBranch does not take the main branch back once another branch is made the main one.
+func LateInitializeProjectBranch(spec *v1alpha1.ProjectBranchParameters, observation *v1alpha1.ProjectBranchObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	helpers.AssignIfNil(&spec.ExcludedFromPurge, observation.ExcludedFromPurge)
+}
+
+// IsProjectBranchRenamed checks whether the observed branch is the main branch under another name than the desired one
+func IsProjectBranchRenamed(spec *v1alpha1.ProjectBranchParameters, observation *v1alpha1.ProjectBranchObservation) bool {
+	return spec.Name != observation.Name
+}
+
+// IsProjectBranchMainMissing checks whether the ProjectBranch should be made the main branch of its Project
+func IsProjectBranchMainMissing(spec *v1alpha1.ProjectBranchParameters, observation *v1alpha1.ProjectBranchObservation) bool {
+	return ptr.Deref(spec.IsMain, false) && !observation.IsMain
+}
+
+// IsProjectBranchProtectionOutdated checks whether the protection from automatic deletion of the branch should be updated
+// The protection of the main branch cannot be updated
+func IsProjectBranchProtectionOutdated(spec *v1alpha1.ProjectBranchParameters, observation *v1alpha1.ProjectBranchObservation) bool {
+	if spec.ExcludedFromPurge == nil || ptr.Deref(spec.IsMain, false) || observation.IsMain {
+		return false
+	}
+	return *spec.ExcludedFromPurge != observation.ExcludedFromPurge
+}
+
+// IsProjectBranchUpToDate checks if the ProjectBranch spec is up to date with the observed state
+func IsProjectBranchUpToDate(spec *v1alpha1.ProjectBranchParameters, observation *v1alpha1.ProjectBranchObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+	return !IsProjectBranchRenamed(spec, observation) &&
+		!IsProjectBranchMainMissing(spec, observation) &&
+		!IsProjectBranchProtectionOutdated(spec, observation)
+}
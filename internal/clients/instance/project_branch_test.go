@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestFindProjectBranch(t *testing.T) {
+	branches := &sonargo.ProjectBranchesListObject{
+		Branches: []sonargo.ProjectBranchesListObject_sub2{
+			{Name: "master", BranchID: "b0", IsMain: true},
+			{Name: "release-1.0", BranchID: "b1"},
+		},
+	}
+
+	tests := map[string]struct {
+		spec        v1alpha1.ProjectBranchParameters
+		observation v1alpha1.ProjectBranchObservation
+		want        string
+	}{
+		"Existing": {
+			spec: v1alpha1.ProjectBranchParameters{Name: "release-1.0"},
+			want: "release-1.0",
+		},
+		"MissingBranch": {
+			spec: v1alpha1.ProjectBranchParameters{Name: "release-2.0"},
+		},
+		"MissingMainBranchIsRenamed": {
+			spec: v1alpha1.ProjectBranchParameters{Name: "main", IsMain: ptr.To(true)},
+			want: "master",
+		},
+		"ObservedMainBranchIsRenamed": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "main", IsMain: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "master", BranchID: "b0", IsMain: true},
+			want:        "master",
+		},
+		"ObservedBranchIsNotRenamed": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "release-2.0"},
+			observation: v1alpha1.ProjectBranchObservation{Name: "release-1.0", BranchID: "b1"},
+		},
+		"ObservedBranchMadeMainDoesNotRenameMainBranch": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "release-2.0", IsMain: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "release-1.0", BranchID: "b1"},
+		},
+		"ExistingIsMadeMain": {
+			spec: v1alpha1.ProjectBranchParameters{Name: "release-1.0", IsMain: ptr.To(true)},
+			want: "release-1.0",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ""
+			if branch := FindProjectBranch(branches, &tc.spec, &tc.observation); branch != nil {
+				got = branch.Name
+			}
+			if got != tc.want {
+				t.Errorf("FindProjectBranch() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeProjectBranch(t *testing.T) {
+	spec := &v1alpha1.ProjectBranchParameters{Name: "master"}
+	LateInitializeProjectBranch(spec, &v1alpha1.ProjectBranchObservation{Name: "master", IsMain: true, ExcludedFromPurge: true})
+	if diff := cmp.Diff(&v1alpha1.ProjectBranchParameters{Name: "master", ExcludedFromPurge: ptr.To(true)}, spec); diff != "" {
+		t.Errorf("LateInitializeProjectBranch() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsProjectBranchUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		spec        v1alpha1.ProjectBranchParameters
+		observation v1alpha1.ProjectBranchObservation
+		want        bool
+	}{
+		"UpToDate": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "release-1.0", ExcludedFromPurge: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "release-1.0", ExcludedFromPurge: true},
+			want:        true,
+		},
+		"Renamed": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "main", IsMain: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "master", IsMain: true, ExcludedFromPurge: true},
+		},
+		"NotMain": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "develop", IsMain: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "develop"},
+		},
+		"NoLongerMainIgnored": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "master", IsMain: ptr.To(false)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "master", IsMain: true, ExcludedFromPurge: true},
+			want:        true,
+		},
+		"ProtectionChanged": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "release-1.0", ExcludedFromPurge: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "release-1.0"},
+		},
+		"MainProtectionIgnored": {
+			spec:        v1alpha1.ProjectBranchParameters{Name: "master", ExcludedFromPurge: ptr.To(false)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "master", IsMain: true, ExcludedFromPurge: true},
+			want:        true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsProjectBranchUpToDate(&tc.spec, &tc.observation); got != tc.want {
+				t.Errorf("IsProjectBranchUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
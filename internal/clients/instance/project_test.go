@@ -0,0 +1,356 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestGenerateProjectCreateOptions(t *testing.T) {
+	tests := map[string]struct {
+		spec v1alpha1.ProjectParameters
+		want *sonargo.ProjectsCreateOption
+	}{
+		"MinimalCreateOption": {
+			spec: v1alpha1.ProjectParameters{
+				Key:  "my-project",
+				Name: "My Project",
+			},
+			want: &sonargo.ProjectsCreateOption{
+				Project: "my-project",
+				Name:    "My Project",
+			},
+		},
+		"FullCreateOption": {
+			spec: v1alpha1.ProjectParameters{
+				Key:        "my-project",
+				Name:       "My Project",
+				Visibility: ptr.To("private"),
+				MainBranch: ptr.To("develop"),
+				Tags:       []string{"finance"},
+			},
+			want: &sonargo.ProjectsCreateOption{
+				Project:    "my-project",
+				Name:       "My Project",
+				Visibility: "private",
+				MainBranch: "develop",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateProjectCreateOptions(tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateProjectCreateOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProjectTagsSetOptions(t *testing.T) {
+	tests := map[string]struct {
+		key  string
+		tags []string
+		want *sonargo.ProjectTagsSetOption
+	}{
+		"NoTags": {
+			key:  "my-project",
+			tags: nil,
+			want: &sonargo.ProjectTagsSetOption{Project: "my-project"},
+		},
+		"MultipleTags": {
+			key:  "my-project",
+			tags: []string{"finance", "offshore"},
+			want: &sonargo.ProjectTagsSetOption{Project: "my-project", Tags: "finance,offshore"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateProjectTagsSetOptions(tc.key, tc.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateProjectTagsSetOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindProject(t *testing.T) {
+	tests := map[string]struct {
+		projects *sonargo.ProjectsSearchObject
+		key      string
+		want     *sonargo.ProjectsSearchObject_sub1
+	}{
+		"NilProjects": {
+			projects: nil,
+			key:      "my-project",
+			want:     nil,
+		},
+		"NoMatch": {
+			projects: &sonargo.ProjectsSearchObject{
+				Components: []sonargo.ProjectsSearchObject_sub1{{Key: "other-project"}},
+			},
+			key:  "my-project",
+			want: nil,
+		},
+		"Match": {
+			projects: &sonargo.ProjectsSearchObject{
+				Components: []sonargo.ProjectsSearchObject_sub1{
+					{Key: "other-project"},
+					{Key: "my-project", Name: "My Project"},
+				},
+			},
+			key:  "my-project",
+			want: &sonargo.ProjectsSearchObject_sub1{Key: "my-project", Name: "My Project"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FindProject(tc.projects, tc.key)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindProject() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindProjectTags(t *testing.T) {
+	tests := map[string]struct {
+		components *sonargo.ComponentsSearchProjectsObject
+		key        string
+		want       []string
+	}{
+		"NilComponents": {
+			components: nil,
+			key:        "my-project",
+			want:       nil,
+		},
+		"IgnoresPartialKeyMatches": {
+			components: &sonargo.ComponentsSearchProjectsObject{
+				Components: []sonargo.ComponentsSearchProjectsObject_sub1{
+					{Key: "my-project-2", Tags: []string{"other"}},
+					{Key: "my-project", Tags: []string{"finance"}},
+				},
+			},
+			key:  "my-project",
+			want: []string{"finance"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FindProjectTags(tc.components, tc.key)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindProjectTags() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindMainBranch(t *testing.T) {
+	tests := map[string]struct {
+		branches *sonargo.ProjectBranchesListObject
+		want     string
+	}{
+		"NilBranches": {
+			branches: nil,
+			want:     "",
+		},
+		"NoMainBranch": {
+			branches: &sonargo.ProjectBranchesListObject{
+				Branches: []sonargo.ProjectBranchesListObject_sub2{{Name: "feature"}},
+			},
+			want: "",
+		},
+		"MainBranch": {
+			branches: &sonargo.ProjectBranchesListObject{
+				Branches: []sonargo.ProjectBranchesListObject_sub2{
+					{Name: "feature"},
+					{Name: "main", IsMain: true},
+				},
+			},
+			want: "main",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FindMainBranch(tc.branches)
+			if got != tc.want {
+				t.Errorf("FindMainBranch() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGenerateProjectObservation(t *testing.T) {
+	project := &sonargo.ProjectsSearchObject_sub1{
+		Key:              "my-project",
+		Name:             "My Project",
+		LastAnalysisDate: "2026-01-01T00:00:00+0000",
+		Managed:          true,
+		ProjectUUID:      "uuid-123",
+		Qualifier:        "TRK",
+		Revision:         "abc123",
+		Visibility:       "public",
+	}
+	want := v1alpha1.ProjectObservation{
+		Key:              "my-project",
+		LastAnalysisDate: "2026-01-01T00:00:00+0000",
+		MainBranch:       "main",
+		Managed:          true,
+		Name:             "My Project",
+		Qualifier:        "TRK",
+		Revision:         "abc123",
+		Tags:             []string{"finance"},
+		UUID:             "uuid-123",
+		Visibility:       "public",
+	}
+
+	got := GenerateProjectObservation(project, "main", []string{"finance"})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateProjectObservation() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsProjectUpToDate(t *testing.T) {
+	observation := &v1alpha1.ProjectObservation{
+		Key:        "my-project",
+		Name:       "My Project",
+		MainBranch: "main",
+		Tags:       []string{"finance", "offshore"},
+		Visibility: "public",
+	}
+
+	tests := map[string]struct {
+		spec        *v1alpha1.ProjectParameters
+		observation *v1alpha1.ProjectObservation
+		want        bool
+	}{
+		"NilSpec": {
+			spec:        nil,
+			observation: observation,
+			want:        true,
+		},
+		"NilObservation": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project"},
+			observation: nil,
+			want:        false,
+		},
+		"UpToDate": {
+			spec: &v1alpha1.ProjectParameters{
+				Key:        "my-project",
+				Name:       "My Project",
+				Visibility: ptr.To("public"),
+				MainBranch: ptr.To("main"),
+				Tags:       []string{"offshore", "finance"},
+			},
+			observation: observation,
+			want:        true,
+		},
+		"UnmanagedOptionalFields": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project"},
+			observation: observation,
+			want:        true,
+		},
+		"NameIsIgnored": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project", Name: "Other Name"},
+			observation: observation,
+			want:        true,
+		},
+		"KeyRenamed": {
+			spec:        &v1alpha1.ProjectParameters{Key: "new-project", Name: "My Project"},
+			observation: observation,
+			want:        false,
+		},
+		"VisibilityChanged": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project", Visibility: ptr.To("private")},
+			observation: observation,
+			want:        false,
+		},
+		"MainBranchChanged": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project", MainBranch: ptr.To("develop")},
+			observation: observation,
+			want:        false,
+		},
+		"TagsChanged": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project", Tags: []string{"finance"}},
+			observation: observation,
+			want:        false,
+		},
+		"EmptyTagsRemovesAll": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project", Tags: []string{}},
+			observation: observation,
+			want:        false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := IsProjectUpToDate(tc.spec, tc.observation)
+			if got != tc.want {
+				t.Errorf("IsProjectUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeProject(t *testing.T) {
+	tests := map[string]struct {
+		spec        *v1alpha1.ProjectParameters
+		observation *v1alpha1.ProjectObservation
+		want        *v1alpha1.ProjectParameters
+	}{
+		"NilSpec": {
+			spec:        nil,
+			observation: &v1alpha1.ProjectObservation{},
+			want:        nil,
+		},
+		"FillsEmptyFields": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project"},
+			observation: &v1alpha1.ProjectObservation{Visibility: "public", MainBranch: "main"},
+			want:        &v1alpha1.ProjectParameters{Key: "my-project", Visibility: ptr.To("public"), MainBranch: ptr.To("main")},
+		},
+		"KeepsExistingFields": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project", Visibility: ptr.To("private"), MainBranch: ptr.To("develop")},
+			observation: &v1alpha1.ProjectObservation{Visibility: "public", MainBranch: "main"},
+			want:        &v1alpha1.ProjectParameters{Key: "my-project", Visibility: ptr.To("private"), MainBranch: ptr.To("develop")},
+		},
+		"IgnoresEmptyObservedMainBranch": {
+			spec:        &v1alpha1.ProjectParameters{Key: "my-project"},
+			observation: &v1alpha1.ProjectObservation{Visibility: "public"},
+			want:        &v1alpha1.ProjectParameters{Key: "my-project", Visibility: ptr.To("public")},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeProject(tc.spec, tc.observation)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeProject() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
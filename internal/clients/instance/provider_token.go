@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"time"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+)
+
+// providerTokenNameTimeLayout is the layout of the generation time suffixing the name of the tokens generated for a ProviderConfig
+const providerTokenNameTimeLayout = "20060102-150405"
+
+// GenerateProviderTokenGenerateOption generates SonarQube UserTokensGenerateOption to rotate the token of a ProviderConfig
+// The token is generated for the User owning the current token, and named after its generation time so that successive tokens never collide
+func GenerateProviderTokenGenerateOption(rotation apisv1alpha1.TokenRotation, now time.Time) *sonargo.UserTokensGenerateOption {
+	option := &sonargo.UserTokensGenerateOption{
+		Name: ptr.Deref(rotation.TokenNamePrefix, "crossplane-provider-sonarqube") + "-" + now.UTC().Format(providerTokenNameTimeLayout),
+	}
+	if rotation.ExpiresAfter != nil {
+		option.ExpirationDate = userTokenExpirationDate(now, rotation.ExpiresAfter.Duration)
+	}
+	return option
+}
+
+// ProviderTokenRotationDelay returns how long to wait before the token of a ProviderConfig is due for rotation
+// A token that was never rotated is due immediately, in which case the delay is zero
+func ProviderTokenRotationDelay(rotation apisv1alpha1.TokenRotation, lastRotated *metav1.Time, now time.Time) time.Duration {
+	if lastRotated == nil {
+		return 0
+	}
+	delay := lastRotated.Add(rotation.Interval.Duration).Sub(now)
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
+
+// ProviderTokenToRevoke returns the name of the token replaced by the rotation of the token of a ProviderConfig
+// It is the token generated by the previous rotation, or the initial token before the first one
+func ProviderTokenToRevoke(rotation apisv1alpha1.TokenRotation, status apisv1alpha1.ProviderConfigStatus) string {
+	if status.TokenName != "" {
+		return status.TokenName
+	}
+	return ptr.Deref(rotation.InitialTokenName, "")
+}
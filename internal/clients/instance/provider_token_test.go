@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+	"time"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+)
+
+func TestGenerateProviderTokenGenerateOption(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 15, 30, 5, 0, time.UTC)
+
+	tests := map[string]struct {
+		rotation apisv1alpha1.TokenRotation
+		want     *sonargo.UserTokensGenerateOption
+	}{
+		"DefaultPrefix": {
+			rotation: apisv1alpha1.TokenRotation{Interval: metav1.Duration{Duration: 24 * time.Hour}},
+			want:     &sonargo.UserTokensGenerateOption{Name: "crossplane-provider-sonarqube-20260310-153005"},
+		},
+		"PrefixAndLifetime": {
+			rotation: apisv1alpha1.TokenRotation{TokenNamePrefix: ptr.To("admin"), ExpiresAfter: &metav1.Duration{Duration: 48 * time.Hour}},
+			want:     &sonargo.UserTokensGenerateOption{Name: "admin-20260310-153005", ExpirationDate: "2026-03-13"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateProviderTokenGenerateOption(tc.rotation, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateProviderTokenGenerateOption() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderTokenRotationDelay(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	rotation := apisv1alpha1.TokenRotation{Interval: metav1.Duration{Duration: 24 * time.Hour}}
+
+	tests := map[string]struct {
+		lastRotated *metav1.Time
+		want        time.Duration
+	}{
+		"NeverRotated": {
+			lastRotated: nil,
+			want:        0,
+		},
+		"NotYetDue": {
+			lastRotated: &metav1.Time{Time: now.Add(-20 * time.Hour)},
+			want:        4 * time.Hour,
+		},
+		"Overdue": {
+			lastRotated: &metav1.Time{Time: now.Add(-30 * time.Hour)},
+			want:        0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ProviderTokenRotationDelay(rotation, tc.lastRotated, now); got != tc.want {
+				t.Errorf("ProviderTokenRotationDelay() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestProviderTokenToRevoke(t *testing.T) {
+	rotation := apisv1alpha1.TokenRotation{InitialTokenName: ptr.To("bootstrap")}
+
+	if got := ProviderTokenToRevoke(rotation, apisv1alpha1.ProviderConfigStatus{}); got != "bootstrap" {
+		t.Errorf("ProviderTokenToRevoke() = %q, want the initial token", got)
+	}
+	if got := ProviderTokenToRevoke(rotation, apisv1alpha1.ProviderConfigStatus{TokenName: "admin-20260310-153005"}); got != "admin-20260310-153005" {
+		t.Errorf("ProviderTokenToRevoke() = %q, want the last generated token", got)
+	}
+	if got := ProviderTokenToRevoke(apisv1alpha1.TokenRotation{}, apisv1alpha1.ProviderConfigStatus{}); got != "" {
+		t.Errorf("ProviderTokenToRevoke() = %q, want no token", got)
+	}
+}
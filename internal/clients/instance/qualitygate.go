@@ -18,6 +18,7 @@ package instance
 
 import (
 	"net/http"
+	"strconv"
 
 	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
 	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
@@ -48,6 +49,7 @@ type QualityGatesClient interface {
 	SearchGroups(opt *sonargo.QualitygatesSearchGroupsOption) (v *sonargo.QualitygatesSearchGroupsObject, resp *http.Response, err error)
 	SearchUsers(opt *sonargo.QualitygatesSearchUsersOption) (v *sonargo.QualitygatesSearchUsersObject, resp *http.Response, err error)
 	Select(opt *sonargo.QualitygatesSelectOption) (resp *http.Response, err error)
+	SetAiCodeAssurance(opt *QualitygatesSetAiCodeAssuranceOption) (resp *http.Response, err error)
 	SetAsDefault(opt *sonargo.QualitygatesSetAsDefaultOption) (resp *http.Response, err error)
 	Show(opt *sonargo.QualitygatesShowOption) (v *sonargo.QualitygatesShowObject, resp *http.Response, err error)
 	UpdateCondition(opt *sonargo.QualitygatesUpdateConditionOption) (resp *http.Response, err error)
@@ -56,7 +58,31 @@ type QualityGatesClient interface {
 // NewQualityGatesClient creates a new QualityGatesClient with the provided SonarQube client configuration.
 func NewQualityGatesClient(clientConfig common.Config) QualityGatesClient {
 	newClient := common.NewClient(clientConfig)
-	return newClient.Qualitygates
+	return &qualityGatesClient{
+		QualitygatesService: newClient.Qualitygates,
+		client:              newClient,
+	}
+}
+
+// QualitygatesSetAiCodeAssuranceOption is the option of the qualitygates/set_ai_code_assurance endpoint, which the SonarQube client does not provide
+type QualitygatesSetAiCodeAssuranceOption struct {
+	AiCodeAssurance string `url:"aiCodeAssurance,omitempty"` // Description:"Whether the Quality Gate qualifies for AI Code Assurance",PossibleValues:"true,false"
+	GateName        string `url:"gateName,omitempty"`        // Description:"Name of the Quality Gate",ExampleValue:"My Quality Gate"
+}
+
+// qualityGatesClient extends the SonarQube client QualitygatesService with the endpoints it does not provide
+type qualityGatesClient struct {
+	*sonargo.QualitygatesService
+	client *sonargo.Client
+}
+
+// SetAiCodeAssurance sets whether the Quality Gate qualifies for AI Code Assurance
+func (c *qualityGatesClient) SetAiCodeAssurance(opt *QualitygatesSetAiCodeAssuranceOption) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "qualitygates/set_ai_code_assurance", opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
 }
 
 // GenerateQualityGateCreateOptions generates SonarQube QualitygatesCreateOption from QualityGateParameters
@@ -66,6 +92,36 @@ func GenerateQualityGateCreateOptions(spec v1alpha1.QualityGateParameters) *sona
 	}
 }
 
+// GenerateQualityGateCopyOptions generates SonarQube QualitygatesCopyOption from QualityGateParameters
+// spec.CopyFrom should not be nil, else it will panic
+func GenerateQualityGateCopyOptions(spec v1alpha1.QualityGateParameters) *sonargo.QualitygatesCopyOption {
+	return &sonargo.QualitygatesCopyOption{
+		Name:       spec.Name,
+		SourceName: *spec.CopyFrom,
+	}
+}
+
+// IsQualityGateCopy returns true if the Quality Gate should be created by copying another Quality Gate
+func IsQualityGateCopy(spec v1alpha1.QualityGateParameters) bool {
+	return spec.CopyFrom != nil && *spec.CopyFrom != ""
+}
+
+// GenerateQualityGateRenameOption generates SonarQube QualitygatesRenameOption to rename a Quality Gate
+func GenerateQualityGateRenameOption(currentName, name string) *sonargo.QualitygatesRenameOption {
+	return &sonargo.QualitygatesRenameOption{
+		CurrentName: currentName,
+		Name:        name,
+	}
+}
+
+// GenerateQualityGateSetAiCodeAssuranceOption generates QualitygatesSetAiCodeAssuranceOption to set whether a Quality Gate qualifies for AI Code Assurance
+func GenerateQualityGateSetAiCodeAssuranceOption(gateName string, aiCodeSupported bool) *QualitygatesSetAiCodeAssuranceOption {
+	return &QualitygatesSetAiCodeAssuranceOption{
+		AiCodeAssurance: strconv.FormatBool(aiCodeSupported),
+		GateName:        gateName,
+	}
+}
+
 // GenerateQualityGateObservation generates QualityGateObservation from SonarQube QualitygatesShowObject
 // observation should not be nil, else it will panic
 func GenerateQualityGateObservation(observation *sonargo.QualitygatesShowObject) v1alpha1.QualityGateObservation {
@@ -112,10 +168,26 @@ func IsQualityGateUpToDate(spec *v1alpha1.QualityGateParameters, observation *v1
 		return false
 	}
 
+	if !helpers.IsComparablePtrEqualComparable(spec.AiCodeSupported, observation.IsAiCodeSupported) {
+		return false
+	}
+
 	if !AreQualityGateConditionsUpToDate(associations) {
 		return false
 	}
 
+	if !AreQualityGateProjectsUpToDate(spec.Projects, observation.Projects) {
+		return false
+	}
+
+	if !AreQualityGateDelegatesUpToDate(spec.DelegatedUsers, observation.DelegatedUsers) {
+		return false
+	}
+
+	if !AreQualityGateDelegatesUpToDate(spec.DelegatedGroups, observation.DelegatedGroups) {
+		return false
+	}
+
 	return true
 }
 
@@ -143,6 +215,7 @@ func findMatchingObservationId(specCondition v1alpha1.QualityGateConditionParame
 
 // LateInitializeQualityGate fills the spec with the observed state if the spec fields are nil
 // It also late-initializes condition IDs by matching conditions by their metric, error, and op fields
+// The conditions of a copied Quality Gate are merged with the copied ones the first time it is observed, which is recorded in the observation
 // If a condition has a stale ID (doesn't exist in observations), it will be updated to the correct ID
 func LateInitializeQualityGate(spec *v1alpha1.QualityGateParameters, observation *v1alpha1.QualityGateObservation) {
 	if spec == nil || observation == nil {
@@ -150,6 +223,15 @@ func LateInitializeQualityGate(spec *v1alpha1.QualityGateParameters, observation
 	}
 
 	helpers.AssignIfNil(&spec.Default, observation.IsDefault)
+	helpers.AssignIfNil(&spec.AiCodeSupported, observation.IsAiCodeSupported)
+
+	// A copied Quality Gate inherits the conditions of its source, they are merged once with the specified conditions
+	// Merging again is harmless, should the status recording the merge be lost when the merged spec is persisted
+	if IsQualityGateCopy(*spec) && !observation.ConditionsCopied {
+		MergeQualityGateConditions(spec, observation)
+		observation.ConditionsCopied = true
+		return
+	}
 
 	// Build a map of observation IDs for quick lookup
 	observationIdSet := buildObservationIdSet(observation.Conditions)
@@ -171,6 +253,41 @@ func LateInitializeQualityGate(spec *v1alpha1.QualityGateParameters, observation
 	}
 }
 
+// AdoptQualityGateConditions fills the conditions of the spec with all the observed conditions when none is specified
+// It is used for Quality Gates whose conditions were not set by the provider, such as copied or imported Quality Gates
+// It returns true if the conditions were adopted
+func AdoptQualityGateConditions(spec *v1alpha1.QualityGateParameters, observation *v1alpha1.QualityGateObservation) bool {
+	if spec == nil || observation == nil {
+		return false
+	}
+	if len(spec.Conditions) > 0 || len(observation.Conditions) == 0 {
+		return false
+	}
+	spec.Conditions = GenerateQualityGateConditionsParameters(observation.Conditions)
+	return true
+}
+
+// MergeQualityGateConditions merges the observed conditions of a copied Quality Gate into the conditions of the spec
+// A specified condition wins over the copied condition on the same metric, whose ID it takes so that it is updated in place
+// The other copied conditions are appended to the spec, so that only the specified thresholds are tweaked
+// It is only called once the Quality Gate has been created or adopted, so that a copied condition removed from the spec afterwards is deleted
+func MergeQualityGateConditions(spec *v1alpha1.QualityGateParameters, observation *v1alpha1.QualityGateObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+	specMetrics := make(map[string]int, len(spec.Conditions))
+	for i := range spec.Conditions {
+		specMetrics[spec.Conditions[i].Metric] = i
+	}
+	for _, condition := range GenerateQualityGateConditionsParameters(observation.Conditions) {
+		if i, ok := specMetrics[condition.Metric]; ok {
+			spec.Conditions[i].Id = condition.Id
+			continue
+		}
+		spec.Conditions = append(spec.Conditions, condition)
+	}
+}
+
 // WereQualityGateConditionsLateInitialized checks if any conditions had their IDs late-initialized
 // by comparing the before and after states
 func WereQualityGateConditionsLateInitialized(before, after []v1alpha1.QualityGateConditionParameters) bool {
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+// caycBestValueMetrics are the metrics a Clean as You Code compliant Quality Gate must have a condition on, with their required error threshold
+var caycBestValueMetrics = map[string]float64{
+	"new_violations":                 0,
+	"new_security_hotspots_reviewed": 100,
+}
+
+// caycLegacyBestValueMetrics are the metrics a Quality Gate created before the new_violations metric must have a condition on instead, with their required error threshold
+var caycLegacyBestValueMetrics = map[string]float64{
+	"new_maintainability_rating":     1,
+	"new_reliability_rating":         1,
+	"new_security_hotspots_reviewed": 100,
+	"new_security_rating":            1,
+}
+
+// caycExistenceMetrics are the metrics a Clean as You Code compliant Quality Gate must have a condition on, whatever its error threshold
+var caycExistenceMetrics = []string{
+	"new_coverage",
+	"new_duplicated_lines_density",
+}
+
+// GetQualityGateCaycPolicy returns the Clean as You Code policy of the Quality Gate, defaulting to Ignore
+func GetQualityGateCaycPolicy(spec *v1alpha1.QualityGateParameters) string {
+	if spec == nil || spec.CaycPolicy == nil || *spec.CaycPolicy == "" {
+		return v1alpha1.CaycPolicyIgnore
+	}
+	return *spec.CaycPolicy
+}
+
+// EvaluateQualityGateCaycStatus computes the Clean as You Code status of a set of Quality Gate conditions
+// It mirrors the SonarQube checker: every Clean as You Code metric must have a condition, with its best value when one is required,
+// and a compliant Quality Gate having conditions on other metrics is over-compliant
+func EvaluateQualityGateCaycStatus(conditions []v1alpha1.QualityGateConditionParameters) string {
+	thresholds := make(map[string]string, len(conditions))
+	for i := range conditions {
+		thresholds[conditions[i].Metric] = conditions[i].Error
+	}
+
+	bestValueMetrics := caycBestValueMetrics
+	if !meetsCaycRequirements(thresholds, bestValueMetrics) {
+		bestValueMetrics = caycLegacyBestValueMetrics
+		if !meetsCaycRequirements(thresholds, bestValueMetrics) {
+			return v1alpha1.CaycStatusNonCompliant
+		}
+	}
+
+	if len(thresholds) > len(bestValueMetrics)+len(caycExistenceMetrics) {
+		return v1alpha1.CaycStatusOverCompliant
+	}
+	return v1alpha1.CaycStatusCompliant
+}
+
+// meetsCaycRequirements checks that the conditions, indexed by metric, have the required best values and existing metrics
+func meetsCaycRequirements(thresholds map[string]string, bestValueMetrics map[string]float64) bool {
+	for metric, bestValue := range bestValueMetrics {
+		threshold, ok := thresholds[metric]
+		if !ok {
+			return false
+		}
+		value, err := strconv.ParseFloat(threshold, 64)
+		if err != nil || value != bestValue {
+			return false
+		}
+	}
+	for _, metric := range caycExistenceMetrics {
+		if _, ok := thresholds[metric]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsQualityGateCaycCompliant returns true if the Clean as You Code status is compliant or over-compliant
+func IsQualityGateCaycCompliant(status string) bool {
+	return status == v1alpha1.CaycStatusCompliant || status == v1alpha1.CaycStatusOverCompliant
+}
+
+// ValidateQualityGateCaycCompliance returns an error if the Quality Gate conditions would make it non Clean as You Code compliant
+func ValidateQualityGateCaycCompliance(conditions []v1alpha1.QualityGateConditionParameters) error {
+	if status := EvaluateQualityGateCaycStatus(conditions); !IsQualityGateCaycCompliant(status) {
+		return fmt.Errorf("the Quality Gate conditions are %s with Clean as You Code: conditions on new_violations (0), new_security_hotspots_reviewed (100), new_coverage and new_duplicated_lines_density are required", status)
+	}
+	return nil
+}
+
+// GenerateQualityGateCaycCondition generates the CaycCompliant status condition from the Clean as You Code status observed in SonarQube
+func GenerateQualityGateCaycCondition(status string) xpv1.Condition {
+	condition := xpv1.Condition{
+		Type:               v1alpha1.TypeCaycCompliant,
+		LastTransitionTime: metav1.Now(),
+	}
+	switch status {
+	case v1alpha1.CaycStatusCompliant:
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = v1alpha1.ReasonCaycCompliant
+	case v1alpha1.CaycStatusOverCompliant:
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = v1alpha1.ReasonCaycOverCompliant
+		condition.Message = "The Quality Gate has conditions on metrics beyond the Clean as You Code ones"
+	default:
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = v1alpha1.ReasonCaycNonCompliant
+		condition.Message = fmt.Sprintf("The Quality Gate is not Clean as You Code compliant (status %q)", status)
+	}
+	return condition
+}
+
+// RemoveQualityGateCaycCondition removes the CaycCompliant status condition, which is no longer reported under the Ignore policy
+func RemoveQualityGateCaycCondition(status *xpv1.ConditionedStatus) {
+	if status == nil {
+		return
+	}
+	conditions := make([]xpv1.Condition, 0, len(status.Conditions))
+	for _, condition := range status.Conditions {
+		if condition.Type != v1alpha1.TypeCaycCompliant {
+			conditions = append(conditions, condition)
+		}
+	}
+	status.Conditions = conditions
+}
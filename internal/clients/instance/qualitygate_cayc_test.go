@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+// caycConditions returns the conditions of a Clean as You Code compliant Quality Gate
+func caycConditions() []v1alpha1.QualityGateConditionParameters {
+	return []v1alpha1.QualityGateConditionParameters{
+		{Metric: "new_violations", Op: ptr.To("GT"), Error: "0"},
+		{Metric: "new_security_hotspots_reviewed", Op: ptr.To("LT"), Error: "100"},
+		{Metric: "new_coverage", Op: ptr.To("LT"), Error: "80"},
+		{Metric: "new_duplicated_lines_density", Op: ptr.To("GT"), Error: "3"},
+	}
+}
+
+func TestGetQualityGateCaycPolicy(t *testing.T) {
+	tests := map[string]struct {
+		spec *v1alpha1.QualityGateParameters
+		want string
+	}{
+		"NilSpec": {
+			spec: nil,
+			want: v1alpha1.CaycPolicyIgnore,
+		},
+		"UnsetPolicy": {
+			spec: &v1alpha1.QualityGateParameters{},
+			want: v1alpha1.CaycPolicyIgnore,
+		},
+		"EnforcePolicy": {
+			spec: &v1alpha1.QualityGateParameters{CaycPolicy: ptr.To(v1alpha1.CaycPolicyEnforce)},
+			want: v1alpha1.CaycPolicyEnforce,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := GetQualityGateCaycPolicy(tc.spec); got != tc.want {
+				t.Errorf("GetQualityGateCaycPolicy() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateQualityGateCaycStatus(t *testing.T) {
+	tests := map[string]struct {
+		conditions []v1alpha1.QualityGateConditionParameters
+		want       string
+	}{
+		"NoConditions": {
+			conditions: nil,
+			want:       v1alpha1.CaycStatusNonCompliant,
+		},
+		"Compliant": {
+			conditions: caycConditions(),
+			want:       v1alpha1.CaycStatusCompliant,
+		},
+		"CompliantWithDecimalThreshold": {
+			conditions: append(caycConditions()[1:], v1alpha1.QualityGateConditionParameters{Metric: "new_violations", Op: ptr.To("GT"), Error: "0.0"}),
+			want:       v1alpha1.CaycStatusCompliant,
+		},
+		"LegacyCompliant": {
+			conditions: append(caycConditions()[1:],
+				v1alpha1.QualityGateConditionParameters{Metric: "new_maintainability_rating", Op: ptr.To("GT"), Error: "1"},
+				v1alpha1.QualityGateConditionParameters{Metric: "new_reliability_rating", Op: ptr.To("GT"), Error: "1"},
+				v1alpha1.QualityGateConditionParameters{Metric: "new_security_rating", Op: ptr.To("GT"), Error: "1"},
+			),
+			want: v1alpha1.CaycStatusCompliant,
+		},
+		"OverCompliant": {
+			conditions: append(caycConditions(), v1alpha1.QualityGateConditionParameters{Metric: "coverage", Op: ptr.To("LT"), Error: "50"}),
+			want:       v1alpha1.CaycStatusOverCompliant,
+		},
+		"MissingExistenceMetric": {
+			conditions: caycConditions()[:3],
+			want:       v1alpha1.CaycStatusNonCompliant,
+		},
+		"WrongBestValue": {
+			conditions: append(caycConditions()[1:], v1alpha1.QualityGateConditionParameters{Metric: "new_violations", Op: ptr.To("GT"), Error: "5"}),
+			want:       v1alpha1.CaycStatusNonCompliant,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := EvaluateQualityGateCaycStatus(tc.conditions); got != tc.want {
+				t.Errorf("EvaluateQualityGateCaycStatus() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateQualityGateCaycCompliance(t *testing.T) {
+	if err := ValidateQualityGateCaycCompliance(caycConditions()); err != nil {
+		t.Errorf("ValidateQualityGateCaycCompliance() unexpected error: %v", err)
+	}
+	if err := ValidateQualityGateCaycCompliance(caycConditions()[1:]); err == nil {
+		t.Error("ValidateQualityGateCaycCompliance() expected an error for non-compliant conditions")
+	}
+}
+
+func TestGenerateQualityGateCaycCondition(t *testing.T) {
+	tests := map[string]struct {
+		status     string
+		wantStatus corev1.ConditionStatus
+		wantReason string
+	}{
+		"Compliant": {
+			status:     v1alpha1.CaycStatusCompliant,
+			wantStatus: corev1.ConditionTrue,
+			wantReason: string(v1alpha1.ReasonCaycCompliant),
+		},
+		"OverCompliant": {
+			status:     v1alpha1.CaycStatusOverCompliant,
+			wantStatus: corev1.ConditionTrue,
+			wantReason: string(v1alpha1.ReasonCaycOverCompliant),
+		},
+		"NonCompliant": {
+			status:     v1alpha1.CaycStatusNonCompliant,
+			wantStatus: corev1.ConditionFalse,
+			wantReason: string(v1alpha1.ReasonCaycNonCompliant),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityGateCaycCondition(tc.status)
+			if got.Type != v1alpha1.TypeCaycCompliant {
+				t.Errorf("GenerateQualityGateCaycCondition() type = %q, want %q", got.Type, v1alpha1.TypeCaycCompliant)
+			}
+			if got.Status != tc.wantStatus || string(got.Reason) != tc.wantReason {
+				t.Errorf("GenerateQualityGateCaycCondition() = %s/%s, want %s/%s", got.Status, got.Reason, tc.wantStatus, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestRemoveQualityGateCaycCondition(t *testing.T) {
+	status := &xpv1.ConditionedStatus{}
+	status.SetConditions(xpv1.Available(), GenerateQualityGateCaycCondition(v1alpha1.CaycStatusNonCompliant))
+
+	RemoveQualityGateCaycCondition(status)
+
+	if got := status.GetCondition(v1alpha1.TypeCaycCompliant); got.Reason != "" {
+		t.Errorf("RemoveQualityGateCaycCondition() kept condition %v", got)
+	}
+	if got := status.GetCondition(xpv1.TypeReady); got.Reason != xpv1.ReasonAvailable {
+		t.Errorf("RemoveQualityGateCaycCondition() Ready reason = %q, want %q", got.Reason, xpv1.ReasonAvailable)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
 	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
 	"github.com/crossplane/provider-sonarqube/internal/helpers"
+	"k8s.io/utils/ptr"
 )
 
 // GenerateQualityGateConditionObservation generates QualityGateConditionObservation from SonarQube QualitygatesShowObject_sub2
@@ -51,6 +52,20 @@ func GenerateQualityGateConditionsObservation(conditions []sonargo.QualitygatesS
 	return conditionObservations
 }
 
+// GenerateQualityGateConditionsParameters generates a slice of QualityGateConditionParameters from a slice of QualityGateConditionObservation
+func GenerateQualityGateConditionsParameters(observations []v1alpha1.QualityGateConditionObservation) []v1alpha1.QualityGateConditionParameters {
+	conditionParameters := make([]v1alpha1.QualityGateConditionParameters, len(observations))
+	for i, observation := range observations {
+		conditionParameters[i] = v1alpha1.QualityGateConditionParameters{
+			Id:     ptr.To(observation.ID),
+			Error:  observation.Error,
+			Metric: observation.Metric,
+			Op:     ptr.To(observation.Op),
+		}
+	}
+	return conditionParameters
+}
+
 // GenerateCreateQualityGateConditionOption generates SonarQube QualitygatesCreateConditionOption from QualityGateConditionParameters
 func GenerateCreateQualityGateConditionOption(gateName string, params v1alpha1.QualityGateConditionParameters) *sonargo.QualitygatesCreateConditionOption {
 	option := sonargo.QualitygatesCreateConditionOption{
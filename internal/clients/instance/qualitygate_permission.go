@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"strconv"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// GenerateQualityGateUsersSearchOption generates SonarQube QualitygatesSearchUsersOption to list a page of the users allowed to edit a Quality Gate
+func GenerateQualityGateUsersSearchOption(gateName string, page int) *sonargo.QualitygatesSearchUsersOption {
+	return &sonargo.QualitygatesSearchUsersOption{
+		GateName: gateName,
+		P:        strconv.Itoa(page),
+		Ps:       strconv.Itoa(QualityGateSearchPageSize),
+		Selected: "selected",
+	}
+}
+
+// GenerateQualityGateGroupsSearchOption generates SonarQube QualitygatesSearchGroupsOption to list a page of the groups allowed to edit a Quality Gate
+func GenerateQualityGateGroupsSearchOption(gateName string, page int) *sonargo.QualitygatesSearchGroupsOption {
+	return &sonargo.QualitygatesSearchGroupsOption{
+		GateName: gateName,
+		P:        strconv.Itoa(page),
+		Ps:       strconv.Itoa(QualityGateSearchPageSize),
+		Selected: "selected",
+	}
+}
+
+// GenerateQualityGateUsersObservation extracts the logins of the selected users from a SonarQube QualitygatesSearchUsersObject
+func GenerateQualityGateUsersObservation(search *sonargo.QualitygatesSearchUsersObject) []string {
+	if search == nil {
+		return nil
+	}
+	users := make([]string, 0, len(search.Users))
+	for _, user := range search.Users {
+		if user.Selected {
+			users = append(users, user.Login)
+		}
+	}
+	return users
+}
+
+// GenerateQualityGateGroupsObservation extracts the names of the selected groups from a SonarQube QualitygatesSearchGroupsObject
+func GenerateQualityGateGroupsObservation(search *sonargo.QualitygatesSearchGroupsObject) []string {
+	if search == nil {
+		return nil
+	}
+	groups := make([]string, 0, len(search.Groups))
+	for _, group := range search.Groups {
+		if group.Selected {
+			groups = append(groups, group.Name)
+		}
+	}
+	return groups
+}
+
+// HasMoreQualityGateUsers returns true if the SonarQube QualitygatesSearchUsersObject is not the last page of results
+func HasMoreQualityGateUsers(search *sonargo.QualitygatesSearchUsersObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Users), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// HasMoreQualityGateGroups returns true if the SonarQube QualitygatesSearchGroupsObject is not the last page of results
+func HasMoreQualityGateGroups(search *sonargo.QualitygatesSearchGroupsObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Groups), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// GenerateQualityGateAddUserOption generates SonarQube QualitygatesAddUserOption to allow a user to edit a Quality Gate
+func GenerateQualityGateAddUserOption(gateName, login string) *sonargo.QualitygatesAddUserOption {
+	return &sonargo.QualitygatesAddUserOption{
+		GateName: gateName,
+		Login:    login,
+	}
+}
+
+// GenerateQualityGateRemoveUserOption generates SonarQube QualitygatesRemoveUserOption to remove the ability of a user to edit a Quality Gate
+func GenerateQualityGateRemoveUserOption(gateName, login string) *sonargo.QualitygatesRemoveUserOption {
+	return &sonargo.QualitygatesRemoveUserOption{
+		GateName: gateName,
+		Login:    login,
+	}
+}
+
+// GenerateQualityGateAddGroupOption generates SonarQube QualitygatesAddGroupOption to allow a group to edit a Quality Gate
+func GenerateQualityGateAddGroupOption(gateName, groupName string) *sonargo.QualitygatesAddGroupOption {
+	return &sonargo.QualitygatesAddGroupOption{
+		GateName:  gateName,
+		GroupName: groupName,
+	}
+}
+
+// GenerateQualityGateRemoveGroupOption generates SonarQube QualitygatesRemoveGroupOption to remove the ability of a group to edit a Quality Gate
+func GenerateQualityGateRemoveGroupOption(gateName, groupName string) *sonargo.QualitygatesRemoveGroupOption {
+	return &sonargo.QualitygatesRemoveGroupOption{
+		GateName:  gateName,
+		GroupName: groupName,
+	}
+}
+
+// AreQualityGateDelegatesUpToDate checks whether the users or groups allowed to edit the Quality Gate match the desired ones
+// If the desired delegates are nil, they are not managed and are always considered up to date
+func AreQualityGateDelegatesUpToDate(specDelegates, observedDelegates []string) bool {
+	if specDelegates == nil {
+		return true
+	}
+	return helpers.IsComparableSliceEqualIgnoringOrder(specDelegates, observedDelegates)
+}
+
+// FindQualityGateDelegatesToAdd finds the desired users or groups that are not allowed to edit the Quality Gate yet
+func FindQualityGateDelegatesToAdd(specDelegates, observedDelegates []string) []string {
+	return findMissingStrings(specDelegates, observedDelegates)
+}
+
+// FindQualityGateDelegatesToRemove finds the users or groups allowed to edit the Quality Gate that are no longer desired
+// If the desired delegates are nil, they are not managed and nothing is removed
+func FindQualityGateDelegatesToRemove(specDelegates, observedDelegates []string) []string {
+	if specDelegates == nil {
+		return nil
+	}
+	return findMissingStrings(observedDelegates, specDelegates)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerateQualityGateDelegatesSearchOptions(t *testing.T) {
+	wantUsers := &sonargo.QualitygatesSearchUsersOption{GateName: "my-gate", P: "1", Ps: "500", Selected: "selected"}
+	if diff := cmp.Diff(wantUsers, GenerateQualityGateUsersSearchOption("my-gate", 1)); diff != "" {
+		t.Errorf("GenerateQualityGateUsersSearchOption() mismatch (-want +got):\n%s", diff)
+	}
+
+	wantGroups := &sonargo.QualitygatesSearchGroupsOption{GateName: "my-gate", P: "3", Ps: "500", Selected: "selected"}
+	if diff := cmp.Diff(wantGroups, GenerateQualityGateGroupsSearchOption("my-gate", 3)); diff != "" {
+		t.Errorf("GenerateQualityGateGroupsSearchOption() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateQualityGateUsersObservation(t *testing.T) {
+	tests := map[string]struct {
+		search *sonargo.QualitygatesSearchUsersObject
+		want   []string
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   nil,
+		},
+		"OnlySelectedUsers": {
+			search: &sonargo.QualitygatesSearchUsersObject{
+				Users: []sonargo.QualitygatesSearchUsersObject_sub2{
+					{Login: "alice", Selected: true},
+					{Login: "bob", Selected: false},
+				},
+			},
+			want: []string{"alice"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityGateUsersObservation(tc.search)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateQualityGateUsersObservation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateQualityGateGroupsObservation(t *testing.T) {
+	tests := map[string]struct {
+		search *sonargo.QualitygatesSearchGroupsObject
+		want   []string
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   nil,
+		},
+		"OnlySelectedGroups": {
+			search: &sonargo.QualitygatesSearchGroupsObject{
+				Groups: []sonargo.QualitygatesSearchGroupsObject_sub1{
+					{Name: "team-leads", Selected: true},
+					{Name: "sonar-users", Selected: false},
+				},
+			},
+			want: []string{"team-leads"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityGateGroupsObservation(tc.search)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateQualityGateGroupsObservation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasMoreQualityGateDelegates(t *testing.T) {
+	moreUsers := &sonargo.QualitygatesSearchUsersObject{
+		Paging: sonargo.QualitygatesSearchUsersObject_sub1{PageIndex: 1, PageSize: 500, Total: 501},
+		Users:  []sonargo.QualitygatesSearchUsersObject_sub2{{Login: "alice"}},
+	}
+	if !HasMoreQualityGateUsers(moreUsers) {
+		t.Errorf("HasMoreQualityGateUsers() = false, want true")
+	}
+	if HasMoreQualityGateUsers(nil) {
+		t.Errorf("HasMoreQualityGateUsers(nil) = true, want false")
+	}
+
+	lastGroups := &sonargo.QualitygatesSearchGroupsObject{
+		Groups: []sonargo.QualitygatesSearchGroupsObject_sub1{{Name: "team-leads"}},
+		Paging: sonargo.QualitygatesSearchGroupsObject_sub2{PageIndex: 2, PageSize: 500, Total: 501},
+	}
+	if HasMoreQualityGateGroups(lastGroups) {
+		t.Errorf("HasMoreQualityGateGroups() = true, want false")
+	}
+	if HasMoreQualityGateGroups(nil) {
+		t.Errorf("HasMoreQualityGateGroups(nil) = true, want false")
+	}
+}
+
+func TestQualityGateDelegatesReconciliation(t *testing.T) {
+	tests := map[string]struct {
+		spec        []string
+		observation []string
+		upToDate    bool
+		wantAdd     []string
+		wantRemove  []string
+	}{
+		"UnmanagedDelegates": {
+			spec:        nil,
+			observation: []string{"alice"},
+			upToDate:    true,
+			wantAdd:     nil,
+			wantRemove:  nil,
+		},
+		"SameDelegatesDifferentOrder": {
+			spec:        []string{"bob", "alice"},
+			observation: []string{"alice", "bob"},
+			upToDate:    true,
+			wantAdd:     nil,
+			wantRemove:  nil,
+		},
+		"AddAndRemove": {
+			spec:        []string{"alice", "bob"},
+			observation: []string{"alice", "carol"},
+			upToDate:    false,
+			wantAdd:     []string{"bob"},
+			wantRemove:  []string{"carol"},
+		},
+		"EmptyDelegatesRemovesAll": {
+			spec:        []string{},
+			observation: []string{"alice"},
+			upToDate:    false,
+			wantAdd:     nil,
+			wantRemove:  []string{"alice"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := AreQualityGateDelegatesUpToDate(tc.spec, tc.observation); got != tc.upToDate {
+				t.Errorf("AreQualityGateDelegatesUpToDate() = %v, want %v", got, tc.upToDate)
+			}
+			if diff := cmp.Diff(tc.wantAdd, FindQualityGateDelegatesToAdd(tc.spec, tc.observation)); diff != "" {
+				t.Errorf("FindQualityGateDelegatesToAdd() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, FindQualityGateDelegatesToRemove(tc.spec, tc.observation)); diff != "" {
+				t.Errorf("FindQualityGateDelegatesToRemove() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
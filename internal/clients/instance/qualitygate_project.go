@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"strconv"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// QualityGateSearchPageSize is the number of items requested per page when searching for the projects, users or groups associated with a Quality Gate
+const QualityGateSearchPageSize = 500
+
+// GenerateQualityGateProjectsSearchOption generates SonarQube QualitygatesSearchOption to list a page of the projects explicitly associated with a Quality Gate
+func GenerateQualityGateProjectsSearchOption(gateName string, page int) *sonargo.QualitygatesSearchOption {
+	return &sonargo.QualitygatesSearchOption{
+		GateName: gateName,
+		Page:     strconv.Itoa(page),
+		PageSize: strconv.Itoa(QualityGateSearchPageSize),
+		Selected: "selected",
+	}
+}
+
+// GenerateQualityGateProjectsObservation extracts the keys of the selected projects from a SonarQube QualitygatesSearchObject
+func GenerateQualityGateProjectsObservation(search *sonargo.QualitygatesSearchObject) []string {
+	if search == nil {
+		return nil
+	}
+	projects := make([]string, 0, len(search.Results))
+	for _, result := range search.Results {
+		if result.Selected {
+			projects = append(projects, result.Key)
+		}
+	}
+	return projects
+}
+
+// HasMoreQualityGateProjects returns true if the SonarQube QualitygatesSearchObject is not the last page of results
+func HasMoreQualityGateProjects(search *sonargo.QualitygatesSearchObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Results), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// GenerateQualityGateSelectOption generates SonarQube QualitygatesSelectOption to associate a project with a Quality Gate
+func GenerateQualityGateSelectOption(gateName, projectKey string) *sonargo.QualitygatesSelectOption {
+	return &sonargo.QualitygatesSelectOption{
+		GateName:   gateName,
+		ProjectKey: projectKey,
+	}
+}
+
+// GenerateQualityGateDeselectOption generates SonarQube QualitygatesDeselectOption to remove the association of a project with its Quality Gate
+func GenerateQualityGateDeselectOption(projectKey string) *sonargo.QualitygatesDeselectOption {
+	return &sonargo.QualitygatesDeselectOption{
+		ProjectKey: projectKey,
+	}
+}
+
+// AreQualityGateProjectsUpToDate checks whether the projects associated with the Quality Gate match the desired ones
+// If the desired projects are nil, the associations are not managed and are always considered up to date
+func AreQualityGateProjectsUpToDate(specProjects, observedProjects []string) bool {
+	if specProjects == nil {
+		return true
+	}
+	return helpers.IsComparableSliceEqualIgnoringOrder(specProjects, observedProjects)
+}
+
+// FindQualityGateProjectsToSelect finds the desired projects that are not associated with the Quality Gate yet
+func FindQualityGateProjectsToSelect(specProjects, observedProjects []string) []string {
+	return findMissingStrings(specProjects, observedProjects)
+}
+
+// FindQualityGateProjectsToDeselect finds the projects associated with the Quality Gate that are no longer desired
+// If the desired projects are nil, the associations are not managed and nothing is deselected
+func FindQualityGateProjectsToDeselect(specProjects, observedProjects []string) []string {
+	if specProjects == nil {
+		return nil
+	}
+	return findMissingStrings(observedProjects, specProjects)
+}
+
+// hasMorePages returns true if a non-empty page of search results is followed by other pages
+func hasMorePages(count int, pageIndex, pageSize, total int64) bool {
+	if count == 0 {
+		return false
+	}
+	return pageIndex*pageSize < total
+}
+
+// findMissingStrings returns the elements of source that are not in target, preserving the order of source
+func findMissingStrings(source, target []string) []string {
+	targetSet := make(map[string]bool, len(target))
+	for _, val := range target {
+		targetSet[val] = true
+	}
+	var missing []string
+	for _, val := range source {
+		if !targetSet[val] {
+			missing = append(missing, val)
+		}
+	}
+	return missing
+}
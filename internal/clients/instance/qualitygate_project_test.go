@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerateQualityGateProjectsSearchOption(t *testing.T) {
+	want := &sonargo.QualitygatesSearchOption{
+		GateName: "my-gate",
+		Page:     "2",
+		PageSize: "500",
+		Selected: "selected",
+	}
+
+	got := GenerateQualityGateProjectsSearchOption("my-gate", 2)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateQualityGateProjectsSearchOption() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateQualityGateProjectsObservation(t *testing.T) {
+	tests := map[string]struct {
+		search *sonargo.QualitygatesSearchObject
+		want   []string
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   nil,
+		},
+		"OnlySelectedProjects": {
+			search: &sonargo.QualitygatesSearchObject{
+				Results: []sonargo.QualitygatesSearchObject_sub2{
+					{Key: "project-a", Selected: true},
+					{Key: "project-b", Selected: false},
+					{Key: "project-c", Selected: true},
+				},
+			},
+			want: []string{"project-a", "project-c"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityGateProjectsObservation(tc.search)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateQualityGateProjectsObservation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasMoreQualityGateProjects(t *testing.T) {
+	tests := map[string]struct {
+		search *sonargo.QualitygatesSearchObject
+		want   bool
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   false,
+		},
+		"EmptyPage": {
+			search: &sonargo.QualitygatesSearchObject{
+				Paging: sonargo.QualitygatesSearchObject_sub1{PageIndex: 3, PageSize: 500, Total: 1200},
+			},
+			want: false,
+		},
+		"LastPage": {
+			search: &sonargo.QualitygatesSearchObject{
+				Paging:  sonargo.QualitygatesSearchObject_sub1{PageIndex: 3, PageSize: 500, Total: 1200},
+				Results: []sonargo.QualitygatesSearchObject_sub2{{Key: "project-a"}},
+			},
+			want: false,
+		},
+		"MorePages": {
+			search: &sonargo.QualitygatesSearchObject{
+				Paging:  sonargo.QualitygatesSearchObject_sub1{PageIndex: 1, PageSize: 500, Total: 1200},
+				Results: []sonargo.QualitygatesSearchObject_sub2{{Key: "project-a"}},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := HasMoreQualityGateProjects(tc.search)
+			if got != tc.want {
+				t.Errorf("HasMoreQualityGateProjects() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAreQualityGateProjectsUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		spec        []string
+		observation []string
+		want        bool
+	}{
+		"UnmanagedProjects": {
+			spec:        nil,
+			observation: []string{"project-a"},
+			want:        true,
+		},
+		"SameProjectsDifferentOrder": {
+			spec:        []string{"project-b", "project-a"},
+			observation: []string{"project-a", "project-b"},
+			want:        true,
+		},
+		"MissingProject": {
+			spec:        []string{"project-a", "project-b"},
+			observation: []string{"project-a"},
+			want:        false,
+		},
+		"EmptyProjectsRemovesAll": {
+			spec:        []string{},
+			observation: []string{"project-a"},
+			want:        false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := AreQualityGateProjectsUpToDate(tc.spec, tc.observation)
+			if got != tc.want {
+				t.Errorf("AreQualityGateProjectsUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFindQualityGateProjectsToSelectAndDeselect(t *testing.T) {
+	tests := map[string]struct {
+		spec         []string
+		observation  []string
+		wantSelect   []string
+		wantDeselect []string
+	}{
+		"UnmanagedProjects": {
+			spec:         nil,
+			observation:  []string{"project-a"},
+			wantSelect:   nil,
+			wantDeselect: nil,
+		},
+		"NothingToDo": {
+			spec:         []string{"project-a"},
+			observation:  []string{"project-a"},
+			wantSelect:   nil,
+			wantDeselect: nil,
+		},
+		"SelectAndDeselect": {
+			spec:         []string{"project-a", "project-b"},
+			observation:  []string{"project-a", "project-c"},
+			wantSelect:   []string{"project-b"},
+			wantDeselect: []string{"project-c"},
+		},
+		"EmptyProjectsDeselectsAll": {
+			spec:         []string{},
+			observation:  []string{"project-a", "project-b"},
+			wantSelect:   nil,
+			wantDeselect: []string{"project-a", "project-b"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantSelect, FindQualityGateProjectsToSelect(tc.spec, tc.observation)); diff != "" {
+				t.Errorf("FindQualityGateProjectsToSelect() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantDeselect, FindQualityGateProjectsToDeselect(tc.spec, tc.observation)); diff != "" {
+				t.Errorf("FindQualityGateProjectsToDeselect() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
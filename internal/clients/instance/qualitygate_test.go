@@ -60,6 +60,70 @@ func TestGenerateQualityGateCreateOptions(t *testing.T) {
 	}
 }
 
+func TestGenerateQualityGateCopyOptions(t *testing.T) {
+	want := &sonargo.QualitygatesCopyOption{
+		Name:       "my-gate",
+		SourceName: "Sonar way",
+	}
+
+	got := GenerateQualityGateCopyOptions(v1alpha1.QualityGateParameters{Name: "my-gate", CopyFrom: ptr.To("Sonar way")})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateQualityGateCopyOptions() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsQualityGateCopy(t *testing.T) {
+	tests := map[string]struct {
+		spec v1alpha1.QualityGateParameters
+		want bool
+	}{
+		"NoCopyFrom": {
+			spec: v1alpha1.QualityGateParameters{Name: "my-gate"},
+			want: false,
+		},
+		"EmptyCopyFrom": {
+			spec: v1alpha1.QualityGateParameters{Name: "my-gate", CopyFrom: ptr.To("")},
+			want: false,
+		},
+		"CopyFrom": {
+			spec: v1alpha1.QualityGateParameters{Name: "my-gate", CopyFrom: ptr.To("Sonar way")},
+			want: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsQualityGateCopy(tc.spec); got != tc.want {
+				t.Errorf("IsQualityGateCopy() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGenerateQualityGateRenameOption(t *testing.T) {
+	want := &sonargo.QualitygatesRenameOption{
+		CurrentName: "old-gate",
+		Name:        "new-gate",
+	}
+
+	got := GenerateQualityGateRenameOption("old-gate", "new-gate")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateQualityGateRenameOption() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateQualityGateSetAiCodeAssuranceOption(t *testing.T) {
+	want := &QualitygatesSetAiCodeAssuranceOption{
+		AiCodeAssurance: "true",
+		GateName:        "my-gate",
+	}
+
+	got := GenerateQualityGateSetAiCodeAssuranceOption("my-gate", true)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateQualityGateSetAiCodeAssuranceOption() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestGenerateQualityGateObservation(t *testing.T) {
 	tests := map[string]struct {
 		observation *sonargo.QualitygatesShowObject
@@ -267,6 +331,18 @@ func TestIsQualityGateUpToDate(t *testing.T) {
 			associations: nil,
 			want:         true,
 		},
+		"DifferentAiCodeSupportedReturnsFalse": {
+			spec:         &v1alpha1.QualityGateParameters{Name: "test", AiCodeSupported: ptr.To(true)},
+			observation:  &v1alpha1.QualityGateObservation{Name: "test", IsAiCodeSupported: false},
+			associations: nil,
+			want:         false,
+		},
+		"NilAiCodeSupportedReturnsTrue": {
+			spec:         &v1alpha1.QualityGateParameters{Name: "test"},
+			observation:  &v1alpha1.QualityGateObservation{Name: "test", IsAiCodeSupported: true},
+			associations: nil,
+			want:         true,
+		},
 		"ConditionsNotUpToDateReturnsFalse": {
 			spec:        &v1alpha1.QualityGateParameters{Name: "test"},
 			observation: &v1alpha1.QualityGateObservation{Name: "test"},
@@ -322,6 +398,56 @@ func TestLateInitializeQualityGate(t *testing.T) {
 			observation: &v1alpha1.QualityGateObservation{IsDefault: true},
 			wantDefault: ptr.To(false),
 		},
+		"CopiedConditionsGetInitialized": {
+			spec: &v1alpha1.QualityGateParameters{
+				Name:     "test",
+				CopyFrom: ptr.To("Sonar way"),
+			},
+			observation: &v1alpha1.QualityGateObservation{
+				IsDefault: false,
+				Conditions: []v1alpha1.QualityGateConditionObservation{
+					{ID: "condition-123", Metric: "new_coverage", Error: "80", Op: "LT"},
+					{ID: "condition-456", Metric: "new_violations", Error: "0", Op: "GT"},
+				},
+			},
+			wantDefault: ptr.To(false),
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Id: ptr.To("condition-123"), Metric: "new_coverage", Error: "80", Op: ptr.To("LT")},
+				{Id: ptr.To("condition-456"), Metric: "new_violations", Error: "0", Op: ptr.To("GT")},
+			},
+		},
+		"CopiedConditionsAreMergedOnce": {
+			spec: &v1alpha1.QualityGateParameters{
+				Name:       "test",
+				CopyFrom:   ptr.To("Sonar way"),
+				Conditions: []v1alpha1.QualityGateConditionParameters{{Id: ptr.To("condition-123"), Metric: "new_coverage", Error: "90", Op: ptr.To("LT")}},
+			},
+			observation: &v1alpha1.QualityGateObservation{
+				IsDefault:        false,
+				ConditionsCopied: true,
+				Conditions: []v1alpha1.QualityGateConditionObservation{
+					{ID: "condition-123", Metric: "new_coverage", Error: "80", Op: "LT"},
+					{ID: "condition-456", Metric: "new_violations", Error: "0", Op: "GT"},
+				},
+			},
+			wantDefault: ptr.To(false),
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Id: ptr.To("condition-123"), Metric: "new_coverage", Error: "90", Op: ptr.To("LT")},
+			},
+		},
+		"ObservedConditionsIgnoredWhenNotCopied": {
+			spec: &v1alpha1.QualityGateParameters{
+				Name: "test",
+			},
+			observation: &v1alpha1.QualityGateObservation{
+				IsDefault: false,
+				Conditions: []v1alpha1.QualityGateConditionObservation{
+					{ID: "condition-123", Metric: "new_coverage", Error: "80", Op: "LT"},
+				},
+			},
+			wantDefault:    ptr.To(false),
+			wantConditions: nil,
+		},
 		"ConditionWithoutIdGetsInitialized": {
 			spec: &v1alpha1.QualityGateParameters{
 				Name: "test",
@@ -508,6 +634,169 @@ func TestLateInitializeQualityGate(t *testing.T) {
 	}
 }
 
+func TestLateInitializeQualityGateAiCodeSupported(t *testing.T) {
+	spec := &v1alpha1.QualityGateParameters{Name: "test"}
+	LateInitializeQualityGate(spec, &v1alpha1.QualityGateObservation{IsAiCodeSupported: true})
+	if diff := cmp.Diff(ptr.To(true), spec.AiCodeSupported); diff != "" {
+		t.Errorf("LateInitializeQualityGate() AiCodeSupported mismatch (-want +got):\n%s", diff)
+	}
+
+	spec = &v1alpha1.QualityGateParameters{Name: "test", AiCodeSupported: ptr.To(false)}
+	LateInitializeQualityGate(spec, &v1alpha1.QualityGateObservation{IsAiCodeSupported: true})
+	if diff := cmp.Diff(ptr.To(false), spec.AiCodeSupported); diff != "" {
+		t.Errorf("LateInitializeQualityGate() AiCodeSupported mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAdoptQualityGateConditions(t *testing.T) {
+	observation := &v1alpha1.QualityGateObservation{
+		Conditions: []v1alpha1.QualityGateConditionObservation{
+			{ID: "condition-123", Metric: "new_coverage", Error: "80", Op: "LT"},
+		},
+	}
+
+	tests := map[string]struct {
+		spec           *v1alpha1.QualityGateParameters
+		observation    *v1alpha1.QualityGateObservation
+		want           bool
+		wantConditions []v1alpha1.QualityGateConditionParameters
+	}{
+		"NilSpec": {
+			spec:        nil,
+			observation: observation,
+			want:        false,
+		},
+		"NilObservation": {
+			spec:        &v1alpha1.QualityGateParameters{Name: "test"},
+			observation: nil,
+			want:        false,
+		},
+		"NoObservedConditions": {
+			spec:        &v1alpha1.QualityGateParameters{Name: "test"},
+			observation: &v1alpha1.QualityGateObservation{},
+			want:        false,
+		},
+		"SpecifiedConditionsAreKept": {
+			spec: &v1alpha1.QualityGateParameters{
+				Name:       "test",
+				Conditions: []v1alpha1.QualityGateConditionParameters{{Metric: "coverage", Error: "50"}},
+			},
+			observation:    observation,
+			want:           false,
+			wantConditions: []v1alpha1.QualityGateConditionParameters{{Metric: "coverage", Error: "50"}},
+		},
+		"ObservedConditionsAreAdopted": {
+			spec:        &v1alpha1.QualityGateParameters{Name: "test"},
+			observation: observation,
+			want:        true,
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Id: ptr.To("condition-123"), Metric: "new_coverage", Error: "80", Op: ptr.To("LT")},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := AdoptQualityGateConditions(tc.spec, tc.observation)
+			if got != tc.want {
+				t.Errorf("AdoptQualityGateConditions() = %v, want %v", got, tc.want)
+			}
+			if tc.spec == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantConditions, tc.spec.Conditions); diff != "" {
+				t.Errorf("AdoptQualityGateConditions() conditions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeQualityGateConditions(t *testing.T) {
+	observation := &v1alpha1.QualityGateObservation{
+		Conditions: []v1alpha1.QualityGateConditionObservation{
+			{ID: "condition-1", Metric: "new_coverage", Error: "80", Op: "LT"},
+			{ID: "condition-2", Metric: "new_duplicated_lines_density", Error: "3", Op: "GT"},
+		},
+	}
+
+	tests := map[string]struct {
+		spec           *v1alpha1.QualityGateParameters
+		observation    *v1alpha1.QualityGateObservation
+		wantConditions []v1alpha1.QualityGateConditionParameters
+	}{
+		"NilSpec": {
+			spec:        nil,
+			observation: observation,
+		},
+		"NilObservation": {
+			spec:        &v1alpha1.QualityGateParameters{Name: "test"},
+			observation: nil,
+		},
+		"NoObservedConditions": {
+			spec:           &v1alpha1.QualityGateParameters{Name: "test", Conditions: []v1alpha1.QualityGateConditionParameters{{Metric: "coverage", Error: "50"}}},
+			observation:    &v1alpha1.QualityGateObservation{},
+			wantConditions: []v1alpha1.QualityGateConditionParameters{{Metric: "coverage", Error: "50"}},
+		},
+		"ObservedConditionsAreAdopted": {
+			spec:        &v1alpha1.QualityGateParameters{Name: "test"},
+			observation: observation,
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Id: ptr.To("condition-1"), Metric: "new_coverage", Error: "80", Op: ptr.To("LT")},
+				{Id: ptr.To("condition-2"), Metric: "new_duplicated_lines_density", Error: "3", Op: ptr.To("GT")},
+			},
+		},
+		"SpecifiedConditionWinsOnSameMetric": {
+			spec: &v1alpha1.QualityGateParameters{
+				Name:       "test",
+				Conditions: []v1alpha1.QualityGateConditionParameters{{Metric: "new_coverage", Error: "90"}},
+			},
+			observation: observation,
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Id: ptr.To("condition-1"), Metric: "new_coverage", Error: "90"},
+				{Id: ptr.To("condition-2"), Metric: "new_duplicated_lines_density", Error: "3", Op: ptr.To("GT")},
+			},
+		},
+		"SpecifiedConditionOnNewMetricIsKept": {
+			spec: &v1alpha1.QualityGateParameters{
+				Name:       "test",
+				Conditions: []v1alpha1.QualityGateConditionParameters{{Metric: "new_security_hotspots_reviewed", Error: "100"}},
+			},
+			observation: observation,
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Metric: "new_security_hotspots_reviewed", Error: "100"},
+				{Id: ptr.To("condition-1"), Metric: "new_coverage", Error: "80", Op: ptr.To("LT")},
+				{Id: ptr.To("condition-2"), Metric: "new_duplicated_lines_density", Error: "3", Op: ptr.To("GT")},
+			},
+		},
+		"MergedConditionsAreUnchanged": {
+			spec: &v1alpha1.QualityGateParameters{
+				Name: "test",
+				Conditions: []v1alpha1.QualityGateConditionParameters{
+					{Id: ptr.To("condition-1"), Metric: "new_coverage", Error: "90"},
+					{Id: ptr.To("condition-2"), Metric: "new_duplicated_lines_density", Error: "3", Op: ptr.To("GT")},
+				},
+			},
+			observation: observation,
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Id: ptr.To("condition-1"), Metric: "new_coverage", Error: "90"},
+				{Id: ptr.To("condition-2"), Metric: "new_duplicated_lines_density", Error: "3", Op: ptr.To("GT")},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			MergeQualityGateConditions(tc.spec, tc.observation)
+			if tc.spec == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantConditions, tc.spec.Conditions); diff != "" {
+				t.Errorf("MergeQualityGateConditions() conditions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestWereQualityGateConditionsLateInitialized(t *testing.T) {
 	tests := map[string]struct {
 		before []v1alpha1.QualityGateConditionParameters
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// QualityProfilesClient is the interface for interacting with SonarQube Quality Profiles API
+// It handles all the operations related to Quality Profiles in SonarQube, such as creating, renaming, deleting and retrieving Quality Profiles.
+// It also handles rules activation, inheritance and projects association with Quality Profiles.
+type QualityProfilesClient interface {
+	ActivateRule(opt *sonargo.QualityprofilesActivateRuleOption) (resp *http.Response, err error)
+	ActivateRules(opt *sonargo.QualityprofilesActivateRulesOption) (resp *http.Response, err error)
+	AddGroup(opt *sonargo.QualityprofilesAddGroupOption) (resp *http.Response, err error)
+	AddProject(opt *sonargo.QualityprofilesAddProjectOption) (resp *http.Response, err error)
+	AddUser(opt *sonargo.QualityprofilesAddUserOption) (resp *http.Response, err error)
+	Backup(opt *sonargo.QualityprofilesBackupOption) (v *string, resp *http.Response, err error)
+	ChangeParent(opt *sonargo.QualityprofilesChangeParentOption) (resp *http.Response, err error)
+	Changelog(opt *sonargo.QualityprofilesChangelogOption) (v *sonargo.QualityprofilesChangelogObject, resp *http.Response, err error)
+	Compare(opt *sonargo.QualityprofilesCompareOption) (v *QualityprofilesCompareObject, resp *http.Response, err error)
+	Copy(opt *sonargo.QualityprofilesCopyOption) (v *sonargo.QualityprofilesCopyObject, resp *http.Response, err error)
+	Create(opt *sonargo.QualityprofilesCreateOption) (v *sonargo.QualityprofilesCreateObject, resp *http.Response, err error)
+	DeactivateRule(opt *sonargo.QualityprofilesDeactivateRuleOption) (resp *http.Response, err error)
+	DeactivateRules(opt *sonargo.QualityprofilesDeactivateRulesOption) (resp *http.Response, err error)
+	Delete(opt *sonargo.QualityprofilesDeleteOption) (resp *http.Response, err error)
+	Inheritance(opt *sonargo.QualityprofilesInheritanceOption) (v *sonargo.QualityprofilesInheritanceObject, resp *http.Response, err error)
+	Projects(opt *sonargo.QualityprofilesProjectsOption) (v *sonargo.QualityprofilesProjectsObject, resp *http.Response, err error)
+	RemoveGroup(opt *sonargo.QualityprofilesRemoveGroupOption) (resp *http.Response, err error)
+	RemoveProject(opt *sonargo.QualityprofilesRemoveProjectOption) (resp *http.Response, err error)
+	RemoveUser(opt *sonargo.QualityprofilesRemoveUserOption) (resp *http.Response, err error)
+	Rename(opt *sonargo.QualityprofilesRenameOption) (resp *http.Response, err error)
+	Restore(opt *sonargo.QualityprofilesRestoreOption) (v *QualityprofilesRestoreObject, resp *http.Response, err error)
+	Search(opt *sonargo.QualityprofilesSearchOption) (v *sonargo.QualityprofilesSearchObject, resp *http.Response, err error)
+	SearchActiveRules(opt *sonargo.RulesSearchOption) (v *RulesSearchActivesObject, resp *http.Response, err error)
+	SearchGroups(opt *sonargo.QualityprofilesSearchGroupsOption) (v *sonargo.QualityprofilesSearchGroupsObject, resp *http.Response, err error)
+	SearchUsers(opt *sonargo.QualityprofilesSearchUsersOption) (v *sonargo.QualityprofilesSearchUsersObject, resp *http.Response, err error)
+	SetDefault(opt *sonargo.QualityprofilesSetDefaultOption) (resp *http.Response, err error)
+	Show(opt *sonargo.QualityprofilesShowOption) (v *sonargo.QualityprofilesShowObject, resp *http.Response, err error)
+}
+
+// NewQualityProfilesClient creates a new QualityProfilesClient with the provided SonarQube client configuration.
+func NewQualityProfilesClient(clientConfig common.Config) QualityProfilesClient {
+	newClient := common.NewClient(clientConfig)
+	return &qualityProfilesClient{
+		QualityprofilesService: newClient.Qualityprofiles,
+		client:                 newClient,
+	}
+}
+
+// qualityProfilesClient extends the SonarQube client QualityprofilesService with the endpoints whose response it does not decode properly
+type qualityProfilesClient struct {
+	*sonargo.QualityprofilesService
+	client *sonargo.Client
+}
+
+// SearchActiveRules searches the rules activated in a Quality Profile, along with their activation
+// The SonarQube client RulesSearchObject does not decode the activations, which are indexed by rule key
+func (c *qualityProfilesClient) SearchActiveRules(opt *sonargo.RulesSearchOption) (v *RulesSearchActivesObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "rules/search", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(RulesSearchActivesObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// Compare compares the rules activated in two Quality Profiles
+// The SonarQube client QualityprofilesCompareObject does not decode the parameters of the modified rules, which are indexed by parameter key
+func (c *qualityProfilesClient) Compare(opt *sonargo.QualityprofilesCompareOption) (v *QualityprofilesCompareObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "qualityprofiles/compare", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(QualityprofilesCompareObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// Restore restores a Quality Profile from a backup
+// The SonarQube client sends the backup as a query parameter, while SonarQube expects it as a multipart file, and does not decode the restored Quality Profile
+func (c *qualityProfilesClient) Restore(opt *sonargo.QualityprofilesRestoreOption) (v *QualityprofilesRestoreObject, resp *http.Response, err error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("backup", "backup.xml")
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := part.Write([]byte(opt.Backup)); err != nil {
+		return nil, nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.client.NewRequest(http.MethodPost, "qualityprofiles/restore", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Body = io.NopCloser(&body)
+	req.ContentLength = int64(body.Len())
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	v = new(QualityprofilesRestoreObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GenerateQualityProfileCreateOptions generates SonarQube QualityprofilesCreateOption from QualityProfileParameters
+func GenerateQualityProfileCreateOptions(spec v1alpha1.QualityProfileParameters) *sonargo.QualityprofilesCreateOption {
+	return &sonargo.QualityprofilesCreateOption{
+		Language: spec.Language,
+		Name:     spec.Name,
+	}
+}
+
+// GenerateQualityProfileSearchOption generates SonarQube QualityprofilesSearchOption to list the Quality Profiles of a language
+func GenerateQualityProfileSearchOption(language string) *sonargo.QualityprofilesSearchOption {
+	return &sonargo.QualityprofilesSearchOption{
+		Language: language,
+	}
+}
+
+// FindQualityProfile finds the Quality Profile with the given key in a SonarQube QualityprofilesSearchObject
+// It returns nil if the Quality Profile is not part of the search results
+func FindQualityProfile(search *sonargo.QualityprofilesSearchObject, key string) *sonargo.QualityprofilesSearchObject_sub3 {
+	if search == nil {
+		return nil
+	}
+	for i := range search.Profiles {
+		if search.Profiles[i].Key == key {
+			return &search.Profiles[i]
+		}
+	}
+	return nil
+}
+
+// FindQualityProfileByName finds the Quality Profile with the given language and name in a SonarQube QualityprofilesSearchObject
+// It returns nil if the Quality Profile is not part of the search results
+func FindQualityProfileByName(search *sonargo.QualityprofilesSearchObject, language, name string) *sonargo.QualityprofilesSearchObject_sub3 {
+	if search == nil {
+		return nil
+	}
+	for i := range search.Profiles {
+		if search.Profiles[i].Language == language && search.Profiles[i].Name == name {
+			return &search.Profiles[i]
+		}
+	}
+	return nil
+}
+
+// GenerateQualityProfileRenameOption generates SonarQube QualityprofilesRenameOption to rename a Quality Profile
+func GenerateQualityProfileRenameOption(key, name string) *sonargo.QualityprofilesRenameOption {
+	return &sonargo.QualityprofilesRenameOption{
+		Key:  key,
+		Name: name,
+	}
+}
+
+// GenerateQualityProfileSetDefaultOption generates SonarQube QualityprofilesSetDefaultOption to set a Quality Profile as the default one for its language
+func GenerateQualityProfileSetDefaultOption(language, name string) *sonargo.QualityprofilesSetDefaultOption {
+	return &sonargo.QualityprofilesSetDefaultOption{
+		Language:       language,
+		QualityProfile: name,
+	}
+}
+
+// GenerateQualityProfileChangeParentOption generates SonarQube QualityprofilesChangeParentOption to change the parent of a Quality Profile
+// An empty parent removes the inheritance of the Quality Profile
+func GenerateQualityProfileChangeParentOption(language, name, parent string) *sonargo.QualityprofilesChangeParentOption {
+	return &sonargo.QualityprofilesChangeParentOption{
+		Language:             language,
+		ParentQualityProfile: parent,
+		QualityProfile:       name,
+	}
+}
+
+// GenerateQualityProfileDeleteOption generates SonarQube QualityprofilesDeleteOption to delete a Quality Profile
+func GenerateQualityProfileDeleteOption(language, name string) *sonargo.QualityprofilesDeleteOption {
+	return &sonargo.QualityprofilesDeleteOption{
+		Language:       language,
+		QualityProfile: name,
+	}
+}
+
+// GenerateQualityProfileObservation generates QualityProfileObservation from SonarQube QualityprofilesSearchObject_sub3
+// profile should not be nil, else it will panic
+func GenerateQualityProfileObservation(profile *sonargo.QualityprofilesSearchObject_sub3) v1alpha1.QualityProfileObservation {
+	return v1alpha1.QualityProfileObservation{
+		Actions: v1alpha1.QualityProfileActions{
+			AssociateProjects: profile.Actions.AssociateProjects,
+			Copy:              profile.Actions.Copy,
+			Delete:            profile.Actions.Delete,
+			Edit:              profile.Actions.Edit,
+			SetAsDefault:      profile.Actions.SetAsDefault,
+		},
+		ActiveRuleCount: profile.ActiveRuleCount,
+		IsBuiltIn:       profile.IsBuiltIn,
+		IsDefault:       profile.IsDefault,
+		IsInherited:     profile.IsInherited,
+		Key:             profile.Key,
+		Language:        profile.Language,
+		LanguageName:    profile.LanguageName,
+		Name:            profile.Name,
+		ParentKey:       profile.ParentKey,
+		ParentName:      profile.ParentName,
+	}
+}
+
+// IsQualityProfileParentUpToDate checks whether the parent of the Quality Profile matches the desired one
+// If the desired parent is nil, the inheritance is not managed and is always considered up to date
+func IsQualityProfileParentUpToDate(spec *v1alpha1.QualityProfileParameters, observation *v1alpha1.QualityProfileObservation) bool {
+	return helpers.IsComparablePtrEqualComparable(spec.Parent, observation.ParentName)
+}
+
+// IsQualityProfileUpToDate checks if the Quality Profile spec is up to date with the observed state
+func IsQualityProfileUpToDate(spec *v1alpha1.QualityProfileParameters, observation *v1alpha1.QualityProfileObservation, associations map[string]QualityProfileRuleAssociation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if spec.Name != observation.Name {
+		return false
+	}
+
+	if !helpers.IsComparablePtrEqualComparable(spec.Default, observation.IsDefault) {
+		return false
+	}
+
+	if !IsQualityProfileParentUpToDate(spec, observation) {
+		return false
+	}
+
+	if !AreQualityProfileRulesUpToDate(associations) {
+		return false
+	}
+
+	if !AreQualityProfileProjectsUpToDate(spec.Projects, observation.Projects) {
+		return false
+	}
+
+	return true
+}
+
+// LateInitializeQualityProfile fills the spec with the observed state if the spec fields are nil
+// The parent is only late-initialized when the Quality Profile inherits from another one
+func LateInitializeQualityProfile(spec *v1alpha1.QualityProfileParameters, observation *v1alpha1.QualityProfileObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	helpers.AssignIfNil(&spec.Default, observation.IsDefault)
+	if observation.ParentName != "" {
+		helpers.AssignIfNil(&spec.Parent, observation.ParentName)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+// QualityprofilesCompareObject is the response of the qualityprofiles/compare endpoint
+type QualityprofilesCompareObject struct {
+	InLeft   []sonargo.QualityprofilesCompareObject_sub2 `json:"inLeft,omitempty"`
+	InRight  []sonargo.QualityprofilesCompareObject_sub2 `json:"inRight,omitempty"`
+	Left     sonargo.QualityprofilesCompareObject_sub3   `json:"left,omitempty"`
+	Modified []QualityprofilesCompareModifiedObject      `json:"modified,omitempty"`
+	Right    sonargo.QualityprofilesCompareObject_sub3   `json:"right,omitempty"`
+	Same     []sonargo.QualityprofilesCompareObject_sub2 `json:"same,omitempty"`
+}
+
+// QualityprofilesCompareModifiedObject is a rule activated differently in the compared Quality Profiles
+type QualityprofilesCompareModifiedObject struct {
+	Key   string                                 `json:"key,omitempty"`
+	Left  QualityprofilesCompareActivationObject `json:"left,omitempty"`
+	Name  string                                 `json:"name,omitempty"`
+	Right QualityprofilesCompareActivationObject `json:"right,omitempty"`
+}
+
+// QualityprofilesCompareActivationObject is the activation of a modified rule in a compared Quality Profile
+type QualityprofilesCompareActivationObject struct {
+	Params   map[string]string `json:"params,omitempty"`
+	Severity string            `json:"severity,omitempty"`
+}
+
+// GenerateQualityProfileCompareOption generates SonarQube QualityprofilesCompareOption from QualityProfileComparisonParameters
+func GenerateQualityProfileCompareOption(spec v1alpha1.QualityProfileComparisonParameters) *sonargo.QualityprofilesCompareOption {
+	option := sonargo.QualityprofilesCompareOption{}
+	if spec.LeftKey != nil {
+		option.LeftKey = *spec.LeftKey
+	}
+	if spec.RightKey != nil {
+		option.RightKey = *spec.RightKey
+	}
+	return &option
+}
+
+// GenerateQualityProfileComparisonObservation generates QualityProfileComparisonObservation from a QualityprofilesCompareObject
+// comparison should not be nil, else it will panic
+func GenerateQualityProfileComparisonObservation(comparison *QualityprofilesCompareObject) v1alpha1.QualityProfileComparisonObservation {
+	observation := v1alpha1.QualityProfileComparisonObservation{
+		Left:          v1alpha1.QualityProfileComparisonProfile{Key: comparison.Left.Key, Name: comparison.Left.Name},
+		Right:         v1alpha1.QualityProfileComparisonProfile{Key: comparison.Right.Key, Name: comparison.Right.Name},
+		InLeft:        generateQualityProfileComparisonRules(comparison.InLeft),
+		InLeftCount:   len(comparison.InLeft),
+		InRight:       generateQualityProfileComparisonRules(comparison.InRight),
+		InRightCount:  len(comparison.InRight),
+		ModifiedCount: len(comparison.Modified),
+		SameCount:     len(comparison.Same),
+	}
+	for _, rule := range comparison.Modified {
+		observation.Modified = append(observation.Modified, v1alpha1.QualityProfileComparisonModifiedRule{
+			Key:   rule.Key,
+			Name:  rule.Name,
+			Left:  v1alpha1.QualityProfileComparisonActivation{Severity: rule.Left.Severity, Params: rule.Left.Params},
+			Right: v1alpha1.QualityProfileComparisonActivation{Severity: rule.Right.Severity, Params: rule.Right.Params},
+		})
+	}
+	return observation
+}
+
+// generateQualityProfileComparisonRules generates QualityProfileComparisonRules from the rules activated in only one of the compared Quality Profiles
+func generateQualityProfileComparisonRules(rules []sonargo.QualityprofilesCompareObject_sub2) []v1alpha1.QualityProfileComparisonRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	comparisonRules := make([]v1alpha1.QualityProfileComparisonRule, len(rules))
+	for i, rule := range rules {
+		comparisonRules[i] = v1alpha1.QualityProfileComparisonRule{Key: rule.Key, Name: rule.Name}
+	}
+	return comparisonRules
+}
+
+// HasQualityProfileComparisonDrifted returns true if the compared Quality Profiles do not activate the same rules identically
+func HasQualityProfileComparisonDrifted(observation *v1alpha1.QualityProfileComparisonObservation) bool {
+	return observation.InLeftCount > 0 || observation.InRightCount > 0 || observation.ModifiedCount > 0
+}
+
+// GenerateQualityProfileComparisonCondition generates the Drifted status condition from the observed comparison
+func GenerateQualityProfileComparisonCondition(observation *v1alpha1.QualityProfileComparisonObservation) xpv1.Condition {
+	condition := xpv1.Condition{
+		Type:               v1alpha1.TypeDrifted,
+		LastTransitionTime: metav1.Now(),
+	}
+	if HasQualityProfileComparisonDrifted(observation) {
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = v1alpha1.ReasonRulesDiffer
+		condition.Message = fmt.Sprintf("%d rules only in %s, %d rules only in %s, %d rules modified",
+			observation.InLeftCount, observation.Left.Name, observation.InRightCount, observation.Right.Name, observation.ModifiedCount)
+		return condition
+	}
+	condition.Status = corev1.ConditionFalse
+	condition.Reason = v1alpha1.ReasonRulesIdentical
+	return condition
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestGenerateQualityProfileCompareOption(t *testing.T) {
+	tests := map[string]struct {
+		spec v1alpha1.QualityProfileComparisonParameters
+		want *sonargo.QualityprofilesCompareOption
+	}{
+		"BothKeys": {
+			spec: v1alpha1.QualityProfileComparisonParameters{LeftKey: ptr.To("AU-left"), RightKey: ptr.To("AU-right")},
+			want: &sonargo.QualityprofilesCompareOption{LeftKey: "AU-left", RightKey: "AU-right"},
+		},
+		"UnresolvedKeys": {
+			spec: v1alpha1.QualityProfileComparisonParameters{},
+			want: &sonargo.QualityprofilesCompareOption{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateQualityProfileCompareOption(tc.spec)); diff != "" {
+				t.Errorf("GenerateQualityProfileCompareOption() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateQualityProfileComparisonObservation(t *testing.T) {
+	tests := map[string]struct {
+		comparison *QualityprofilesCompareObject
+		want       v1alpha1.QualityProfileComparisonObservation
+	}{
+		"Identical": {
+			comparison: &QualityprofilesCompareObject{
+				Left:  sonargo.QualityprofilesCompareObject_sub3{Key: "AU-left", Name: "Sonar way"},
+				Right: sonargo.QualityprofilesCompareObject_sub3{Key: "AU-right", Name: "Company way"},
+				Same:  []sonargo.QualityprofilesCompareObject_sub2{{Key: "java:S1144"}, {Key: "java:S107"}},
+			},
+			want: v1alpha1.QualityProfileComparisonObservation{
+				Left:      v1alpha1.QualityProfileComparisonProfile{Key: "AU-left", Name: "Sonar way"},
+				Right:     v1alpha1.QualityProfileComparisonProfile{Key: "AU-right", Name: "Company way"},
+				SameCount: 2,
+			},
+		},
+		"Drifted": {
+			comparison: &QualityprofilesCompareObject{
+				Left:    sonargo.QualityprofilesCompareObject_sub3{Key: "AU-left", Name: "Sonar way"},
+				Right:   sonargo.QualityprofilesCompareObject_sub3{Key: "AU-right", Name: "Company way"},
+				InLeft:  []sonargo.QualityprofilesCompareObject_sub2{{Key: "java:S1144", Name: "Unused private methods should be removed"}},
+				InRight: []sonargo.QualityprofilesCompareObject_sub2{{Key: "java:S100", Name: "Method names should comply with a naming convention"}, {Key: "java:S101"}},
+				Modified: []QualityprofilesCompareModifiedObject{{
+					Key:   "java:S107",
+					Name:  "Methods should not have too many parameters",
+					Left:  QualityprofilesCompareActivationObject{Severity: "MAJOR", Params: map[string]string{"max": "7"}},
+					Right: QualityprofilesCompareActivationObject{Severity: "CRITICAL", Params: map[string]string{"max": "5"}},
+				}},
+				Same: []sonargo.QualityprofilesCompareObject_sub2{{Key: "java:S108"}},
+			},
+			want: v1alpha1.QualityProfileComparisonObservation{
+				Left:         v1alpha1.QualityProfileComparisonProfile{Key: "AU-left", Name: "Sonar way"},
+				Right:        v1alpha1.QualityProfileComparisonProfile{Key: "AU-right", Name: "Company way"},
+				InLeft:       []v1alpha1.QualityProfileComparisonRule{{Key: "java:S1144", Name: "Unused private methods should be removed"}},
+				InLeftCount:  1,
+				InRight:      []v1alpha1.QualityProfileComparisonRule{{Key: "java:S100", Name: "Method names should comply with a naming convention"}, {Key: "java:S101"}},
+				InRightCount: 2,
+				Modified: []v1alpha1.QualityProfileComparisonModifiedRule{{
+					Key:   "java:S107",
+					Name:  "Methods should not have too many parameters",
+					Left:  v1alpha1.QualityProfileComparisonActivation{Severity: "MAJOR", Params: map[string]string{"max": "7"}},
+					Right: v1alpha1.QualityProfileComparisonActivation{Severity: "CRITICAL", Params: map[string]string{"max": "5"}},
+				}},
+				ModifiedCount: 1,
+				SameCount:     1,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateQualityProfileComparisonObservation(tc.comparison)); diff != "" {
+				t.Errorf("GenerateQualityProfileComparisonObservation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasQualityProfileComparisonDrifted(t *testing.T) {
+	tests := map[string]struct {
+		observation v1alpha1.QualityProfileComparisonObservation
+		want        bool
+	}{
+		"Identical": {
+			observation: v1alpha1.QualityProfileComparisonObservation{SameCount: 3},
+			want:        false,
+		},
+		"RulesOnlyInLeft": {
+			observation: v1alpha1.QualityProfileComparisonObservation{InLeftCount: 1},
+			want:        true,
+		},
+		"RulesOnlyInRight": {
+			observation: v1alpha1.QualityProfileComparisonObservation{InRightCount: 1},
+			want:        true,
+		},
+		"RulesModified": {
+			observation: v1alpha1.QualityProfileComparisonObservation{ModifiedCount: 1},
+			want:        true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := HasQualityProfileComparisonDrifted(&tc.observation); got != tc.want {
+				t.Errorf("HasQualityProfileComparisonDrifted() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGenerateQualityProfileComparisonCondition(t *testing.T) {
+	tests := map[string]struct {
+		observation v1alpha1.QualityProfileComparisonObservation
+		wantStatus  corev1.ConditionStatus
+		wantReason  string
+	}{
+		"Identical": {
+			observation: v1alpha1.QualityProfileComparisonObservation{SameCount: 3},
+			wantStatus:  corev1.ConditionFalse,
+			wantReason:  string(v1alpha1.ReasonRulesIdentical),
+		},
+		"Drifted": {
+			observation: v1alpha1.QualityProfileComparisonObservation{InRightCount: 2, ModifiedCount: 1},
+			wantStatus:  corev1.ConditionTrue,
+			wantReason:  string(v1alpha1.ReasonRulesDiffer),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityProfileComparisonCondition(&tc.observation)
+			if got.Type != v1alpha1.TypeDrifted {
+				t.Errorf("GenerateQualityProfileComparisonCondition() type = %q, want %q", got.Type, v1alpha1.TypeDrifted)
+			}
+			if got.Status != tc.wantStatus || string(got.Reason) != tc.wantReason {
+				t.Errorf("GenerateQualityProfileComparisonCondition() = %s/%s, want %s/%s", got.Status, got.Reason, tc.wantStatus, tc.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"strconv"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// QualityProfileSearchPageSize is the number of items requested per page when searching for the projects or rules of a Quality Profile
+const QualityProfileSearchPageSize = 500
+
+// GenerateQualityProfileProjectsSearchOption generates SonarQube QualityprofilesProjectsOption to list a page of the projects explicitly associated with a Quality Profile
+func GenerateQualityProfileProjectsSearchOption(key string, page int) *sonargo.QualityprofilesProjectsOption {
+	return &sonargo.QualityprofilesProjectsOption{
+		Key:      key,
+		P:        strconv.Itoa(page),
+		Ps:       strconv.Itoa(QualityProfileSearchPageSize),
+		Selected: "selected",
+	}
+}
+
+// GenerateQualityProfileProjectsObservation extracts the keys of the selected projects from a SonarQube QualityprofilesProjectsObject
+func GenerateQualityProfileProjectsObservation(search *sonargo.QualityprofilesProjectsObject) []string {
+	if search == nil {
+		return nil
+	}
+	projects := make([]string, 0, len(search.Results))
+	for _, result := range search.Results {
+		if result.Selected {
+			projects = append(projects, result.Key)
+		}
+	}
+	return projects
+}
+
+// HasMoreQualityProfileProjects returns true if the SonarQube QualityprofilesProjectsObject is not the last page of results
+func HasMoreQualityProfileProjects(search *sonargo.QualityprofilesProjectsObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Results), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// GenerateQualityProfileAddProjectOption generates SonarQube QualityprofilesAddProjectOption to associate a project with a Quality Profile
+func GenerateQualityProfileAddProjectOption(language, name, projectKey string) *sonargo.QualityprofilesAddProjectOption {
+	return &sonargo.QualityprofilesAddProjectOption{
+		Language:       language,
+		Project:        projectKey,
+		QualityProfile: name,
+	}
+}
+
+// GenerateQualityProfileRemoveProjectOption generates SonarQube QualityprofilesRemoveProjectOption to remove the association of a project with a Quality Profile
+func GenerateQualityProfileRemoveProjectOption(language, name, projectKey string) *sonargo.QualityprofilesRemoveProjectOption {
+	return &sonargo.QualityprofilesRemoveProjectOption{
+		Language:       language,
+		Project:        projectKey,
+		QualityProfile: name,
+	}
+}
+
+// AreQualityProfileProjectsUpToDate checks whether the projects associated with the Quality Profile match the desired ones
+// If the desired projects are nil, the associations are not managed and are always considered up to date
+func AreQualityProfileProjectsUpToDate(specProjects, observedProjects []string) bool {
+	if specProjects == nil {
+		return true
+	}
+	return helpers.IsComparableSliceEqualIgnoringOrder(specProjects, observedProjects)
+}
+
+// FindQualityProfileProjectsToAdd finds the desired projects that are not associated with the Quality Profile yet
+func FindQualityProfileProjectsToAdd(specProjects, observedProjects []string) []string {
+	return findMissingStrings(specProjects, observedProjects)
+}
+
+// FindQualityProfileProjectsToRemove finds the projects associated with the Quality Profile that are no longer desired
+// If the desired projects are nil, the associations are not managed and nothing is removed
+func FindQualityProfileProjectsToRemove(specProjects, observedProjects []string) []string {
+	if specProjects == nil {
+		return nil
+	}
+	return findMissingStrings(observedProjects, specProjects)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerateQualityProfileProjectsSearchOption(t *testing.T) {
+	want := &sonargo.QualityprofilesProjectsOption{
+		Key:      "AU-key",
+		P:        "2",
+		Ps:       "500",
+		Selected: "selected",
+	}
+
+	got := GenerateQualityProfileProjectsSearchOption("AU-key", 2)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateQualityProfileProjectsSearchOption() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateQualityProfileProjectsObservation(t *testing.T) {
+	tests := map[string]struct {
+		search *sonargo.QualityprofilesProjectsObject
+		want   []string
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   nil,
+		},
+		"OnlySelectedProjects": {
+			search: &sonargo.QualityprofilesProjectsObject{
+				Results: []sonargo.QualityprofilesProjectsObject_sub2{
+					{Key: "project-a", Selected: true},
+					{Key: "project-b", Selected: false},
+				},
+			},
+			want: []string{"project-a"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityProfileProjectsObservation(tc.search)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateQualityProfileProjectsObservation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasMoreQualityProfileProjects(t *testing.T) {
+	tests := map[string]struct {
+		search *sonargo.QualityprofilesProjectsObject
+		want   bool
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   false,
+		},
+		"LastPage": {
+			search: &sonargo.QualityprofilesProjectsObject{
+				Paging:  sonargo.QualityprofilesProjectsObject_sub1{PageIndex: 3, PageSize: 500, Total: 1200},
+				Results: []sonargo.QualityprofilesProjectsObject_sub2{{Key: "project-a"}},
+			},
+			want: false,
+		},
+		"MorePages": {
+			search: &sonargo.QualityprofilesProjectsObject{
+				Paging:  sonargo.QualityprofilesProjectsObject_sub1{PageIndex: 1, PageSize: 500, Total: 1200},
+				Results: []sonargo.QualityprofilesProjectsObject_sub2{{Key: "project-a"}},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := HasMoreQualityProfileProjects(tc.search)
+			if got != tc.want {
+				t.Errorf("HasMoreQualityProfileProjects() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFindQualityProfileProjectsToAddAndRemove(t *testing.T) {
+	tests := map[string]struct {
+		spec         []string
+		observation  []string
+		wantAdd      []string
+		wantRemove   []string
+		wantUpToDate bool
+	}{
+		"UnmanagedProjects": {
+			spec:         nil,
+			observation:  []string{"project-a"},
+			wantAdd:      nil,
+			wantRemove:   nil,
+			wantUpToDate: true,
+		},
+		"NothingToDo": {
+			spec:         []string{"project-a"},
+			observation:  []string{"project-a"},
+			wantAdd:      nil,
+			wantRemove:   nil,
+			wantUpToDate: true,
+		},
+		"AddAndRemove": {
+			spec:         []string{"project-a", "project-b"},
+			observation:  []string{"project-a", "project-c"},
+			wantAdd:      []string{"project-b"},
+			wantRemove:   []string{"project-c"},
+			wantUpToDate: false,
+		},
+		"EmptyProjectsRemovesAll": {
+			spec:         []string{},
+			observation:  []string{"project-a"},
+			wantAdd:      nil,
+			wantRemove:   []string{"project-a"},
+			wantUpToDate: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantAdd, FindQualityProfileProjectsToAdd(tc.spec, tc.observation)); diff != "" {
+				t.Errorf("FindQualityProfileProjectsToAdd() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, FindQualityProfileProjectsToRemove(tc.spec, tc.observation)); diff != "" {
+				t.Errorf("FindQualityProfileProjectsToRemove() mismatch (-want +got):\n%s", diff)
+			}
+			if got := AreQualityProfileProjectsUpToDate(tc.spec, tc.observation); got != tc.wantUpToDate {
+				t.Errorf("AreQualityProfileProjectsUpToDate() = %v, want %v", got, tc.wantUpToDate)
+			}
+		})
+	}
+}
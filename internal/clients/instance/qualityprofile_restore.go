@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/backup"
+)
+
+// QualityprofilesRestoreObject is the response of the qualityprofiles/restore endpoint
+type QualityprofilesRestoreObject struct {
+	Profile       QualityprofilesRestoreProfileObject `json:"profile,omitempty"`
+	RuleFailures  int64                               `json:"ruleFailures,omitempty"`
+	RuleSuccesses int64                               `json:"ruleSuccesses,omitempty"`
+}
+
+// QualityprofilesRestoreProfileObject is the Quality Profile restored by the qualityprofiles/restore endpoint
+type QualityprofilesRestoreProfileObject struct {
+	IsDefault    bool   `json:"isDefault,omitempty"`
+	IsInherited  bool   `json:"isInherited,omitempty"`
+	Key          string `json:"key,omitempty"`
+	Language     string `json:"language,omitempty"`
+	LanguageName string `json:"languageName,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// GenerateQualityProfileRestoreOption generates SonarQube QualityprofilesRestoreOption to restore a Quality Profile backup
+func GenerateQualityProfileRestoreOption(data string) *sonargo.QualityprofilesRestoreOption {
+	return &sonargo.QualityprofilesRestoreOption{
+		Backup: data,
+	}
+}
+
+// GenerateQualityProfileBackupOption generates SonarQube QualityprofilesBackupOption to export a Quality Profile backup
+func GenerateQualityProfileBackupOption(language, name string) *sonargo.QualityprofilesBackupOption {
+	return &sonargo.QualityprofilesBackupOption{
+		Language:       language,
+		QualityProfile: name,
+	}
+}
+
+// GenerateQualityProfileRestoreObservation generates QualityProfileRestoreObservation from SonarQube QualityprofilesSearchObject_sub3 and its drift from the backup
+// profile should not be nil, else it will panic
+func GenerateQualityProfileRestoreObservation(profile *sonargo.QualityprofilesSearchObject_sub3, drift backup.Drift) v1alpha1.QualityProfileRestoreObservation {
+	return v1alpha1.QualityProfileRestoreObservation{
+		ActiveRuleCount: profile.ActiveRuleCount,
+		IsBuiltIn:       profile.IsBuiltIn,
+		IsDefault:       profile.IsDefault,
+		Key:             profile.Key,
+		Language:        profile.Language,
+		Name:            profile.Name,
+		MissingRules:    drift.MissingRules,
+		UnexpectedRules: drift.UnexpectedRules,
+		ModifiedRules:   drift.ModifiedRules,
+	}
+}
+
+// IsQualityProfileRestoreUpToDate checks if the restored Quality Profile still has the name and the rules of the backup
+func IsQualityProfileRestoreUpToDate(desired *backup.Profile, observation *v1alpha1.QualityProfileRestoreObservation) bool {
+	if desired == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+	return desired.Name == observation.Name &&
+		len(observation.MissingRules) == 0 &&
+		len(observation.UnexpectedRules) == 0 &&
+		len(observation.ModifiedRules) == 0
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/backup"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestQualityProfilesClientRestore(t *testing.T) {
+	const data = `<profile><name>Company way</name><language>java</language></profile>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/qualityprofiles/restore" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("the backup should not be sent as a query parameter, got %q", r.URL.RawQuery)
+		}
+		file, _, err := r.FormFile("backup")
+		if err != nil {
+			t.Fatalf("the backup should be sent as a multipart file: %v", err)
+		}
+		defer file.Close() //nolint:errcheck // test server
+		content, _ := io.ReadAll(file)
+		if string(content) != data {
+			t.Errorf("backup = %q, want %q", content, data)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"profile":{"key":"AU-key","name":"Company way","language":"java","languageName":"Java"},"ruleSuccesses":2,"ruleFailures":1}`))
+	}))
+	defer server.Close()
+
+	client := NewQualityProfilesClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	got, resp, err := client.Restore(GenerateQualityProfileRestoreOption(data))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	want := &QualityprofilesRestoreObject{
+		Profile:       QualityprofilesRestoreProfileObject{Key: "AU-key", Name: "Company way", Language: "java", LanguageName: "Java"},
+		RuleSuccesses: 2,
+		RuleFailures:  1,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Restore() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateQualityProfileRestoreObservation(t *testing.T) {
+	profile := &sonargo.QualityprofilesSearchObject_sub3{Key: "AU-key", Name: "Company way", Language: "java", ActiveRuleCount: 2, IsDefault: true}
+	drift := backup.Drift{MissingRules: []string{"java:S1144"}, ModifiedRules: []string{"java:S107"}}
+
+	want := v1alpha1.QualityProfileRestoreObservation{
+		ActiveRuleCount: 2,
+		IsDefault:       true,
+		Key:             "AU-key",
+		Language:        "java",
+		Name:            "Company way",
+		MissingRules:    []string{"java:S1144"},
+		ModifiedRules:   []string{"java:S107"},
+	}
+	if diff := cmp.Diff(want, GenerateQualityProfileRestoreObservation(profile, drift)); diff != "" {
+		t.Errorf("GenerateQualityProfileRestoreObservation() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsQualityProfileRestoreUpToDate(t *testing.T) {
+	desired := &backup.Profile{Name: "Company way", Language: "java"}
+
+	tests := map[string]struct {
+		desired     *backup.Profile
+		observation *v1alpha1.QualityProfileRestoreObservation
+		want        bool
+	}{
+		"NilDesired": {
+			desired:     nil,
+			observation: &v1alpha1.QualityProfileRestoreObservation{},
+			want:        true,
+		},
+		"NilObservation": {
+			desired:     desired,
+			observation: nil,
+			want:        false,
+		},
+		"UpToDate": {
+			desired:     desired,
+			observation: &v1alpha1.QualityProfileRestoreObservation{Name: "Company way"},
+			want:        true,
+		},
+		"Renamed": {
+			desired:     desired,
+			observation: &v1alpha1.QualityProfileRestoreObservation{Name: "Old way"},
+			want:        false,
+		},
+		"MissingRules": {
+			desired:     desired,
+			observation: &v1alpha1.QualityProfileRestoreObservation{Name: "Company way", MissingRules: []string{"java:S1144"}},
+			want:        false,
+		},
+		"UnexpectedRules": {
+			desired:     desired,
+			observation: &v1alpha1.QualityProfileRestoreObservation{Name: "Company way", UnexpectedRules: []string{"java:S1144"}},
+			want:        false,
+		},
+		"ModifiedRules": {
+			desired:     desired,
+			observation: &v1alpha1.QualityProfileRestoreObservation{Name: "Company way", ModifiedRules: []string{"java:S1144"}},
+			want:        false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsQualityProfileRestoreUpToDate(tc.desired, tc.observation); got != tc.want {
+				t.Errorf("IsQualityProfileRestoreUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
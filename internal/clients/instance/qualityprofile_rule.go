@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// RulesSearchActivesObject is the response of the rules/search endpoint when searching the rules activated in a Quality Profile
+type RulesSearchActivesObject struct {
+	Actives map[string][]RulesSearchActiveObject `json:"actives,omitempty"`
+	Paging  sonargo.RulesSearchObject_sub6       `json:"paging,omitempty"`
+	Rules   []RulesSearchActivesRuleObject       `json:"rules,omitempty"`
+}
+
+// RulesSearchActiveObject is the activation of a rule in a Quality Profile
+type RulesSearchActiveObject struct {
+	Inherit  string                         `json:"inherit,omitempty"`
+	Params   []RulesSearchActiveParamObject `json:"params,omitempty"`
+	QProfile string                         `json:"qProfile,omitempty"`
+	Severity string                         `json:"severity,omitempty"`
+}
+
+// RulesSearchActiveParamObject is a parameter of a rule activated in a Quality Profile
+type RulesSearchActiveParamObject struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// RulesSearchActivesRuleObject is a rule returned by the rules/search endpoint
+type RulesSearchActivesRuleObject struct {
+	Key string `json:"key,omitempty"`
+}
+
+// GenerateQualityProfileRulesSearchOption generates SonarQube RulesSearchOption to list a page of the rules activated in a Quality Profile, along with their activation
+func GenerateQualityProfileRulesSearchOption(key string, page int) *sonargo.RulesSearchOption {
+	return &sonargo.RulesSearchOption{
+		Activation: "true",
+		F:          "actives",
+		P:          strconv.Itoa(page),
+		Ps:         strconv.Itoa(QualityProfileSearchPageSize),
+		Qprofile:   key,
+	}
+}
+
+// GenerateQualityProfileRulesObservation extracts the activations in the Quality Profile from a RulesSearchActivesObject
+func GenerateQualityProfileRulesObservation(search *RulesSearchActivesObject, key string) []v1alpha1.QualityProfileRuleObservation {
+	if search == nil {
+		return nil
+	}
+	rules := make([]v1alpha1.QualityProfileRuleObservation, 0, len(search.Rules))
+	for _, rule := range search.Rules {
+		for _, active := range search.Actives[rule.Key] {
+			if active.QProfile != key {
+				continue
+			}
+			rules = append(rules, GenerateQualityProfileRuleObservation(rule.Key, &active))
+			break
+		}
+	}
+	return rules
+}
+
+// GenerateQualityProfileRuleObservation generates QualityProfileRuleObservation from a RulesSearchActiveObject
+func GenerateQualityProfileRuleObservation(rule string, active *RulesSearchActiveObject) v1alpha1.QualityProfileRuleObservation {
+	observation := v1alpha1.QualityProfileRuleObservation{
+		Inherit:  active.Inherit,
+		Rule:     rule,
+		Severity: active.Severity,
+	}
+	if len(active.Params) > 0 {
+		observation.Params = make(map[string]string, len(active.Params))
+		for _, param := range active.Params {
+			observation.Params[param.Key] = param.Value
+		}
+	}
+	return observation
+}
+
+// HasMoreQualityProfileRules returns true if the RulesSearchActivesObject is not the last page of results
+func HasMoreQualityProfileRules(search *RulesSearchActivesObject) bool {
+	if search == nil {
+		return false
+	}
+	return hasMorePages(len(search.Rules), search.Paging.PageIndex, search.Paging.PageSize, search.Paging.Total)
+}
+
+// GenerateQualityProfileActivateRuleOption generates SonarQube QualityprofilesActivateRuleOption to activate a rule in a Quality Profile with its overrides
+func GenerateQualityProfileActivateRuleOption(key string, params v1alpha1.QualityProfileRuleParameters) *sonargo.QualityprofilesActivateRuleOption {
+	option := sonargo.QualityprofilesActivateRuleOption{
+		Key:    key,
+		Params: formatQualityProfileRuleParams(params.Params),
+		Rule:   params.Rule,
+	}
+	if params.Severity != nil {
+		option.Severity = *params.Severity
+	}
+	return &option
+}
+
+// GenerateQualityProfileDeactivateRuleOption generates SonarQube QualityprofilesDeactivateRuleOption to deactivate a rule in a Quality Profile
+func GenerateQualityProfileDeactivateRuleOption(key, rule string) *sonargo.QualityprofilesDeactivateRuleOption {
+	return &sonargo.QualityprofilesDeactivateRuleOption{
+		Key:  key,
+		Rule: rule,
+	}
+}
+
+// formatQualityProfileRuleParams formats rule parameters as the semicolon separated list of key=value expected by SonarQube, sorted by key
+func formatQualityProfileRuleParams(params map[string]string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(params))
+	for key, value := range params {
+		pairs = append(pairs, key+"="+value)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ";")
+}
+
+// IsQualityProfileRuleUpToDate checks whether the observed rule activation is up to date with the desired QualityProfileRuleParameters
+// Only the severity and the parameters specified are compared
+func IsQualityProfileRuleUpToDate(params *v1alpha1.QualityProfileRuleParameters, observation *v1alpha1.QualityProfileRuleObservation) bool {
+	if params == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if !helpers.IsComparablePtrEqualComparable(params.Severity, observation.Severity) {
+		return false
+	}
+	for key, value := range params.Params {
+		if observed, ok := observation.Params[key]; !ok || observed != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isQualityProfileRuleInherited returns true if the rule activation comes from the parent Quality Profile, in which case it cannot be deactivated
+func isQualityProfileRuleInherited(observation *v1alpha1.QualityProfileRuleObservation) bool {
+	return observation.Inherit == v1alpha1.QualityProfileRuleInheritanceInherited || observation.Inherit == v1alpha1.QualityProfileRuleInheritanceOverrides
+}
+
+// QualityProfileRuleAssociation associates a QualityProfileRuleObservation with its corresponding QualityProfileRuleParameters
+type QualityProfileRuleAssociation struct {
+	Observation *v1alpha1.QualityProfileRuleObservation
+	Spec        *v1alpha1.QualityProfileRuleParameters
+	UpToDate    bool
+}
+
+// GenerateQualityProfileRulesAssociation generates associations between QualityProfileRuleParameters and QualityProfileRuleObservation, indexed by rule key
+// If the desired rules are nil, the rules are not managed and no association is generated
+// Inherited rule activations without a desired counterpart cannot be deactivated and are considered up to date
+func GenerateQualityProfileRulesAssociation(specs []v1alpha1.QualityProfileRuleParameters, observations []v1alpha1.QualityProfileRuleObservation) map[string]QualityProfileRuleAssociation {
+	associations := make(map[string]QualityProfileRuleAssociation)
+	if specs == nil {
+		return associations
+	}
+
+	for i := range observations {
+		associations[observations[i].Rule] = QualityProfileRuleAssociation{
+			Observation: &observations[i],
+			Spec:        nil,
+			UpToDate:    isQualityProfileRuleInherited(&observations[i]),
+		}
+	}
+
+	for i := range specs {
+		assoc := associations[specs[i].Rule]
+		assoc.Spec = &specs[i]
+		assoc.UpToDate = IsQualityProfileRuleUpToDate(&specs[i], assoc.Observation)
+		associations[specs[i].Rule] = assoc
+	}
+
+	return associations
+}
+
+// AreQualityProfileRulesUpToDate checks whether the observed rule activations are up to date with the desired QualityProfileRuleParameters
+func AreQualityProfileRulesUpToDate(associations map[string]QualityProfileRuleAssociation) bool {
+	for _, assoc := range associations {
+		if !assoc.UpToDate {
+			return false
+		}
+	}
+	return true
+}
+
+// FindQualityProfileRulesToActivate finds the QualityProfileRuleParameters that are not activated yet or whose activation is not up to date, sorted by rule key
+func FindQualityProfileRulesToActivate(associations map[string]QualityProfileRuleAssociation) []*v1alpha1.QualityProfileRuleParameters {
+	var toActivate []*v1alpha1.QualityProfileRuleParameters
+	for _, assoc := range associations {
+		if !assoc.UpToDate && assoc.Spec != nil {
+			toActivate = append(toActivate, assoc.Spec)
+		}
+	}
+	slices.SortFunc(toActivate, func(a, b *v1alpha1.QualityProfileRuleParameters) int {
+		return strings.Compare(a.Rule, b.Rule)
+	})
+	return toActivate
+}
+
+// FindQualityProfileRulesToDeactivate finds the QualityProfileRuleObservations that are activated but no longer desired, sorted by rule key
+func FindQualityProfileRulesToDeactivate(associations map[string]QualityProfileRuleAssociation) []*v1alpha1.QualityProfileRuleObservation {
+	var toDeactivate []*v1alpha1.QualityProfileRuleObservation
+	for _, assoc := range associations {
+		if !assoc.UpToDate && assoc.Spec == nil && assoc.Observation != nil {
+			toDeactivate = append(toDeactivate, assoc.Observation)
+		}
+	}
+	slices.SortFunc(toDeactivate, func(a, b *v1alpha1.QualityProfileRuleObservation) int {
+		return strings.Compare(a.Rule, b.Rule)
+	})
+	return toDeactivate
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestGenerateQualityProfileRulesObservation(t *testing.T) {
+	tests := map[string]struct {
+		search *RulesSearchActivesObject
+		want   []v1alpha1.QualityProfileRuleObservation
+	}{
+		"NilSearch": {
+			search: nil,
+			want:   nil,
+		},
+		"OnlyActivationsOfTheProfile": {
+			search: &RulesSearchActivesObject{
+				Rules: []RulesSearchActivesRuleObject{{Key: "java:S107"}, {Key: "java:S1144"}},
+				Actives: map[string][]RulesSearchActiveObject{
+					"java:S107": {
+						{QProfile: "AU-parent", Inherit: "NONE", Severity: "MAJOR"},
+						{QProfile: "AU-key", Inherit: "OVERRIDES", Severity: "CRITICAL", Params: []RulesSearchActiveParamObject{{Key: "max", Value: "5"}}},
+					},
+					"java:S1144": {{QProfile: "AU-key", Inherit: "NONE", Severity: "MAJOR"}},
+				},
+			},
+			want: []v1alpha1.QualityProfileRuleObservation{
+				{Rule: "java:S107", Inherit: "OVERRIDES", Severity: "CRITICAL", Params: map[string]string{"max": "5"}},
+				{Rule: "java:S1144", Inherit: "NONE", Severity: "MAJOR"},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityProfileRulesObservation(tc.search, "AU-key")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateQualityProfileRulesObservation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateQualityProfileActivateRuleOption(t *testing.T) {
+	tests := map[string]struct {
+		params v1alpha1.QualityProfileRuleParameters
+		want   *sonargo.QualityprofilesActivateRuleOption
+	}{
+		"RuleOnly": {
+			params: v1alpha1.QualityProfileRuleParameters{Rule: "java:S1144"},
+			want:   &sonargo.QualityprofilesActivateRuleOption{Key: "AU-key", Rule: "java:S1144"},
+		},
+		"SeverityAndSortedParams": {
+			params: v1alpha1.QualityProfileRuleParameters{
+				Rule:     "java:S107",
+				Severity: ptr.To("CRITICAL"),
+				Params:   map[string]string{"max": "5", "constructorMax": "10"},
+			},
+			want: &sonargo.QualityprofilesActivateRuleOption{
+				Key:      "AU-key",
+				Rule:     "java:S107",
+				Severity: "CRITICAL",
+				Params:   "constructorMax=10;max=5",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQualityProfileActivateRuleOption("AU-key", tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateQualityProfileActivateRuleOption() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsQualityProfileRuleUpToDate(t *testing.T) {
+	observation := &v1alpha1.QualityProfileRuleObservation{
+		Rule:     "java:S107",
+		Severity: "MAJOR",
+		Params:   map[string]string{"max": "7", "constructorMax": "7"},
+	}
+
+	tests := map[string]struct {
+		params      *v1alpha1.QualityProfileRuleParameters
+		observation *v1alpha1.QualityProfileRuleObservation
+		want        bool
+	}{
+		"NilParams": {
+			params:      nil,
+			observation: observation,
+			want:        true,
+		},
+		"NotActivated": {
+			params:      &v1alpha1.QualityProfileRuleParameters{Rule: "java:S107"},
+			observation: nil,
+			want:        false,
+		},
+		"UnmanagedSeverityAndParams": {
+			params:      &v1alpha1.QualityProfileRuleParameters{Rule: "java:S107"},
+			observation: observation,
+			want:        true,
+		},
+		"MatchingSubsetOfParams": {
+			params:      &v1alpha1.QualityProfileRuleParameters{Rule: "java:S107", Severity: ptr.To("MAJOR"), Params: map[string]string{"max": "7"}},
+			observation: observation,
+			want:        true,
+		},
+		"SeverityDrift": {
+			params:      &v1alpha1.QualityProfileRuleParameters{Rule: "java:S107", Severity: ptr.To("BLOCKER")},
+			observation: observation,
+			want:        false,
+		},
+		"ParamDrift": {
+			params:      &v1alpha1.QualityProfileRuleParameters{Rule: "java:S107", Params: map[string]string{"max": "5"}},
+			observation: observation,
+			want:        false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsQualityProfileRuleUpToDate(tc.params, tc.observation); got != tc.want {
+				t.Errorf("IsQualityProfileRuleUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGenerateQualityProfileRulesAssociation(t *testing.T) {
+	observations := []v1alpha1.QualityProfileRuleObservation{
+		{Rule: "java:S1144", Severity: "MAJOR", Inherit: v1alpha1.QualityProfileRuleInheritanceNone},
+		{Rule: "java:S107", Severity: "MAJOR", Inherit: v1alpha1.QualityProfileRuleInheritanceInherited},
+		{Rule: "java:S2068", Severity: "BLOCKER", Inherit: v1alpha1.QualityProfileRuleInheritanceNone},
+	}
+
+	tests := map[string]struct {
+		specs          []v1alpha1.QualityProfileRuleParameters
+		wantUpToDate   bool
+		wantActivate   []string
+		wantDeactivate []string
+	}{
+		"UnmanagedRules": {
+			specs:        nil,
+			wantUpToDate: true,
+		},
+		"InheritedRulesAreNotDeactivated": {
+			specs:          []v1alpha1.QualityProfileRuleParameters{{Rule: "java:S2068"}},
+			wantUpToDate:   false,
+			wantDeactivate: []string{"java:S1144"},
+		},
+		"ActivateOverrideAndDeactivate": {
+			specs: []v1alpha1.QualityProfileRuleParameters{
+				{Rule: "java:S107", Severity: ptr.To("CRITICAL")},
+				{Rule: "java:S3776"},
+				{Rule: "java:S1144", Severity: ptr.To("MAJOR")},
+			},
+			wantUpToDate:   false,
+			wantActivate:   []string{"java:S107", "java:S3776"},
+			wantDeactivate: []string{"java:S2068"},
+		},
+		"UpToDate": {
+			specs: []v1alpha1.QualityProfileRuleParameters{
+				{Rule: "java:S1144"},
+				{Rule: "java:S2068", Severity: ptr.To("BLOCKER")},
+			},
+			wantUpToDate: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			associations := GenerateQualityProfileRulesAssociation(tc.specs, observations)
+			if got := AreQualityProfileRulesUpToDate(associations); got != tc.wantUpToDate {
+				t.Errorf("AreQualityProfileRulesUpToDate() = %v, want %v", got, tc.wantUpToDate)
+			}
+			var activate, deactivate []string
+			for _, spec := range FindQualityProfileRulesToActivate(associations) {
+				activate = append(activate, spec.Rule)
+			}
+			for _, observation := range FindQualityProfileRulesToDeactivate(associations) {
+				deactivate = append(deactivate, observation.Rule)
+			}
+			if diff := cmp.Diff(tc.wantActivate, activate); diff != "" {
+				t.Errorf("FindQualityProfileRulesToActivate() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantDeactivate, deactivate); diff != "" {
+				t.Errorf("FindQualityProfileRulesToDeactivate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
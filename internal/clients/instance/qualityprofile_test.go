@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestFindQualityProfile(t *testing.T) {
+	search := &sonargo.QualityprofilesSearchObject{
+		Profiles: []sonargo.QualityprofilesSearchObject_sub3{
+			{Key: "AU-sonar-way", Name: "Sonar way"},
+			{Key: "AU-key", Name: "My Profile"},
+		},
+	}
+
+	if got := FindQualityProfile(search, "AU-key"); got == nil || got.Name != "My Profile" {
+		t.Errorf("FindQualityProfile() = %v, want the My Profile Quality Profile", got)
+	}
+	if got := FindQualityProfile(search, "AU-missing"); got != nil {
+		t.Errorf("FindQualityProfile() = %v, want nil", got)
+	}
+	if got := FindQualityProfile(nil, "AU-key"); got != nil {
+		t.Errorf("FindQualityProfile() = %v, want nil", got)
+	}
+}
+
+func TestFindQualityProfileByName(t *testing.T) {
+	search := &sonargo.QualityprofilesSearchObject{
+		Profiles: []sonargo.QualityprofilesSearchObject_sub3{
+			{Key: "AU-sonar-way", Name: "Sonar way", Language: "java"},
+			{Key: "AU-key", Name: "My Profile", Language: "java"},
+			{Key: "AU-py-key", Name: "My Profile", Language: "py"},
+		},
+	}
+
+	if got := FindQualityProfileByName(search, "py", "My Profile"); got == nil || got.Key != "AU-py-key" {
+		t.Errorf("FindQualityProfileByName() = %v, want the My Profile Python Quality Profile", got)
+	}
+	if got := FindQualityProfileByName(search, "js", "My Profile"); got != nil {
+		t.Errorf("FindQualityProfileByName() = %v, want nil", got)
+	}
+	if got := FindQualityProfileByName(nil, "java", "My Profile"); got != nil {
+		t.Errorf("FindQualityProfileByName() = %v, want nil", got)
+	}
+}
+
+func TestIsQualityProfileUpToDate(t *testing.T) {
+	observation := &v1alpha1.QualityProfileObservation{
+		Name:       "My Profile",
+		IsDefault:  true,
+		ParentName: "Sonar way",
+		Projects:   []string{"project-a"},
+	}
+
+	tests := map[string]struct {
+		spec *v1alpha1.QualityProfileParameters
+		want bool
+	}{
+		"NilSpec": {
+			spec: nil,
+			want: true,
+		},
+		"UnmanagedFields": {
+			spec: &v1alpha1.QualityProfileParameters{Name: "My Profile"},
+			want: true,
+		},
+		"UpToDate": {
+			spec: &v1alpha1.QualityProfileParameters{Name: "My Profile", Default: ptr.To(true), Parent: ptr.To("Sonar way"), Projects: []string{"project-a"}},
+			want: true,
+		},
+		"NameDrift": {
+			spec: &v1alpha1.QualityProfileParameters{Name: "My Renamed Profile"},
+			want: false,
+		},
+		"InheritanceRemoved": {
+			spec: &v1alpha1.QualityProfileParameters{Name: "My Profile", Parent: ptr.To("")},
+			want: false,
+		},
+		"ProjectsDrift": {
+			spec: &v1alpha1.QualityProfileParameters{Name: "My Profile", Projects: []string{}},
+			want: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsQualityProfileUpToDate(tc.spec, observation, nil); got != tc.want {
+				t.Errorf("IsQualityProfileUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeQualityProfile(t *testing.T) {
+	tests := map[string]struct {
+		spec        *v1alpha1.QualityProfileParameters
+		observation *v1alpha1.QualityProfileObservation
+		want        *v1alpha1.QualityProfileParameters
+	}{
+		"FillsDefaultAndParent": {
+			spec:        &v1alpha1.QualityProfileParameters{Name: "My Profile"},
+			observation: &v1alpha1.QualityProfileObservation{IsDefault: true, ParentName: "Sonar way"},
+			want:        &v1alpha1.QualityProfileParameters{Name: "My Profile", Default: ptr.To(true), Parent: ptr.To("Sonar way")},
+		},
+		"NoParentIsNotLateInitialized": {
+			spec:        &v1alpha1.QualityProfileParameters{Name: "My Profile"},
+			observation: &v1alpha1.QualityProfileObservation{},
+			want:        &v1alpha1.QualityProfileParameters{Name: "My Profile", Default: ptr.To(false)},
+		},
+		"KeepsSpecifiedFields": {
+			spec:        &v1alpha1.QualityProfileParameters{Name: "My Profile", Default: ptr.To(false), Parent: ptr.To("")},
+			observation: &v1alpha1.QualityProfileObservation{IsDefault: true, ParentName: "Sonar way"},
+			want:        &v1alpha1.QualityProfileParameters{Name: "My Profile", Default: ptr.To(false), Parent: ptr.To("")},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeQualityProfile(tc.spec, tc.observation)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeQualityProfile() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// SettingsClient is the interface for interacting with SonarQube Settings API
+// It handles all the operations related to the global and Project Settings in SonarQube, such as setting, resetting and retrieving their values.
+type SettingsClient interface {
+	Reset(opt *sonargo.SettingsResetOption) (resp *http.Response, err error)
+	Set(opt *SettingsSetOption) (resp *http.Response, err error)
+	Values(opt *sonargo.SettingsValuesOption) (v *SettingsValuesObject, resp *http.Response, err error)
+}
+
+// NewSettingsClient creates a new SettingsClient with the provided SonarQube client configuration.
+func NewSettingsClient(clientConfig common.Config) SettingsClient {
+	newClient := common.NewClient(clientConfig)
+	return &settingsClient{
+		SettingsService: newClient.Settings,
+		client:          newClient,
+	}
+}
+
+// SettingsSetOption is the option of the settings/set endpoint
+// The SonarQube client SettingsSetOption only sends a single value and a single field value, so that multi-valued and property set Settings cannot be set
+type SettingsSetOption struct {
+	Component   string   `url:"component,omitempty"`   // Description:"Component key. Only keys for projects, applications, portfolios or subportfolios are accepted.",ExampleValue:"my_project"
+	FieldValues []string `url:"fieldValues,omitempty"` // Description:"Setting field values. To set several values, the parameter must be called once for each value.",ExampleValue:"fieldValues={"firstField":"first value", "secondField":"second value", "thirdField":"third value"}"
+	Key         string   `url:"key,omitempty"`         // Description:"Setting key",ExampleValue:"sonar.core.serverBaseURL"
+	Value       string   `url:"value,omitempty"`       // Description:"Setting value. To reset a value, please use the reset web service.",ExampleValue:"http://my-sonarqube-instance.com"
+	Values      []string `url:"values,omitempty"`      // Description:"Setting multi value. To set several values, the parameter must be called once for each value.",ExampleValue:"values=firstValue&values=secondValue&values=thirdValue"
+}
+
+// SettingsValuesObject is the response of the settings/values endpoint
+// The SonarQube client SettingsValuesObject does not type the field values and omits the inherited values
+type SettingsValuesObject struct {
+	SetSecuredSettings []string             `json:"setSecuredSettings,omitempty"`
+	Settings           []SettingValueObject `json:"settings,omitempty"`
+}
+
+// SettingValueObject is the value of a Setting, along with the value it inherits
+type SettingValueObject struct {
+	FieldValues       []map[string]string `json:"fieldValues,omitempty"`
+	Inherited         bool                `json:"inherited,omitempty"`
+	Key               string              `json:"key,omitempty"`
+	ParentFieldValues []map[string]string `json:"parentFieldValues,omitempty"`
+	ParentValue       *string             `json:"parentValue,omitempty"`
+	ParentValues      []string            `json:"parentValues,omitempty"`
+	Value             *string             `json:"value,omitempty"`
+	Values            []string            `json:"values,omitempty"`
+}
+
+// settingsClient extends the SonarQube client SettingsService with the endpoints it does not provide properly
+type settingsClient struct {
+	*sonargo.SettingsService
+	client *sonargo.Client
+}
+
+// Set sets the value, the values or the field values of a Setting
+func (c *settingsClient) Set(opt *SettingsSetOption) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "settings/set", opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// Values retrieves the values of Settings
+func (c *settingsClient) Values(opt *sonargo.SettingsValuesOption) (v *SettingsValuesObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "settings/values", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(SettingsValuesObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GenerateSettingValuesOption generates SonarQube SettingsValuesOption to retrieve the value of the Setting
+func GenerateSettingValuesOption(spec v1alpha1.SettingParameters) *sonargo.SettingsValuesOption {
+	return &sonargo.SettingsValuesOption{
+		Component: ptr.Deref(spec.Component, ""),
+		Keys:      spec.Key,
+	}
+}
+
+// GenerateSettingSetOption generates SettingsSetOption from SettingParameters
+// Each entry of a property set Setting is sent as a JSON object
+func GenerateSettingSetOption(spec v1alpha1.SettingParameters) (*SettingsSetOption, error) {
+	option := &SettingsSetOption{
+		Component: ptr.Deref(spec.Component, ""),
+		Key:       spec.Key,
+		Value:     ptr.Deref(spec.Value, ""),
+		Values:    spec.Values,
+	}
+	for _, fieldValues := range spec.FieldValues {
+		encoded, err := json.Marshal(fieldValues)
+		if err != nil {
+			return nil, err
+		}
+		option.FieldValues = append(option.FieldValues, string(encoded))
+	}
+	return option, nil
+}
+
+// GenerateSettingResetOption generates SonarQube SettingsResetOption to reset the Setting to its inherited value
+func GenerateSettingResetOption(spec v1alpha1.SettingParameters) *sonargo.SettingsResetOption {
+	return &sonargo.SettingsResetOption{
+		Component: ptr.Deref(spec.Component, ""),
+		Keys:      spec.Key,
+	}
+}
+
+// IsSecuredSettingKey returns true if the key is the one of a secured Setting,
+// whose value SonarQube never returns, so that it cannot be observed
+func IsSecuredSettingKey(key string) bool {
+	return strings.HasSuffix(key, ".secured")
+}
+
+// FindSetting finds the value of the Setting with the given key in a SettingsValuesObject
+// It returns nil if the Setting has no value, neither overridden, inherited nor default
+func FindSetting(values *SettingsValuesObject, key string) *SettingValueObject {
+	if values == nil {
+		return nil
+	}
+	for i := range values.Settings {
+		if values.Settings[i].Key == key {
+			return &values.Settings[i]
+		}
+	}
+	return nil
+}
+
+// GenerateSettingObservation generates SettingObservation from a SettingValueObject
+// setting should not be nil, else it will panic
+func GenerateSettingObservation(setting *SettingValueObject) v1alpha1.SettingObservation {
+	return v1alpha1.SettingObservation{
+		FieldValues:       setting.FieldValues,
+		Inherited:         setting.Inherited,
+		ParentFieldValues: setting.ParentFieldValues,
+		ParentValue:       setting.ParentValue,
+		ParentValues:      setting.ParentValues,
+		Value:             setting.Value,
+		Values:            setting.Values,
+	}
+}
+
+// HasSettingValue checks whether a value, values or field values are specified for the Setting
+func HasSettingValue(spec *v1alpha1.SettingParameters) bool {
+	return spec.Value != nil || spec.Values != nil || spec.FieldValues != nil
+}
+
+// LateInitializeSetting fills the value of *SettingParameters, when none is specified, with
+// the value seen in SettingObservation, whether it is overridden, inherited or the default one.
+func LateInitializeSetting(spec *v1alpha1.SettingParameters, observation *v1alpha1.SettingObservation) {
+	if spec == nil || observation == nil || HasSettingValue(spec) {
+		return
+	}
+
+	spec.Value = observation.Value
+	spec.Values = observation.Values
+	spec.FieldValues = observation.FieldValues
+}
+
+// IsSettingUpToDate checks whether the observed Setting is overridden with the desired value
+// An inherited value is never up to date, even if it equals the desired one, so that it is overridden
+func IsSettingUpToDate(spec *v1alpha1.SettingParameters, observation *v1alpha1.SettingObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil || observation.Inherited {
+		return false
+	}
+
+	switch {
+	case spec.Value != nil:
+		return observation.Value != nil && *spec.Value == *observation.Value
+	case spec.Values != nil:
+		return slices.Equal(spec.Values, observation.Values)
+	case spec.FieldValues != nil:
+		return slices.EqualFunc(spec.FieldValues, observation.FieldValues, maps.Equal)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestIsSettingUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		spec        v1alpha1.SettingParameters
+		observation v1alpha1.SettingObservation
+		want        bool
+	}{
+		"ValueUpToDate": {
+			spec:        v1alpha1.SettingParameters{Value: ptr.To("true")},
+			observation: v1alpha1.SettingObservation{Value: ptr.To("true")},
+			want:        true,
+		},
+		"ValueInherited": {
+			spec:        v1alpha1.SettingParameters{Value: ptr.To("true")},
+			observation: v1alpha1.SettingObservation{Value: ptr.To("true"), Inherited: true},
+		},
+		"ValueChanged": {
+			spec:        v1alpha1.SettingParameters{Value: ptr.To("true")},
+			observation: v1alpha1.SettingObservation{Value: ptr.To("false")},
+		},
+		"ValuesUpToDate": {
+			spec:        v1alpha1.SettingParameters{Values: []string{"**/generated/**", "**/vendor/**"}},
+			observation: v1alpha1.SettingObservation{Values: []string{"**/generated/**", "**/vendor/**"}},
+			want:        true,
+		},
+		"ValuesChanged": {
+			spec:        v1alpha1.SettingParameters{Values: []string{"**/generated/**", "**/vendor/**"}},
+			observation: v1alpha1.SettingObservation{Values: []string{"**/generated/**"}},
+		},
+		"FieldValuesUpToDate": {
+			spec:        v1alpha1.SettingParameters{FieldValues: []map[string]string{{"key": "jira", "value": "https://jira"}}},
+			observation: v1alpha1.SettingObservation{FieldValues: []map[string]string{{"value": "https://jira", "key": "jira"}}},
+			want:        true,
+		},
+		"FieldValuesChanged": {
+			spec:        v1alpha1.SettingParameters{FieldValues: []map[string]string{{"key": "jira", "value": "https://jira"}}},
+			observation: v1alpha1.SettingObservation{FieldValues: []map[string]string{{"key": "jira", "value": "https://old-jira"}}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsSettingUpToDate(&tc.spec, &tc.observation); got != tc.want {
+				t.Errorf("IsSettingUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSetting(t *testing.T) {
+	observation := &v1alpha1.SettingObservation{Values: []string{"**/generated/**"}, Inherited: true}
+
+	spec := &v1alpha1.SettingParameters{Key: "sonar.exclusions"}
+	LateInitializeSetting(spec, observation)
+	if diff := cmp.Diff(&v1alpha1.SettingParameters{Key: "sonar.exclusions", Values: []string{"**/generated/**"}}, spec); diff != "" {
+		t.Errorf("LateInitializeSetting() mismatch (-want +got):\n%s", diff)
+	}
+
+	spec = &v1alpha1.SettingParameters{Key: "sonar.exclusions", Values: []string{}}
+	LateInitializeSetting(spec, observation)
+	if diff := cmp.Diff(&v1alpha1.SettingParameters{Key: "sonar.exclusions", Values: []string{}}, spec); diff != "" {
+		t.Errorf("LateInitializeSetting() overrode the specified values (-want +got):\n%s", diff)
+	}
+}
+
+func TestSettingsClient(t *testing.T) {
+	var setQuery map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/settings/set":
+			setQuery = r.URL.Query()
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/settings/values":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"settings":[{"key":"sonar.issue.ignore.multicriteria","fieldValues":[{"ruleKey":"go:S100","resourceKey":"**/*.go"}],"inherited":false,"parentFieldValues":[]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewSettingsClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	spec := v1alpha1.SettingParameters{
+		Component: ptr.To("my-project"),
+		Key:       "sonar.issue.ignore.multicriteria",
+		FieldValues: []map[string]string{
+			{"ruleKey": "go:S100", "resourceKey": "**/*.go"},
+			{"ruleKey": "go:S101", "resourceKey": "**/*_test.go"},
+		},
+	}
+
+	option, err := GenerateSettingSetOption(spec)
+	if err != nil {
+		t.Fatalf("GenerateSettingSetOption() error = %v", err)
+	}
+	resp, err := client.Set(option)
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	// Each entry of a property set is sent as a repeated JSON parameter
+	wantQuery := map[string][]string{
+		"component":   {"my-project"},
+		"fieldValues": {`{"resourceKey":"**/*.go","ruleKey":"go:S100"}`, `{"resourceKey":"**/*_test.go","ruleKey":"go:S101"}`},
+		"key":         {"sonar.issue.ignore.multicriteria"},
+	}
+	if diff := cmp.Diff(wantQuery, setQuery); diff != "" {
+		t.Errorf("Set() parameters mismatch (-want +got):\n%s", diff)
+	}
+
+	values, resp, err := client.Values(GenerateSettingValuesOption(spec))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Values() error = %v", err)
+	}
+	setting := FindSetting(values, spec.Key)
+	if setting == nil {
+		t.Fatalf("FindSetting() did not find %s", spec.Key)
+	}
+	want := v1alpha1.SettingObservation{FieldValues: []map[string]string{{"ruleKey": "go:S100", "resourceKey": "**/*.go"}}, ParentFieldValues: []map[string]string{}}
+	if diff := cmp.Diff(want, GenerateSettingObservation(setting)); diff != "" {
+		t.Errorf("Values() observation mismatch (-want +got):\n%s", diff)
+	}
+}
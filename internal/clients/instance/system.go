@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// Editions of SonarQube supporting Applications and Portfolios, as reported by the system/info endpoint.
+const (
+	EditionEnterprise = "Enterprise"
+	EditionDataCenter = "Data Center"
+)
+
+// SystemClient is the interface for interacting with SonarQube System API
+// It is used to detect the edition of the SonarQube instance, as some features require the Enterprise Edition or above.
+type SystemClient interface {
+	Info() (v *SystemInfoObject, resp *http.Response, err error)
+}
+
+// NewSystemClient creates a new SystemClient with the provided SonarQube client configuration.
+func NewSystemClient(clientConfig common.Config) SystemClient {
+	return &systemClient{
+		client: common.NewClient(clientConfig),
+	}
+}
+
+// SystemInfoObject is the response of the system/info endpoint
+// The SonarQube client SystemInfoObject types every section of the response, whose content varies between versions and editions,
+// so only the System section is kept.
+type SystemInfoObject struct {
+	System SystemInfoSystemObject `json:"System,omitempty"`
+}
+
+// SystemInfoSystemObject is the System section of the response of the system/info endpoint
+type SystemInfoSystemObject struct {
+	Edition string `json:"Edition,omitempty"`
+	Version string `json:"Version,omitempty"`
+}
+
+// systemClient reads the system information of SonarQube, which the SonarQube client SystemService cannot decode reliably
+type systemClient struct {
+	client *sonargo.Client
+}
+
+// Info retrieves the system information of the SonarQube instance
+func (c *systemClient) Info() (v *SystemInfoObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "system/info", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(SystemInfoObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GetSystemEdition returns the edition of the SonarQube instance from its SystemInfoObject, empty if unknown
+func GetSystemEdition(info *SystemInfoObject) string {
+	if info == nil {
+		return ""
+	}
+	return info.System.Edition
+}
+
+// IsEnterpriseEdition returns true if the edition is the Enterprise Edition or above
+func IsEnterpriseEdition(edition string) bool {
+	return strings.EqualFold(edition, EditionEnterprise) || strings.EqualFold(edition, EditionDataCenter)
+}
+
+// GenerateEditionCondition generates the EditionSupported status condition from the edition of the SonarQube instance
+func GenerateEditionCondition(edition string) xpv1.Condition {
+	condition := xpv1.Condition{
+		Type:               v1alpha1.TypeEditionSupported,
+		LastTransitionTime: metav1.Now(),
+	}
+	if IsEnterpriseEdition(edition) {
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = v1alpha1.ReasonEditionSupported
+		return condition
+	}
+	if edition == "" {
+		edition = "unknown"
+	}
+	condition.Status = corev1.ConditionFalse
+	condition.Reason = v1alpha1.ReasonEditionUnsupported
+	condition.Message = fmt.Sprintf("The SonarQube instance runs the %s edition, Applications and Portfolios require the Enterprise Edition or above", edition)
+	return condition
+}
+
+// GetCachedSystemEdition returns the edition of the SonarQube instance of a ProviderConfig, only retrieving it when it is not cached yet
+// A nil cache retrieves the edition every time. The error is the API error of the system/info endpoint.
+func GetCachedSystemEdition(client SystemClient, editions *common.TTLCache[string], providerConfigKey string) (string, error) {
+	if editions != nil {
+		if edition, ok := editions.Get(providerConfigKey); ok {
+			return edition, nil
+		}
+	}
+
+	info, resp, err := client.Info() //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return "", common.NewAPIError(resp, err)
+	}
+	edition := GetSystemEdition(info)
+
+	if editions != nil {
+		editions.Set(providerConfigKey, edition)
+	}
+	return edition, nil
+}
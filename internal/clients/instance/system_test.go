@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestIsEnterpriseEdition(t *testing.T) {
+	tests := map[string]struct {
+		edition string
+		want    bool
+	}{
+		"Community":  {edition: "Community"},
+		"Developer":  {edition: "Developer"},
+		"Enterprise": {edition: "Enterprise", want: true},
+		"DataCenter": {edition: "Data Center", want: true},
+		"LowerCase":  {edition: "enterprise", want: true},
+		"Unknown":    {edition: ""},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsEnterpriseEdition(tc.edition); got != tc.want {
+				t.Errorf("IsEnterpriseEdition() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGenerateEditionCondition(t *testing.T) {
+	tests := map[string]struct {
+		edition     string
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		"Supported": {
+			edition:    "Enterprise",
+			wantStatus: corev1.ConditionTrue,
+		},
+		"Unsupported": {
+			edition:     "Community",
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "The SonarQube instance runs the Community edition, Applications and Portfolios require the Enterprise Edition or above",
+		},
+		"Unknown": {
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "The SonarQube instance runs the unknown edition, Applications and Portfolios require the Enterprise Edition or above",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateEditionCondition(tc.edition)
+			if got.Type != v1alpha1.TypeEditionSupported {
+				t.Errorf("GenerateEditionCondition() type = %v, want %v", got.Type, v1alpha1.TypeEditionSupported)
+			}
+			if got.Status != tc.wantStatus {
+				t.Errorf("GenerateEditionCondition() status = %v, want %v", got.Status, tc.wantStatus)
+			}
+			if got.Message != tc.wantMessage {
+				t.Errorf("GenerateEditionCondition() message = %q, want %q", got.Message, tc.wantMessage)
+			}
+		})
+	}
+}
+
+func TestSystemClientInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/system/info":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Health":"GREEN","System":{"Edition":"Data Center","Version":"2025.1","High Availability":true},"Plugins":{"scmgit":"2.1.0"},"Search Indexes":{"Index components - Docs":"7"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewSystemClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+
+	info, resp, err := client.Info()
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Info() error = %v", err)
+	}
+	if got := GetSystemEdition(info); got != EditionDataCenter {
+		t.Errorf("GetSystemEdition() = %s, want %s", got, EditionDataCenter)
+	}
+}
+
+// countingSystemClient is a SystemClient reporting the given edition, which counts its calls
+type countingSystemClient struct {
+	edition string
+	calls   int
+}
+
+func (c *countingSystemClient) Info() (*SystemInfoObject, *http.Response, error) {
+	c.calls++
+	return &SystemInfoObject{System: SystemInfoSystemObject{Edition: c.edition}}, nil, nil
+}
+
+func TestGetCachedSystemEdition(t *testing.T) {
+	client := &countingSystemClient{edition: EditionEnterprise}
+	editions := common.NewTTLCache[string](time.Minute)
+
+	for range 2 {
+		got, err := GetCachedSystemEdition(client, editions, "ProviderConfig/default/example")
+		if err != nil {
+			t.Fatalf("GetCachedSystemEdition() error = %v", err)
+		}
+		if got != EditionEnterprise {
+			t.Errorf("GetCachedSystemEdition() = %q, want %q", got, EditionEnterprise)
+		}
+	}
+	if client.calls != 1 {
+		t.Errorf("GetCachedSystemEdition() retrieved the edition %d times, want once", client.calls)
+	}
+
+	if _, err := GetCachedSystemEdition(client, nil, "ProviderConfig/default/example"); err != nil {
+		t.Fatalf("GetCachedSystemEdition() error = %v", err)
+	}
+	if client.calls != 2 {
+		t.Errorf("GetCachedSystemEdition() without cache retrieved the edition %d times in total, want 2", client.calls)
+	}
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"crypto/rand"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+// UsersClient is the interface for interacting with SonarQube Users API
+// It handles all the operations related to Users in SonarQube, such as creating, updating, deactivating and retrieving Users.
+// It also validates the credentials of local Users.
+type UsersClient interface {
+	Anonymize(opt *sonargo.UsersAnonymizeOption) (resp *http.Response, err error)
+	ChangePassword(opt *sonargo.UsersChangePasswordOption) (resp *http.Response, err error)
+	Create(opt *UsersCreateOption) (v *sonargo.UsersCreateObject, resp *http.Response, err error)
+	Current() (v *sonargo.UsersCurrentObject, resp *http.Response, err error)
+	Deactivate(opt *sonargo.UsersDeactivateOption) (v *sonargo.UsersDeactivateObject, resp *http.Response, err error)
+	DismissNotice(opt *sonargo.UsersDismissNoticeOption) (resp *http.Response, err error)
+	Groups(opt *sonargo.UsersGroupsOption) (v *sonargo.UsersGroupsObject, resp *http.Response, err error)
+	IdentityProviders() (v *sonargo.UsersIdentityProvidersObject, resp *http.Response, err error)
+	Search(opt *sonargo.UsersSearchOption) (v *sonargo.UsersSearchObject, resp *http.Response, err error)
+	SetHomepage(opt *sonargo.UsersSetHomepageOption) (resp *http.Response, err error)
+	Update(opt *UsersUpdateOption) (v *sonargo.UsersUpdateObject, resp *http.Response, err error)
+	UpdateIdentityProvider(opt *sonargo.UsersUpdateIdentityProviderOption) (resp *http.Response, err error)
+	UpdateLogin(opt *sonargo.UsersUpdateLoginOption) (resp *http.Response, err error)
+	ValidateCredentials(opt *AuthenticationValidateCredentialsOption) (v *sonargo.AuthenticationValidateObject, resp *http.Response, err error)
+}
+
+// NewUsersClient creates a new UsersClient with the provided SonarQube client configuration.
+func NewUsersClient(clientConfig common.Config) UsersClient {
+	newClient := common.NewClient(clientConfig)
+	return &usersClient{
+		UsersService: newClient.Users,
+		client:       newClient,
+	}
+}
+
+// UsersCreateOption is the option of the users/create endpoint
+// The SonarQube client UsersCreateOption only sends a single SCM account, while the parameter must be repeated for each of them
+type UsersCreateOption struct {
+	Email      string   `url:"email,omitempty"`      // Description:"User email",ExampleValue:"myname@email.com"
+	Local      string   `url:"local,omitempty"`      // Description:"Specify if the user should be authenticated from SonarQube server or from an external authentication system. Password should not be set when local is set to false.",ExampleValue:""
+	Login      string   `url:"login,omitempty"`      // Description:"User login",ExampleValue:"myuser"
+	Name       string   `url:"name,omitempty"`       // Description:"User name",ExampleValue:"My Name"
+	Password   string   `url:"password,omitempty"`   // Description:"User password. Only mandatory when creating local user, otherwise it should not be set",ExampleValue:"mypassword"
+	ScmAccount []string `url:"scmAccount,omitempty"` // Description:"List of SCM accounts. To set several values, the parameter must be called once for each value.",ExampleValue:"scmAccount=firstValue&scmAccount=secondValue&scmAccount=thirdValue"
+}
+
+// UsersUpdateOption is the option of the users/update endpoint
+// The SonarQube client UsersUpdateOption only sends a single SCM account, while the parameter must be repeated for each of them
+type UsersUpdateOption struct {
+	Email      string   `url:"email,omitempty"`      // Description:"User email",ExampleValue:"myname@email.com"
+	Login      string   `url:"login,omitempty"`      // Description:"User login",ExampleValue:"myuser"
+	Name       string   `url:"name,omitempty"`       // Description:"User name",ExampleValue:"My Name"
+	ScmAccount []string `url:"scmAccount,omitempty"` // Description:"SCM accounts. To set several values, the parameter must be called once for each value.",ExampleValue:"scmAccount=firstValue&scmAccount=secondValue&scmAccount=thirdValue"
+}
+
+// AuthenticationValidateCredentialsOption are the credentials checked by the authentication/validate endpoint
+// They are sent as the basic authentication of the request instead of the credentials of the provider
+type AuthenticationValidateCredentialsOption struct {
+	Login    string `url:"-"`
+	Password string `url:"-"`
+}
+
+// usersClient extends the SonarQube client UsersService with the endpoints it does not provide properly
+type usersClient struct {
+	*sonargo.UsersService
+	client *sonargo.Client
+}
+
+// Create creates a User, or reactivates the deactivated User with the same login
+func (c *usersClient) Create(opt *UsersCreateOption) (v *sonargo.UsersCreateObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "users/create", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(sonargo.UsersCreateObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// Update updates a User
+func (c *usersClient) Update(opt *UsersUpdateOption) (v *sonargo.UsersUpdateObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "users/update", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	v = new(sonargo.UsersUpdateObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// ValidateCredentials checks whether the given credentials are valid
+func (c *usersClient) ValidateCredentials(opt *AuthenticationValidateCredentialsOption) (v *sonargo.AuthenticationValidateObject, resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodGet, "authentication/validate", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.SetBasicAuth(opt.Login, opt.Password)
+	v = new(sonargo.AuthenticationValidateObject)
+	resp, err = c.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// IsUserLocal returns true if the User authenticates against SonarQube, which is the default
+func IsUserLocal(spec v1alpha1.UserParameters) bool {
+	return spec.Local == nil || *spec.Local
+}
+
+// GenerateUserCreateOption generates UsersCreateOption from UserParameters
+// The password is only sent for local Users
+func GenerateUserCreateOption(spec v1alpha1.UserParameters, password string) *UsersCreateOption {
+	option := &UsersCreateOption{
+		Local:      strconv.FormatBool(IsUserLocal(spec)),
+		Login:      spec.Login,
+		Name:       spec.Name,
+		ScmAccount: spec.ScmAccounts,
+	}
+	if spec.Email != nil {
+		option.Email = *spec.Email
+	}
+	if IsUserLocal(spec) {
+		option.Password = password
+	}
+	return option
+}
+
+// GenerateUserSearchOption generates SonarQube UsersSearchOption to look up a User by its login
+func GenerateUserSearchOption(login string) *sonargo.UsersSearchOption {
+	return &sonargo.UsersSearchOption{
+		Q:  login,
+		Ps: "500",
+	}
+}
+
+// FindUser finds the User with the given login in a SonarQube UsersSearchObject
+// The search API matches the login partially, so it returns nil if no User has exactly this login
+func FindUser(search *sonargo.UsersSearchObject, login string) *sonargo.UsersSearchObject_sub2 {
+	if search == nil {
+		return nil
+	}
+	for i := range search.Users {
+		if search.Users[i].Login == login {
+			return &search.Users[i]
+		}
+	}
+	return nil
+}
+
+// GenerateUserObservation generates UserObservation from SonarQube UsersSearchObject_sub2
+// user should not be nil, else it will panic
+func GenerateUserObservation(user *sonargo.UsersSearchObject_sub2) v1alpha1.UserObservation {
+	return v1alpha1.UserObservation{
+		Active:             user.Active,
+		Email:              user.Email,
+		ExternalIdentity:   user.ExternalIdentity,
+		ExternalProvider:   user.ExternalProvider,
+		Groups:             user.Groups,
+		LastConnectionDate: user.LastConnectionDate,
+		Local:              user.Local,
+		Login:              user.Login,
+		Managed:            user.Managed,
+		Name:               user.Name,
+		ScmAccounts:        user.ScmAccounts,
+		TokensCount:        user.TokensCount,
+	}
+}
+
+// IsUserDetailsUpToDate checks whether the name, email and SCM accounts of the User match the desired ones
+func IsUserDetailsUpToDate(spec *v1alpha1.UserParameters, observation *v1alpha1.UserObservation) bool {
+	if spec.Name != observation.Name {
+		return false
+	}
+	if !helpers.IsComparablePtrEqualComparable(spec.Email, observation.Email) {
+		return false
+	}
+	if spec.ScmAccounts != nil && !helpers.IsComparableSliceEqualIgnoringOrder(spec.ScmAccounts, observation.ScmAccounts) {
+		return false
+	}
+	return true
+}
+
+// IsUserUpToDate checks if the User spec is up to date with the observed state
+func IsUserUpToDate(spec *v1alpha1.UserParameters, observation *v1alpha1.UserObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if spec.Login != observation.Login {
+		return false
+	}
+
+	if !IsUserDetailsUpToDate(spec, observation) {
+		return false
+	}
+
+	if observation.PasswordUpToDate != nil && !*observation.PasswordUpToDate {
+		return false
+	}
+
+	return true
+}
+
+// LateInitializeUser fills the spec with the observed state if the spec fields are nil
+func LateInitializeUser(spec *v1alpha1.UserParameters, observation *v1alpha1.UserObservation) {
+	if spec == nil || observation == nil {
+		return
+	}
+
+	helpers.AssignIfNil(&spec.Local, observation.Local)
+	if observation.Email != "" {
+		helpers.AssignIfNil(&spec.Email, observation.Email)
+	}
+}
+
+// GenerateUserUpdateOption generates UsersUpdateOption from UserParameters
+// Clearing the SCM accounts requires sending the parameter once with an empty value
+func GenerateUserUpdateOption(spec v1alpha1.UserParameters) *UsersUpdateOption {
+	option := &UsersUpdateOption{
+		Login:      spec.Login,
+		Name:       spec.Name,
+		ScmAccount: spec.ScmAccounts,
+	}
+	if spec.Email != nil {
+		option.Email = *spec.Email
+	}
+	if spec.ScmAccounts != nil && len(spec.ScmAccounts) == 0 {
+		option.ScmAccount = []string{""}
+	}
+	return option
+}
+
+// GenerateUserUpdateLoginOption generates SonarQube UsersUpdateLoginOption to change the login of a User
+func GenerateUserUpdateLoginOption(login, newLogin string) *sonargo.UsersUpdateLoginOption {
+	return &sonargo.UsersUpdateLoginOption{
+		Login:    login,
+		NewLogin: newLogin,
+	}
+}
+
+// GenerateUserChangePasswordOption generates SonarQube UsersChangePasswordOption to set the password of a User
+func GenerateUserChangePasswordOption(login, password string) *sonargo.UsersChangePasswordOption {
+	return &sonargo.UsersChangePasswordOption{
+		Login:    login,
+		Password: password,
+	}
+}
+
+// GenerateUserDeactivateOption generates SonarQube UsersDeactivateOption to deactivate a User
+func GenerateUserDeactivateOption(login string) *sonargo.UsersDeactivateOption {
+	return &sonargo.UsersDeactivateOption{
+		Login: login,
+	}
+}
+
+// GenerateUserValidateCredentialsOption generates AuthenticationValidateCredentialsOption to check the password of a User
+func GenerateUserValidateCredentialsOption(login, password string) *AuthenticationValidateCredentialsOption {
+	return &AuthenticationValidateCredentialsOption{
+		Login:    login,
+		Password: password,
+	}
+}
+
+// GenerateUserConnectionDetails generates the connection details of a User
+// The password is only published when it is known, which is when it comes from a Secret or has just been generated
+func GenerateUserConnectionDetails(login string, password *string) managed.ConnectionDetails {
+	details := managed.ConnectionDetails{
+		v1alpha1.UserConnectionSecretLoginKey: []byte(login),
+	}
+	if password != nil {
+		details[v1alpha1.UserConnectionSecretPasswordKey] = []byte(*password)
+	}
+	return details
+}
+
+// userPasswordLength is the length of the generated User passwords
+const userPasswordLength = 32
+
+// userPasswordCharsets are the character sets a generated User password draws at least one character from,
+// to fulfill the SonarQube requirements of an uppercase character, a lowercase character, a digit and a special character
+var userPasswordCharsets = []string{
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"abcdefghijklmnopqrstuvwxyz",
+	"0123456789",
+	"!#%*+-.:=?@_~",
+}
+
+// GenerateUserPassword generates a random password fulfilling the SonarQube password requirements
+func GenerateUserPassword() (string, error) {
+	all := ""
+	for _, charset := range userPasswordCharsets {
+		all += charset
+	}
+
+	password := make([]byte, userPasswordLength)
+	for i := range password {
+		// The first characters are drawn from each required character set, the others from all of them
+		charset := all
+		if i < len(userPasswordCharsets) {
+			charset = userPasswordCharsets[i]
+		}
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = charset[index.Int64()]
+	}
+
+	// Shuffle the password so that the required characters are not always at its beginning
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
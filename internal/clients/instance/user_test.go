@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestFindUser(t *testing.T) {
+	search := &sonargo.UsersSearchObject{
+		Users: []sonargo.UsersSearchObject_sub2{
+			{Login: "jdoe-admin", Name: "John Doe (admin)"},
+			{Login: "jdoe", Name: "John Doe"},
+		},
+	}
+
+	if got := FindUser(search, "jdoe"); got == nil || got.Name != "John Doe" {
+		t.Errorf("FindUser() = %v, want the jdoe User", got)
+	}
+	if got := FindUser(search, "jd"); got != nil {
+		t.Errorf("FindUser() = %v, want nil", got)
+	}
+	if got := FindUser(nil, "jdoe"); got != nil {
+		t.Errorf("FindUser() = %v, want nil", got)
+	}
+}
+
+func TestGenerateUserCreateOption(t *testing.T) {
+	tests := map[string]struct {
+		spec v1alpha1.UserParameters
+		want *UsersCreateOption
+	}{
+		"LocalByDefault": {
+			spec: v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Email: ptr.To("jdoe@example.com"), ScmAccounts: []string{"jdoe", "john.doe"}},
+			want: &UsersCreateOption{Local: "true", Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", Password: "s3cr3t", ScmAccount: []string{"jdoe", "john.doe"}},
+		},
+		"ExternalWithoutPassword": {
+			spec: v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Local: ptr.To(false)},
+			want: &UsersCreateOption{Local: "false", Login: "jdoe", Name: "John Doe"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUserCreateOption(tc.spec, "s3cr3t")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUserCreateOption() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUserUpToDate(t *testing.T) {
+	observation := &v1alpha1.UserObservation{
+		Login:       "jdoe",
+		Name:        "John Doe",
+		Email:       "jdoe@example.com",
+		ScmAccounts: []string{"jdoe", "john.doe"},
+	}
+
+	tests := map[string]struct {
+		spec             *v1alpha1.UserParameters
+		passwordUpToDate *bool
+		want             bool
+	}{
+		"NilSpec": {
+			spec: nil,
+			want: true,
+		},
+		"UnmanagedFields": {
+			spec: &v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Email: ptr.To("jdoe@example.com")},
+			want: true,
+		},
+		"UpToDate": {
+			spec:             &v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Email: ptr.To("jdoe@example.com"), ScmAccounts: []string{"john.doe", "jdoe"}},
+			passwordUpToDate: ptr.To(true),
+			want:             true,
+		},
+		"LoginDrift": {
+			spec: &v1alpha1.UserParameters{Login: "john", Name: "John Doe", Email: ptr.To("jdoe@example.com")},
+			want: false,
+		},
+		"NameDrift": {
+			spec: &v1alpha1.UserParameters{Login: "jdoe", Name: "Johnny Doe", Email: ptr.To("jdoe@example.com")},
+			want: false,
+		},
+		"ScmAccountsCleared": {
+			spec: &v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Email: ptr.To("jdoe@example.com"), ScmAccounts: []string{}},
+			want: false,
+		},
+		"PasswordDrift": {
+			spec:             &v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Email: ptr.To("jdoe@example.com")},
+			passwordUpToDate: ptr.To(false),
+			want:             false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			observation := observation.DeepCopy()
+			observation.PasswordUpToDate = tc.passwordUpToDate
+			if got := IsUserUpToDate(tc.spec, observation); got != tc.want {
+				t.Errorf("IsUserUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLateInitializeUser(t *testing.T) {
+	tests := map[string]struct {
+		spec        *v1alpha1.UserParameters
+		observation *v1alpha1.UserObservation
+		want        *v1alpha1.UserParameters
+	}{
+		"FillsLocalAndEmail": {
+			spec:        &v1alpha1.UserParameters{Login: "jdoe"},
+			observation: &v1alpha1.UserObservation{Local: true, Email: "jdoe@example.com"},
+			want:        &v1alpha1.UserParameters{Login: "jdoe", Local: ptr.To(true), Email: ptr.To("jdoe@example.com")},
+		},
+		"NoEmailIsNotLateInitialized": {
+			spec:        &v1alpha1.UserParameters{Login: "jdoe"},
+			observation: &v1alpha1.UserObservation{Local: false},
+			want:        &v1alpha1.UserParameters{Login: "jdoe", Local: ptr.To(false)},
+		},
+		"KeepsSpecifiedFields": {
+			spec:        &v1alpha1.UserParameters{Login: "jdoe", Local: ptr.To(true), Email: ptr.To("")},
+			observation: &v1alpha1.UserObservation{Local: false, Email: "jdoe@example.com"},
+			want:        &v1alpha1.UserParameters{Login: "jdoe", Local: ptr.To(true), Email: ptr.To("")},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeUser(tc.spec, tc.observation)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeUser() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUserUpdateOption(t *testing.T) {
+	tests := map[string]struct {
+		spec v1alpha1.UserParameters
+		want *UsersUpdateOption
+	}{
+		"UnmanagedScmAccounts": {
+			spec: v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"},
+			want: &UsersUpdateOption{Login: "jdoe", Name: "John Doe"},
+		},
+		"ScmAccounts": {
+			spec: v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Email: ptr.To("jdoe@example.com"), ScmAccounts: []string{"jdoe"}},
+			want: &UsersUpdateOption{Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", ScmAccount: []string{"jdoe"}},
+		},
+		"ClearedScmAccounts": {
+			spec: v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", ScmAccounts: []string{}},
+			want: &UsersUpdateOption{Login: "jdoe", Name: "John Doe", ScmAccount: []string{""}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUserUpdateOption(tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUserUpdateOption() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUserConnectionDetails(t *testing.T) {
+	if got := GenerateUserConnectionDetails("jdoe", nil); len(got) != 1 || string(got[v1alpha1.UserConnectionSecretLoginKey]) != "jdoe" {
+		t.Errorf("GenerateUserConnectionDetails() = %v, want only the login", got)
+	}
+	got := GenerateUserConnectionDetails("jdoe", ptr.To("s3cr3t"))
+	if string(got[v1alpha1.UserConnectionSecretLoginKey]) != "jdoe" || string(got[v1alpha1.UserConnectionSecretPasswordKey]) != "s3cr3t" {
+		t.Errorf("GenerateUserConnectionDetails() = %v, want the login and the password", got)
+	}
+}
+
+func TestGenerateUserPassword(t *testing.T) {
+	password, err := GenerateUserPassword()
+	if err != nil {
+		t.Fatalf("GenerateUserPassword() error = %v", err)
+	}
+	if len(password) != userPasswordLength {
+		t.Errorf("GenerateUserPassword() length = %d, want %d", len(password), userPasswordLength)
+	}
+	for _, charset := range userPasswordCharsets {
+		if !strings.ContainsAny(password, charset) {
+			t.Errorf("GenerateUserPassword() = %q, want at least one of %q", password, charset)
+		}
+	}
+
+	other, err := GenerateUserPassword()
+	if err != nil {
+		t.Fatalf("GenerateUserPassword() error = %v", err)
+	}
+	if password == other {
+		t.Errorf("GenerateUserPassword() returned %q twice", password)
+	}
+}
+
+func TestUsersClientRequests(t *testing.T) {
+	var scmAccounts []string
+	var login, password string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/users/update":
+			scmAccounts = r.URL.Query()["scmAccount"]
+			_, _ = w.Write([]byte(`{"user":{"login":"jdoe"}}`))
+		case "/api/authentication/validate":
+			login, password, _ = r.BasicAuth()
+			_, _ = w.Write([]byte(`{"valid":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewUsersClient(common.Config{AuthType: common.PersonalAccessToken, Token: "admin-token", BaseURL: server.URL + "/api/"})
+
+	_, resp, err := client.Update(GenerateUserUpdateOption(v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", ScmAccounts: []string{"jdoe", "john.doe"}}))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"jdoe", "john.doe"}, scmAccounts); diff != "" {
+		t.Errorf("Update() scmAccount parameters mismatch (-want +got):\n%s", diff)
+	}
+
+	validation, resp, err := client.ValidateCredentials(GenerateUserValidateCredentialsOption("jdoe", "s3cr3t"))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("ValidateCredentials() error = %v", err)
+	}
+	if !validation.Valid || login != "jdoe" || password != "s3cr3t" {
+		t.Errorf("ValidateCredentials() = %v with credentials %q:%q, want valid with the User credentials", validation, login, password)
+	}
+}
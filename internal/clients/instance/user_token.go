@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"time"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// UserTokensClient is the interface for interacting with SonarQube User Tokens API
+// It handles all the operations related to the access tokens of Users in SonarQube, such as generating, revoking and listing them.
+type UserTokensClient interface {
+	Generate(opt *sonargo.UserTokensGenerateOption) (v *sonargo.UserTokensGenerateObject, resp *http.Response, err error)
+	Revoke(opt *sonargo.UserTokensRevokeOption) (resp *http.Response, err error)
+	Search(opt *sonargo.UserTokensSearchOption) (v *sonargo.UserTokensSearchObject, resp *http.Response, err error)
+}
+
+// NewUserTokensClient creates a new UserTokensClient with the provided SonarQube client configuration.
+func NewUserTokensClient(clientConfig common.Config) UserTokensClient {
+	newClient := common.NewClient(clientConfig)
+	return newClient.UserTokens
+}
+
+// userTokenDateLayout is the layout of the expiration date of a token sent to SonarQube
+const userTokenDateLayout = time.DateOnly
+
+// userTokenDateTimeLayout is the layout of the dates of a token returned by SonarQube
+const userTokenDateTimeLayout = "2006-01-02T15:04:05-0700"
+
+// GenerateUserTokenGenerateOption generates SonarQube UserTokensGenerateOption from UserTokenParameters
+// The expiration date of a token with a lifetime is computed from now, rounded up to the next day so that the token lives at least as long
+func GenerateUserTokenGenerateOption(spec v1alpha1.UserTokenParameters, now time.Time) *sonargo.UserTokensGenerateOption {
+	option := &sonargo.UserTokensGenerateOption{
+		Login:      ptr.Deref(spec.Login, ""),
+		Name:       spec.Name,
+		ProjectKey: ptr.Deref(spec.ProjectKey, ""),
+		Type:       ptr.Deref(spec.Type, ""),
+	}
+	switch {
+	case spec.ExpirationDate != nil:
+		option.ExpirationDate = *spec.ExpirationDate
+	case spec.ExpiresAfter != nil:
+		option.ExpirationDate = userTokenExpirationDate(now, spec.ExpiresAfter.Duration)
+	}
+	return option
+}
+
+// userTokenExpirationDate computes the expiration date of a token living for lifetime from now, rounded up to the next day
+func userTokenExpirationDate(now time.Time, lifetime time.Duration) string {
+	expiration := now.UTC().Add(lifetime)
+	day := expiration.Truncate(24 * time.Hour)
+	if day.Before(expiration) {
+		day = day.Add(24 * time.Hour)
+	}
+	return day.Format(userTokenDateLayout)
+}
+
+// GenerateUserTokenSearchOption generates SonarQube UserTokensSearchOption to list the tokens of a User
+func GenerateUserTokenSearchOption(login *string) *sonargo.UserTokensSearchOption {
+	return &sonargo.UserTokensSearchOption{
+		Login: ptr.Deref(login, ""),
+	}
+}
+
+// GenerateUserTokenRevokeOption generates SonarQube UserTokensRevokeOption to revoke the token of a User
+func GenerateUserTokenRevokeOption(login *string, name string) *sonargo.UserTokensRevokeOption {
+	return &sonargo.UserTokensRevokeOption{
+		Login: ptr.Deref(login, ""),
+		Name:  name,
+	}
+}
+
+// FindUserToken finds the token with the given name in a SonarQube UserTokensSearchObject
+func FindUserToken(search *sonargo.UserTokensSearchObject, name string) *sonargo.UserTokensSearchObject_sub2 {
+	if search == nil {
+		return nil
+	}
+	for i := range search.UserTokens {
+		if search.UserTokens[i].Name == name {
+			return &search.UserTokens[i]
+		}
+	}
+	return nil
+}
+
+// GenerateUserTokenObservation generates UserTokenObservation from SonarQube UserTokensSearchObject_sub2 and the login of its User
+// token should not be nil, else it will panic
+func GenerateUserTokenObservation(login string, token *sonargo.UserTokensSearchObject_sub2) v1alpha1.UserTokenObservation {
+	return v1alpha1.UserTokenObservation{
+		CreatedAt:      token.CreatedAt,
+		ExpirationDate: token.ExpirationDate,
+		IsExpired:      token.IsExpired,
+		Login:          login,
+		Name:           token.Name,
+		ProjectKey:     token.Project.Key,
+		Type:           token.Type,
+	}
+}
+
+// parseUserTokenDate parses a date returned by SonarQube, which may or may not include the time
+func parseUserTokenDate(date string) (time.Time, bool) {
+	for _, layout := range []string{userTokenDateTimeLayout, time.RFC3339, userTokenDateLayout} {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// IsUserTokenRotationDue checks whether the token is due to be replaced, as its expiration date is closer than RotateBefore
+func IsUserTokenRotationDue(spec *v1alpha1.UserTokenParameters, observation *v1alpha1.UserTokenObservation, now time.Time) bool {
+	if spec.RotateBefore == nil || observation.ExpirationDate == "" {
+		return false
+	}
+	expiration, ok := parseUserTokenDate(observation.ExpirationDate)
+	if !ok {
+		return false
+	}
+	return !now.Before(expiration.Add(-spec.RotateBefore.Duration))
+}
+
+// IsUserTokenUpToDate checks if the UserToken spec is up to date with the observed state
+// A token that is not up to date must be replaced by a new one, as SonarQube does not allow updating tokens
+func IsUserTokenUpToDate(spec *v1alpha1.UserTokenParameters, observation *v1alpha1.UserTokenObservation, now time.Time) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if observation.IsExpired {
+		return false
+	}
+
+	// Only the day of the expiration date is specified, while SonarQube returns it along with its time
+	if spec.ExpirationDate != nil {
+		expiration, ok := parseUserTokenDate(observation.ExpirationDate)
+		if !ok || expiration.Format(userTokenDateLayout) != *spec.ExpirationDate {
+			return false
+		}
+	}
+
+	return !IsUserTokenRotationDue(spec, observation, now)
+}
+
+// GenerateUserTokenConnectionDetails generates the connection details of a UserToken from the generated token
+func GenerateUserTokenConnectionDetails(token *sonargo.UserTokensGenerateObject) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.UserTokenConnectionSecretTokenKey: []byte(token.Token),
+		v1alpha1.UserTokenConnectionSecretLoginKey: []byte(token.Login),
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+	"time"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+)
+
+func TestGenerateUserTokenGenerateOption(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 15, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		spec v1alpha1.UserTokenParameters
+		want *sonargo.UserTokensGenerateOption
+	}{
+		"ProjectAnalysisToken": {
+			spec: v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci", Type: ptr.To("PROJECT_ANALYSIS_TOKEN"), ProjectKey: ptr.To("my-project"), ExpirationDate: ptr.To("2026-12-31")},
+			want: &sonargo.UserTokensGenerateOption{Login: "ci-bot", Name: "ci", Type: "PROJECT_ANALYSIS_TOKEN", ProjectKey: "my-project", ExpirationDate: "2026-12-31"},
+		},
+		"LifetimeIsRoundedUpToTheNextDay": {
+			spec: v1alpha1.UserTokenParameters{Name: "ci", ExpiresAfter: &metav1.Duration{Duration: 30 * 24 * time.Hour}},
+			want: &sonargo.UserTokensGenerateOption{Name: "ci", ExpirationDate: "2026-04-10"},
+		},
+		"NoExpiration": {
+			spec: v1alpha1.UserTokenParameters{Name: "ci", Type: ptr.To("GLOBAL_ANALYSIS_TOKEN")},
+			want: &sonargo.UserTokensGenerateOption{Name: "ci", Type: "GLOBAL_ANALYSIS_TOKEN"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUserTokenGenerateOption(tc.spec, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUserTokenGenerateOption() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindUserToken(t *testing.T) {
+	search := &sonargo.UserTokensSearchObject{
+		Login: "ci-bot",
+		UserTokens: []sonargo.UserTokensSearchObject_sub2{
+			{Name: "ci-old"},
+			{Name: "ci", Type: "USER_TOKEN"},
+		},
+	}
+
+	if got := FindUserToken(search, "ci"); got == nil || got.Type != "USER_TOKEN" {
+		t.Errorf("FindUserToken() = %v, want the ci token", got)
+	}
+	if got := FindUserToken(search, "missing"); got != nil {
+		t.Errorf("FindUserToken() = %v, want nil", got)
+	}
+	if got := FindUserToken(nil, "ci"); got != nil {
+		t.Errorf("FindUserToken() = %v, want nil", got)
+	}
+}
+
+func TestIsUserTokenUpToDate(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	observation := &v1alpha1.UserTokenObservation{Name: "ci", ExpirationDate: "2026-04-10T00:00:00+0000"}
+
+	tests := map[string]struct {
+		spec        *v1alpha1.UserTokenParameters
+		observation *v1alpha1.UserTokenObservation
+		want        bool
+	}{
+		"NilSpec": {
+			spec:        nil,
+			observation: observation,
+			want:        true,
+		},
+		"NoExpiration": {
+			spec:        &v1alpha1.UserTokenParameters{Name: "ci"},
+			observation: &v1alpha1.UserTokenObservation{Name: "ci"},
+			want:        true,
+		},
+		"SameExpirationDate": {
+			spec:        &v1alpha1.UserTokenParameters{Name: "ci", ExpirationDate: ptr.To("2026-04-10")},
+			observation: observation,
+			want:        true,
+		},
+		"ExpirationDateChanged": {
+			spec:        &v1alpha1.UserTokenParameters{Name: "ci", ExpirationDate: ptr.To("2026-06-30")},
+			observation: observation,
+			want:        false,
+		},
+		"Expired": {
+			spec:        &v1alpha1.UserTokenParameters{Name: "ci"},
+			observation: &v1alpha1.UserTokenObservation{Name: "ci", ExpirationDate: "2026-03-01T00:00:00+0000", IsExpired: true},
+			want:        false,
+		},
+		"RotationNotDueYet": {
+			spec:        &v1alpha1.UserTokenParameters{Name: "ci", ExpiresAfter: &metav1.Duration{Duration: 30 * 24 * time.Hour}, RotateBefore: &metav1.Duration{Duration: 7 * 24 * time.Hour}},
+			observation: observation,
+			want:        true,
+		},
+		"RotationDue": {
+			spec:        &v1alpha1.UserTokenParameters{Name: "ci", ExpiresAfter: &metav1.Duration{Duration: 90 * 24 * time.Hour}, RotateBefore: &metav1.Duration{Duration: 31 * 24 * time.Hour}},
+			observation: observation,
+			want:        false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUserTokenUpToDate(tc.spec, tc.observation, now); got != tc.want {
+				t.Errorf("IsUserTokenUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
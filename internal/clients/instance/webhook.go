@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"strconv"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+// WebhookDeliveriesPageSize is the number of deliveries retrieved to observe the latest delivery of a Webhook
+const WebhookDeliveriesPageSize = 1
+
+// WebhooksClient is the interface for interacting with SonarQube Webhooks API
+// It handles all the operations related to Webhooks in SonarQube, such as creating, updating, deleting and listing Webhooks and their deliveries.
+type WebhooksClient interface {
+	Create(opt *sonargo.WebhooksCreateOption) (v *sonargo.WebhooksCreateObject, resp *http.Response, err error)
+	Delete(opt *sonargo.WebhooksDeleteOption) (resp *http.Response, err error)
+	Deliveries(opt *sonargo.WebhooksDeliveriesOption) (v *sonargo.WebhooksDeliveriesObject, resp *http.Response, err error)
+	List(opt *sonargo.WebhooksListOption) (v *sonargo.WebhooksListObject, resp *http.Response, err error)
+	Update(opt *WebhooksUpdateOption) (resp *http.Response, err error)
+}
+
+// NewWebhooksClient creates a new WebhooksClient with the provided SonarQube client configuration.
+func NewWebhooksClient(clientConfig common.Config) WebhooksClient {
+	newClient := common.NewClient(clientConfig)
+	return &webhooksClient{
+		WebhooksService: newClient.Webhooks,
+		client:          newClient,
+	}
+}
+
+// WebhooksUpdateOption is the option of the webhooks/update endpoint
+// The SonarQube client WebhooksUpdateOption omits an empty secret, so that a secret cannot be removed
+type WebhooksUpdateOption struct {
+	Name    string  `url:"name,omitempty"`    // Description:"new name of the webhook",ExampleValue:"My Webhook"
+	Secret  *string `url:"secret,omitempty"`  // Description:"If provided, secret will be used as the key to generate the HMAC hex (lowercase) digest value in the 'X-Sonar-Webhook-HMAC-SHA256' header. If blank, any secret previously configured will be removed. If not set, the secret will remain unchanged.",ExampleValue:"your_secret"
+	Url     string  `url:"url,omitempty"`     // Description:"new url to be called by the webhook",ExampleValue:"https://www.my-webhook-listener.com/sonar"
+	Webhook string  `url:"webhook,omitempty"` // Description:"The key of the webhook to be updated, auto-generated value can be obtained through api/webhooks/create or api/webhooks/list",ExampleValue:"my_project"
+}
+
+// webhooksClient extends the SonarQube client WebhooksService with the endpoints it does not provide properly
+type webhooksClient struct {
+	*sonargo.WebhooksService
+	client *sonargo.Client
+}
+
+// Update updates the name, the URL and the secret of a Webhook
+func (c *webhooksClient) Update(opt *WebhooksUpdateOption) (resp *http.Response, err error) {
+	req, err := c.client.NewRequest(http.MethodPost, "webhooks/update", opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req, nil)
+}
+
+// GenerateWebhookCreateOption generates SonarQube WebhooksCreateOption from WebhookParameters and the secret of the Webhook
+func GenerateWebhookCreateOption(spec v1alpha1.WebhookParameters, secret *string) *sonargo.WebhooksCreateOption {
+	return &sonargo.WebhooksCreateOption{
+		Name:    spec.Name,
+		Project: ptr.Deref(spec.ProjectKey, ""),
+		Secret:  ptr.Deref(secret, ""),
+		Url:     spec.URL,
+	}
+}
+
+// GenerateWebhookUpdateOption generates WebhooksUpdateOption from WebhookParameters and the secret of the Webhook
+// A nil secret is sent empty, so that any secret previously configured is removed
+func GenerateWebhookUpdateOption(key string, spec v1alpha1.WebhookParameters, secret *string) *WebhooksUpdateOption {
+	return &WebhooksUpdateOption{
+		Name:    spec.Name,
+		Secret:  ptr.To(ptr.Deref(secret, "")),
+		Url:     spec.URL,
+		Webhook: key,
+	}
+}
+
+// GenerateWebhookListOption generates SonarQube WebhooksListOption to list the global Webhooks or the ones of the Project
+func GenerateWebhookListOption(spec v1alpha1.WebhookParameters) *sonargo.WebhooksListOption {
+	return &sonargo.WebhooksListOption{
+		Project: ptr.Deref(spec.ProjectKey, ""),
+	}
+}
+
+// GenerateWebhookDeleteOption generates SonarQube WebhooksDeleteOption to delete a Webhook
+func GenerateWebhookDeleteOption(key string) *sonargo.WebhooksDeleteOption {
+	return &sonargo.WebhooksDeleteOption{
+		Webhook: key,
+	}
+}
+
+// GenerateWebhookDeliveriesOption generates SonarQube WebhooksDeliveriesOption to retrieve the latest delivery of a Webhook
+func GenerateWebhookDeliveriesOption(key string) *sonargo.WebhooksDeliveriesOption {
+	return &sonargo.WebhooksDeliveriesOption{
+		P:       "1",
+		Ps:      strconv.Itoa(WebhookDeliveriesPageSize),
+		Webhook: key,
+	}
+}
+
+// FindWebhook finds the Webhook with the given key in a SonarQube WebhooksListObject
+func FindWebhook(list *sonargo.WebhooksListObject, key string) *sonargo.WebhooksListObject_sub1 {
+	if list == nil {
+		return nil
+	}
+	for i := range list.Webhooks {
+		if list.Webhooks[i].Key == key {
+			return &list.Webhooks[i]
+		}
+	}
+	return nil
+}
+
+// GenerateWebhookObservation generates WebhookObservation from a SonarQube Webhook
+// webhook should not be nil, else it will panic
+func GenerateWebhookObservation(webhook *sonargo.WebhooksListObject_sub1, projectKey string) v1alpha1.WebhookObservation {
+	return v1alpha1.WebhookObservation{
+		HasSecret:  webhook.HasSecret,
+		Key:        webhook.Key,
+		Name:       webhook.Name,
+		ProjectKey: projectKey,
+		URL:        webhook.URL,
+	}
+}
+
+// GenerateWebhookLastDeliveryObservation generates WebhookDeliveryObservation from the latest delivery of a SonarQube WebhooksDeliveriesObject
+// It returns nil if the Webhook has not been delivered yet
+func GenerateWebhookLastDeliveryObservation(deliveries *sonargo.WebhooksDeliveriesObject) *v1alpha1.WebhookDeliveryObservation {
+	if deliveries == nil || len(deliveries.Deliveries) == 0 {
+		return nil
+	}
+	delivery := deliveries.Deliveries[0]
+	return &v1alpha1.WebhookDeliveryObservation{
+		At:           delivery.At,
+		CeTaskID:     delivery.CeTaskID,
+		ComponentKey: delivery.ComponentKey,
+		DurationMs:   delivery.DurationMs,
+		HTTPStatus:   delivery.HTTPStatus,
+		ID:           delivery.ID,
+		Success:      delivery.Success,
+	}
+}
+
+// IsWebhookUpToDate checks whether the observed Webhook is up to date with the desired WebhookParameters and secret
+// SonarQube does not return the secret of a Webhook, so the version of the Secret it was last set from is compared instead
+// A secret whose Secret version was not recorded yet is considered out of date, so that it is set again
+func IsWebhookUpToDate(spec *v1alpha1.WebhookParameters, observation *v1alpha1.WebhookObservation, secret *string, secretVersion string) bool {
+	if spec == nil {
+		return true
+	}
+	if observation == nil {
+		return false
+	}
+
+	if spec.Name != observation.Name || spec.URL != observation.URL {
+		return false
+	}
+
+	if secret == nil || *secret == "" {
+		return !observation.HasSecret
+	}
+	return observation.HasSecret && observation.SecretVersion == secretVersion
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+)
+
+func TestGenerateWebhookLastDeliveryObservation(t *testing.T) {
+	if got := GenerateWebhookLastDeliveryObservation(&sonargo.WebhooksDeliveriesObject{}); got != nil {
+		t.Errorf("GenerateWebhookLastDeliveryObservation() = %v, want nil without delivery", got)
+	}
+
+	deliveries := &sonargo.WebhooksDeliveriesObject{
+		Deliveries: []sonargo.WebhooksDeliveriesObject_sub1{
+			{ID: "d2", At: "2026-10-16T10:00:00+0000", ComponentKey: "my-project", HTTPStatus: 500, DurationMs: 12},
+			{ID: "d1", Success: true, HTTPStatus: 200},
+		},
+	}
+	want := &v1alpha1.WebhookDeliveryObservation{ID: "d2", At: "2026-10-16T10:00:00+0000", ComponentKey: "my-project", HTTPStatus: 500, DurationMs: 12}
+	if diff := cmp.Diff(want, GenerateWebhookLastDeliveryObservation(deliveries)); diff != "" {
+		t.Errorf("GenerateWebhookLastDeliveryObservation() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsWebhookUpToDate(t *testing.T) {
+	spec := &v1alpha1.WebhookParameters{Name: "gate", URL: "https://gate.example.com/sonar"}
+	secret := ptr.To("s3cr3t")
+
+	tests := map[string]struct {
+		observation v1alpha1.WebhookObservation
+		secret      *string
+		want        bool
+	}{
+		"UpToDateWithoutSecret": {
+			observation: v1alpha1.WebhookObservation{Name: "gate", URL: "https://gate.example.com/sonar"},
+			want:        true,
+		},
+		"UpToDateWithSecret": {
+			observation: v1alpha1.WebhookObservation{Name: "gate", URL: "https://gate.example.com/sonar", HasSecret: true, SecretVersion: "secret-uid/42"},
+			secret:      secret,
+			want:        true,
+		},
+		"URLChanged": {
+			observation: v1alpha1.WebhookObservation{Name: "gate", URL: "https://old.example.com/sonar"},
+		},
+		"SecretChanged": {
+			observation: v1alpha1.WebhookObservation{Name: "gate", URL: "https://gate.example.com/sonar", HasSecret: true, SecretVersion: "secret-uid/41"},
+			secret:      secret,
+		},
+		"SecretRecreated": {
+			observation: v1alpha1.WebhookObservation{Name: "gate", URL: "https://gate.example.com/sonar", HasSecret: true, SecretVersion: "old-secret-uid/42"},
+			secret:      secret,
+		},
+		"SecretVersionUnknown": {
+			observation: v1alpha1.WebhookObservation{Name: "gate", URL: "https://gate.example.com/sonar", HasSecret: true},
+			secret:      secret,
+		},
+		"UnwantedSecret": {
+			observation: v1alpha1.WebhookObservation{Name: "gate", URL: "https://gate.example.com/sonar", HasSecret: true},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsWebhookUpToDate(spec, &tc.observation, tc.secret, "secret-uid/42"); got != tc.want {
+				t.Errorf("IsWebhookUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWebhooksClientUpdateRemovesSecret(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/webhooks/update" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewWebhooksClient(common.Config{AuthType: common.PersonalAccessToken, Token: "token", BaseURL: server.URL + "/api/"})
+	resp, err := client.Update(GenerateWebhookUpdateOption("AU-Tpxb", v1alpha1.WebhookParameters{Name: "gate", URL: "https://gate.example.com/sonar"}, nil))
+	if resp != nil {
+		defer resp.Body.Close() //nolint:errcheck // test client
+	}
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	// An empty secret is sent so that the previous one is removed
+	wantQuery := map[string][]string{"name": {"gate"}, "secret": {""}, "url": {"https://gate.example.com/sonar"}, "webhook": {"AU-Tpxb"}}
+	if diff := cmp.Diff(wantQuery, query); diff != "" {
+		t.Errorf("Update() parameters mismatch (-want +got):\n%s", diff)
+	}
+}
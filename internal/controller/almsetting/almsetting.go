@@ -0,0 +1,328 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package almsetting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotAlmSetting = "managed resource is not an AlmSetting custom resource"
+	errTrackPCUsage  = "cannot track ProviderConfig usage"
+	errGetPC         = "cannot get ProviderConfig"
+
+	errListAlmSettings        = "cannot list SonarQube DevOps Platform Settings"
+	errCreateAlmSetting       = "cannot create SonarQube DevOps Platform Setting"
+	errUpdateAlmSetting       = "cannot update SonarQube DevOps Platform Setting"
+	errDeleteAlmSetting       = "cannot delete SonarQube DevOps Platform Setting"
+	errGetAlmSettingSecret    = "cannot get AlmSetting credentials"
+	errUnsupportedAlmPlatform = "unsupported DevOps Platform %s"
+)
+
+// SetupGated adds a controller that reconciles AlmSetting managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup AlmSetting controller"))
+		}
+	}, v1alpha1.AlmSettingGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AlmSettingGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewAlmSettingsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.AlmSettingList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.AlmSettingList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.AlmSettingGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.AlmSetting{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.AlmSettingsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AlmSetting)
+	if !ok {
+		return nil, errors.New(errNotAlmSetting)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{kube: c.kube, almSettingsClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// kube is used to read the credentials of the AlmSettings from their Secrets
+	kube client.Client
+	// almSettingsClient is used to interact with SonarQube DevOps Platform Settings API
+	almSettingsClient instance.AlmSettingsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AlmSetting)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAlmSetting)
+	}
+
+	// DevOps Platform Settings cannot be shown individually, find this one among all of them
+	definitions, resp, err := c.almSettingsClient.ListDefinitions() //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(resp, err), errListAlmSettings)
+	}
+	definition := instance.FindAlmSetting(definitions, cr.Spec.ForProvider.Alm, cr.Spec.ForProvider.Key)
+	if definition == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	credentials, err := c.getCredentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Update status with observed state, keeping the versions of the Secrets last set by the provider
+	secretVersions := cr.Status.AtProvider.SecretVersions
+	cr.Status.AtProvider = instance.GenerateAlmSettingObservation(definition, cr.Spec.ForProvider.Alm)
+	cr.Status.AtProvider.SecretVersions = secretVersions
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: instance.IsAlmSettingUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, credentials),
+	}, nil
+}
+
+// getCredentials retrieves the credentials of the AlmSetting from the Secrets it references, along with the versions of the Secrets
+func (c *external) getCredentials(ctx context.Context, cr *v1alpha1.AlmSetting) (instance.AlmSettingCredentials, error) {
+	credentials := instance.AlmSettingCredentials{}
+	for _, credential := range []struct {
+		name     string
+		selector *xpv1.LocalSecretKeySelector
+		value    **string
+	}{
+		{name: "clientSecret", selector: cr.Spec.ForProvider.ClientSecretSecretRef, value: &credentials.ClientSecret},
+		{name: "personalAccessToken", selector: cr.Spec.ForProvider.PersonalAccessTokenSecretRef, value: &credentials.PersonalAccessToken},
+		{name: "privateKey", selector: cr.Spec.ForProvider.PrivateKeySecretRef, value: &credentials.PrivateKey},
+		{name: "webhookSecret", selector: cr.Spec.ForProvider.WebhookSecretSecretRef, value: &credentials.WebhookSecret},
+	} {
+		if credential.selector == nil {
+			continue
+		}
+		value, version, err := common.GetTokenValueAndVersionFromLocalSecret(ctx, c.kube, cr, credential.selector)
+		if err != nil {
+			return instance.AlmSettingCredentials{}, errors.Wrap(err, errGetAlmSettingSecret)
+		}
+		*credential.value = value
+		if credentials.SecretVersions == nil {
+			credentials.SecretVersions = map[string]string{}
+		}
+		credentials.SecretVersions[credential.name] = version
+	}
+	return credentials, nil
+}
+
+// Create creates the DevOps Platform Setting with the endpoint of its DevOps Platform
+// The versions of the Secrets are only recorded by the first Update, since the status set on creation is not persisted
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AlmSetting)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAlmSetting)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	credentials, err := c.getCredentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	resp, err := c.create(cr.Spec.ForProvider, credentials) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateAlmSetting)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// create calls the creation endpoint of the DevOps Platform of the AlmSetting
+func (c *external) create(spec v1alpha1.AlmSettingParameters, credentials instance.AlmSettingCredentials) (*http.Response, error) {
+	switch spec.Alm {
+	case v1alpha1.AlmGitHub:
+		return c.almSettingsClient.CreateGithub(instance.GenerateAlmSettingCreateGithubOption(spec, credentials))
+	case v1alpha1.AlmGitLab:
+		return c.almSettingsClient.CreateGitlab(instance.GenerateAlmSettingCreateGitlabOption(spec, credentials))
+	case v1alpha1.AlmAzureDevOps:
+		return c.almSettingsClient.CreateAzure(instance.GenerateAlmSettingCreateAzureOption(spec, credentials))
+	case v1alpha1.AlmBitbucketServer:
+		return c.almSettingsClient.CreateBitbucket(instance.GenerateAlmSettingCreateBitbucketOption(spec, credentials))
+	case v1alpha1.AlmBitbucketCloud:
+		return c.almSettingsClient.CreateBitbucketcloud(instance.GenerateAlmSettingCreateBitbucketcloudOption(spec, credentials))
+	default:
+		return nil, fmt.Errorf(errUnsupportedAlmPlatform, spec.Alm)
+	}
+}
+
+// Update updates the DevOps Platform Setting and its credentials, and records the versions of their Secrets
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AlmSetting)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAlmSetting)
+	}
+
+	credentials, err := c.getCredentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	resp, err := c.update(cr.Spec.ForProvider, credentials) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errUpdateAlmSetting)
+	}
+
+	cr.Status.AtProvider.SecretVersions = credentials.SecretVersions
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// update calls the update endpoint of the DevOps Platform of the AlmSetting
+func (c *external) update(spec v1alpha1.AlmSettingParameters, credentials instance.AlmSettingCredentials) (*http.Response, error) {
+	switch spec.Alm {
+	case v1alpha1.AlmGitHub:
+		return c.almSettingsClient.UpdateGithub(instance.GenerateAlmSettingUpdateGithubOption(spec, credentials))
+	case v1alpha1.AlmGitLab:
+		return c.almSettingsClient.UpdateGitlab(instance.GenerateAlmSettingUpdateGitlabOption(spec, credentials))
+	case v1alpha1.AlmAzureDevOps:
+		return c.almSettingsClient.UpdateAzure(instance.GenerateAlmSettingUpdateAzureOption(spec, credentials))
+	case v1alpha1.AlmBitbucketServer:
+		return c.almSettingsClient.UpdateBitbucket(instance.GenerateAlmSettingUpdateBitbucketOption(spec, credentials))
+	case v1alpha1.AlmBitbucketCloud:
+		return c.almSettingsClient.UpdateBitbucketcloud(instance.GenerateAlmSettingUpdateBitbucketcloudOption(spec, credentials))
+	default:
+		return nil, fmt.Errorf(errUnsupportedAlmPlatform, spec.Alm)
+	}
+}
+
+// Delete deletes the DevOps Platform Setting, which unbinds the Projects bound through it
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.AlmSetting)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotAlmSetting)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	resp, err := c.almSettingsClient.Delete(instance.GenerateAlmSettingDeleteOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The DevOps Platform Setting is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteAlmSetting)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
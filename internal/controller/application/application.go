@@ -0,0 +1,353 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotApplication = "managed resource is not an Application custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+
+	errGetSystemInfo      = "cannot get SonarQube system information"
+	errUnsupportedEdition = "cannot manage Applications on the %s edition of SonarQube, they require the Enterprise Edition or above"
+	errShowApplication    = "cannot get SonarQube Application"
+	errShowBranch         = "cannot get SonarQube Application branch"
+	errCreateApplication  = "cannot create SonarQube Application"
+	errUpdateApplication  = "cannot update SonarQube Application"
+	errDeleteApplication  = "cannot delete SonarQube Application"
+	errAddProject         = "cannot add Project to SonarQube Application"
+	errRemoveProject      = "cannot remove Project from SonarQube Application"
+	errCreateBranch       = "cannot create SonarQube Application branch"
+	errUpdateBranch       = "cannot update SonarQube Application branch"
+	errDeleteBranch       = "cannot delete SonarQube Application branch"
+)
+
+// editionTTL is the duration for which the edition of the SonarQube instance of a ProviderConfig is cached
+const editionTTL = 10 * time.Minute
+
+// SetupGated adds a controller that reconciles Application managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup Application controller"))
+		}
+	}, v1alpha1.ApplicationGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApplicationGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:               mgr.GetClient(),
+			usage:              resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:       instance.NewApplicationsClient,
+			newSystemServiceFn: instance.NewSystemClient,
+			editions:           common.NewTTLCache[string](editionTTL)}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ApplicationList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.ApplicationList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.ApplicationGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Application{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube               client.Client
+	usage              *resource.ProviderConfigUsageTracker
+	newServiceFn       func(config common.Config) instance.ApplicationsClient
+	newSystemServiceFn func(config common.Config) instance.SystemClient
+	editions           *common.TTLCache[string]
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return nil, errors.New(errNotApplication)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{
+		applicationsClient: c.newServiceFn(*config),
+		systemClient:       c.newSystemServiceFn(*config),
+		editions:           c.editions,
+		providerConfigKey:  common.ProviderConfigKey(m),
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// applicationsClient is used to interact with SonarQube Applications API
+	applicationsClient instance.ApplicationsClient
+	// systemClient is used to detect the edition of SonarQube
+	systemClient instance.SystemClient
+	// editions caches the edition of the SonarQube instance of each ProviderConfig
+	editions *common.TTLCache[string]
+	// providerConfigKey identifies the ProviderConfig of the managed resource in the editions cache
+	providerConfigKey string
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApplication)
+	}
+
+	edition, err := instance.GetCachedSystemEdition(c.systemClient, c.editions, c.providerConfigKey)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSystemInfo)
+	}
+	cr.Status.SetConditions(instance.GenerateEditionCondition(edition))
+	if !instance.IsEnterpriseEdition(edition) {
+		// The Application cannot exist on this edition, so there is nothing to delete
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf(errUnsupportedEdition, edition)
+	}
+
+	application, resp, err := c.applicationsClient.Show(instance.GenerateApplicationShowOption(cr.Spec.ForProvider.Key, "")) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errShowApplication)
+	}
+
+	// The Project branches aggregated by each branch of the Application are only returned when showing this branch
+	names := instance.FindApplicationBranchNames(&application.Application)
+	branches := make([]v1alpha1.ApplicationBranch, 0, len(names))
+	for _, name := range names {
+		branch, err := c.observeApplicationBranch(cr.Spec.ForProvider.Key, name)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		branches = append(branches, branch)
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateApplicationObservation(&application.Application, branches)
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeApplication(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsApplicationUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// observeApplicationBranch shows a branch of the Application, closing its response before the next branch is shown
+func (c *external) observeApplicationBranch(key, name string) (v1alpha1.ApplicationBranch, error) {
+	branch, resp, err := c.applicationsClient.Show(instance.GenerateApplicationShowOption(key, name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return v1alpha1.ApplicationBranch{}, errors.Wrap(common.NewAPIError(resp, err), errShowBranch)
+	}
+	return instance.GenerateApplicationBranch(name, &branch.Application), nil
+}
+
+// Create creates the Application along with its Projects and branches
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApplication)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	resp, err := c.applicationsClient.Create(instance.GenerateApplicationCreateOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateApplication)
+	}
+
+	// A new Application has neither Projects nor branches
+	return managed.ExternalCreation{}, c.updateContent(cr.Spec.ForProvider, v1alpha1.ApplicationObservation{})
+}
+
+// Update updates the Application, its Projects and its branches
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApplication)
+	}
+
+	if cr.Spec.ForProvider.Name != cr.Status.AtProvider.Name || !helpers.IsComparablePtrEqualComparable(cr.Spec.ForProvider.Description, cr.Status.AtProvider.Description) {
+		resp, err := c.applicationsClient.Update(instance.GenerateApplicationUpdateOption(cr.Spec.ForProvider, cr.Status.AtProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errUpdateApplication)
+		}
+	}
+
+	return managed.ExternalUpdate{}, c.updateContent(cr.Spec.ForProvider, cr.Status.AtProvider)
+}
+
+// updateContent updates the Projects and branches of the Application from their observed state
+// Branches are deleted before and created after updating the Projects, as they aggregate branches of these Projects.
+func (c *external) updateContent(spec v1alpha1.ApplicationParameters, observation v1alpha1.ApplicationObservation) error {
+	for _, branch := range instance.FindApplicationBranchesToDelete(spec.Branches, observation.Branches) {
+		resp, err := c.applicationsClient.DeleteBranch(instance.GenerateApplicationDeleteBranchOption(spec.Key, branch)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrap(common.NewAPIError(resp, err), errDeleteBranch)
+		}
+	}
+
+	for _, project := range instance.FindApplicationProjectsToAdd(spec.Projects, observation.Projects) {
+		resp, err := c.applicationsClient.AddProject(instance.GenerateApplicationAddProjectOption(spec.Key, project)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrap(common.NewAPIError(resp, err), errAddProject)
+		}
+	}
+
+	for _, project := range instance.FindApplicationProjectsToRemove(spec.Projects, observation.Projects) {
+		resp, err := c.applicationsClient.RemoveProject(instance.GenerateApplicationRemoveProjectOption(spec.Key, project)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrap(common.NewAPIError(resp, err), errRemoveProject)
+		}
+	}
+
+	for _, branch := range instance.FindApplicationBranchesToUpdate(spec.Branches, observation.Branches) {
+		resp, err := c.applicationsClient.UpdateBranch(instance.GenerateApplicationUpdateBranchOption(spec.Key, branch)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrap(common.NewAPIError(resp, err), errUpdateBranch)
+		}
+	}
+
+	for _, branch := range instance.FindApplicationBranchesToCreate(spec.Branches, observation.Branches) {
+		resp, err := c.applicationsClient.CreateBranch(instance.GenerateApplicationCreateBranchOption(spec.Key, branch)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrap(common.NewAPIError(resp, err), errCreateBranch)
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the Application, along with its branches
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotApplication)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	resp, err := c.applicationsClient.Delete(instance.GenerateApplicationDeleteOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Application is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteApplication)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,565 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notApplication struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newApplication(params v1alpha1.ApplicationParameters, observation v1alpha1.ApplicationObservation) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-application",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			ForProvider: params,
+		},
+		Status: v1alpha1.ApplicationStatus{
+			AtProvider: observation,
+		},
+	}
+}
+
+// roundTrip returns the Application as stored by the API server, which drops the fields omitted from its JSON
+func roundTrip(cr *v1alpha1.Application) *v1alpha1.Application {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		panic(err)
+	}
+	stored := &v1alpha1.Application{}
+	if err := json.Unmarshal(raw, stored); err != nil {
+		panic(err)
+	}
+	return stored
+}
+
+func systemReturning(edition string) *fake.MockSystemClient {
+	return &fake.MockSystemClient{
+		InfoFn: func() (*instance.SystemInfoObject, *http.Response, error) {
+			return &instance.SystemInfoObject{System: instance.SystemInfoSystemObject{Edition: edition}}, nil, nil
+		},
+	}
+}
+
+var (
+	releaseBranch = v1alpha1.ApplicationBranch{
+		Name:     "release",
+		Projects: []v1alpha1.ApplicationBranchProject{{ProjectKey: "backend", Branch: "release-1.0"}},
+	}
+	appParams = v1alpha1.ApplicationParameters{
+		Key:         "my-app",
+		Name:        "My Application",
+		Description: ptr.To("Backend services"),
+		Projects:    []string{"backend"},
+		Branches:    []v1alpha1.ApplicationBranch{releaseBranch},
+	}
+	appObservation = v1alpha1.ApplicationObservation{
+		Key:         "my-app",
+		Name:        "My Application",
+		Description: "Backend services",
+		Visibility:  "private",
+		Projects:    []string{"backend"},
+		Branches:    []v1alpha1.ApplicationBranch{releaseBranch},
+	}
+)
+
+// showApplication returns the Application matching appObservation, on its main branch or on its release branch
+func showApplication(opt *instance.ApplicationsShowOption) (*instance.ApplicationsShowObject, *http.Response, error) {
+	if opt.Branch == "release" {
+		return &instance.ApplicationsShowObject{Application: instance.ApplicationObject{
+			Key:      "my-app",
+			Branch:   "release",
+			Projects: []instance.ApplicationProjectObject{{Key: "backend", Branch: "release-1.0", Selected: ptr.To(true)}},
+		}}, nil, nil
+	}
+	return &instance.ApplicationsShowObject{Application: instance.ApplicationObject{
+		Key:         "my-app",
+		Name:        "My Application",
+		Description: "Backend services",
+		Visibility:  "private",
+		IsMain:      true,
+		Projects:    []instance.ApplicationProjectObject{{Key: "backend", Branch: "main", IsMain: true}},
+		Branches:    []instance.ApplicationBranchObject{{Name: "main", IsMain: true}, {Name: "release"}},
+	}}, nil, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.ApplicationObservation
+		edition     corev1.ConditionStatus
+		err         error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockApplicationsClient
+		system *fake.MockSystemClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotApplicationError": {
+			client: &fake.MockApplicationsClient{},
+			system: systemReturning(instance.EditionEnterprise),
+			mg:     &notApplication{},
+			want: want{
+				err: errors.New(errNotApplication),
+			},
+		},
+		"SystemInfoFailsReturnsError": {
+			client: &fake.MockApplicationsClient{},
+			system: &fake.MockSystemClient{
+				InfoFn: func() (*instance.SystemInfoObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newApplication(appParams, v1alpha1.ApplicationObservation{}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errGetSystemInfo),
+			},
+		},
+		"UnsupportedEditionReturnsError": {
+			client: &fake.MockApplicationsClient{},
+			system: systemReturning("Community"),
+			mg:     newApplication(appParams, v1alpha1.ApplicationObservation{}),
+			want: want{
+				edition: corev1.ConditionFalse,
+				err:     fmt.Errorf(errUnsupportedEdition, "Community"),
+			},
+		},
+		"UnsupportedEditionDeleted": {
+			client: &fake.MockApplicationsClient{},
+			system: systemReturning("Community"),
+			mg: func() resource.Managed {
+				cr := newApplication(appParams, v1alpha1.ApplicationObservation{})
+				cr.SetDeletionTimestamp(ptr.To(metav1.Now()))
+				return cr
+			}(),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: false},
+				edition: corev1.ConditionFalse,
+			},
+		},
+		"NotFound": {
+			client: &fake.MockApplicationsClient{
+				ShowFn: func(opt *instance.ApplicationsShowOption) (*instance.ApplicationsShowObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			system: systemReturning(instance.EditionEnterprise),
+			mg:     newApplication(appParams, v1alpha1.ApplicationObservation{}),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: false},
+				edition: corev1.ConditionTrue,
+			},
+		},
+		"ShowFailsReturnsError": {
+			client: &fake.MockApplicationsClient{
+				ShowFn: func(opt *instance.ApplicationsShowOption) (*instance.ApplicationsShowObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("internal error")
+				},
+			},
+			system: systemReturning(instance.EditionEnterprise),
+			mg:     newApplication(appParams, v1alpha1.ApplicationObservation{}),
+			want: want{
+				edition: corev1.ConditionTrue,
+				err:     errors.Wrap(errors.New("internal error"), errShowApplication),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockApplicationsClient{ShowFn: showApplication},
+			system: systemReturning(instance.EditionDataCenter),
+			mg:     newApplication(appParams, v1alpha1.ApplicationObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: appObservation,
+				edition:     corev1.ConditionTrue,
+			},
+		},
+		"BranchRemovedNeedsUpdate": {
+			client: &fake.MockApplicationsClient{ShowFn: showApplication},
+			system: systemReturning(instance.EditionEnterprise),
+			mg: newApplication(v1alpha1.ApplicationParameters{
+				Key:         "my-app",
+				Name:        "My Application",
+				Description: ptr.To("Backend services"),
+				Branches:    []v1alpha1.ApplicationBranch{},
+			}, v1alpha1.ApplicationObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: appObservation,
+				edition:     corev1.ConditionTrue,
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockApplicationsClient{ShowFn: showApplication},
+			system: systemReturning(instance.EditionEnterprise),
+			mg:     newApplication(v1alpha1.ApplicationParameters{Key: "my-app", Name: "My Application"}, v1alpha1.ApplicationObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				observation: appObservation,
+				edition:     corev1.ConditionTrue,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{applicationsClient: tc.client, systemClient: tc.system}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			cr, ok := tc.mg.(*v1alpha1.Application)
+			if !ok {
+				return
+			}
+			if tc.want.edition != "" {
+				if diff := cmp.Diff(tc.want.edition, cr.Status.GetCondition(v1alpha1.TypeEditionSupported).Status); diff != "" {
+					t.Errorf("\ne.Observe(...): -want EditionSupported status, +got EditionSupported status:\n%s\n", diff)
+				}
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestObserveCachesEdition(t *testing.T) {
+	infos := 0
+	system := &fake.MockSystemClient{
+		InfoFn: func() (*instance.SystemInfoObject, *http.Response, error) {
+			infos++
+			return &instance.SystemInfoObject{System: instance.SystemInfoSystemObject{Edition: "Community"}}, nil, nil
+		},
+	}
+	editions := common.NewTTLCache[string](time.Minute)
+
+	for _, key := range []string{"ProviderConfig/default/example", "ProviderConfig/default/example", "ProviderConfig/default/other"} {
+		e := external{applicationsClient: &fake.MockApplicationsClient{}, systemClient: system, editions: editions, providerConfigKey: key}
+		if _, err := e.Observe(context.Background(), newApplication(appParams, v1alpha1.ApplicationObservation{})); !errComparer(err, fmt.Errorf(errUnsupportedEdition, "Community")) {
+			t.Errorf("e.Observe(...) error = %v, want %s", err, fmt.Sprintf(errUnsupportedEdition, "Community"))
+		}
+	}
+	if infos != 2 {
+		t.Errorf("e.Observe(...) retrieved the edition %d times, want once per ProviderConfig", infos)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type calls struct {
+		projects []string
+		branches []string
+	}
+
+	cases := map[string]struct {
+		client *fake.MockApplicationsClient
+		mg     resource.Managed
+		want   error
+		calls  calls
+	}{
+		"NotApplicationError": {
+			client: &fake.MockApplicationsClient{},
+			mg:     &notApplication{},
+			want:   errors.New(errNotApplication),
+		},
+		"CreateFailsReturnsError": {
+			client: &fake.MockApplicationsClient{
+				CreateFn: func(opt *instance.ApplicationsCreateOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("already exists")
+				},
+			},
+			mg:   newApplication(appParams, v1alpha1.ApplicationObservation{}),
+			want: errors.Wrap(errors.New("already exists"), errCreateApplication),
+		},
+		"AddProjectFailsReturnsError": {
+			client: &fake.MockApplicationsClient{
+				CreateFn: func(opt *instance.ApplicationsCreateOption) (*http.Response, error) {
+					return nil, nil
+				},
+				AddProjectFn: func(opt *instance.ApplicationsAddProjectOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("project not found")
+				},
+			},
+			mg:   newApplication(appParams, v1alpha1.ApplicationObservation{}),
+			want: errors.Wrap(errors.New("project not found"), errAddProject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{applicationsClient: tc.client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+
+	t.Run("CreatesProjectsAndBranches", func(t *testing.T) {
+		var got calls
+		client := &fake.MockApplicationsClient{
+			CreateFn: func(opt *instance.ApplicationsCreateOption) (*http.Response, error) {
+				if opt.Key != "my-app" || opt.Name != "My Application" || opt.Description != "Backend services" {
+					return nil, errors.Errorf("unexpected option %+v", opt)
+				}
+				return nil, nil
+			},
+			AddProjectFn: func(opt *instance.ApplicationsAddProjectOption) (*http.Response, error) {
+				got.projects = append(got.projects, opt.Project)
+				return nil, nil
+			},
+			CreateBranchFn: func(opt *instance.ApplicationsCreateBranchOption) (*http.Response, error) {
+				got.branches = append(got.branches, opt.Branch)
+				return nil, nil
+			},
+		}
+		cr := newApplication(appParams, v1alpha1.ApplicationObservation{})
+		e := external{applicationsClient: client}
+		if _, err := e.Create(context.Background(), cr); err != nil {
+			t.Fatalf("e.Create(...): unexpected error: %v", err)
+		}
+		want := calls{projects: []string{"backend"}, branches: []string{"release"}}
+		if diff := cmp.Diff(want, got, cmp.AllowUnexported(calls{})); diff != "" {
+			t.Errorf("\ne.Create(...): -want calls, +got calls:\n%s\n", diff)
+		}
+		if diff := cmp.Diff(xpv1.Creating().Reason, cr.Status.GetCondition(xpv1.TypeReady).Reason); diff != "" {
+			t.Errorf("\ne.Create(...): -want reason, +got reason:\n%s\n", diff)
+		}
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	type calls struct {
+		updated         bool
+		addedProjects   []string
+		removedProjects []string
+		createdBranches []string
+		updatedBranches []string
+		deletedBranches []string
+	}
+
+	outdatedRelease := v1alpha1.ApplicationBranch{
+		Name:     "release",
+		Projects: []v1alpha1.ApplicationBranchProject{{ProjectKey: "backend", Branch: "release-0.9"}},
+	}
+	hotfix := v1alpha1.ApplicationBranch{
+		Name:     "hotfix",
+		Projects: []v1alpha1.ApplicationBranchProject{{ProjectKey: "frontend", Branch: "hotfix"}},
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		fail bool
+		want calls
+		err  error
+	}{
+		"NotApplicationError": {
+			mg:  &notApplication{},
+			err: errors.New(errNotApplication),
+		},
+		"UpdatesEverything": {
+			mg: newApplication(v1alpha1.ApplicationParameters{
+				Key:         "my-app",
+				Name:        "Renamed Application",
+				Description: ptr.To("Backend services"),
+				Projects:    []string{"frontend"},
+				Branches:    []v1alpha1.ApplicationBranch{releaseBranch, hotfix},
+			}, v1alpha1.ApplicationObservation{
+				Key:         "my-app",
+				Name:        "My Application",
+				Description: "Backend services",
+				Projects:    []string{"backend"},
+				Branches:    []v1alpha1.ApplicationBranch{outdatedRelease, {Name: "legacy"}},
+			}),
+			want: calls{
+				updated:         true,
+				addedProjects:   []string{"frontend"},
+				removedProjects: []string{"backend"},
+				createdBranches: []string{"hotfix"},
+				updatedBranches: []string{"release"},
+				deletedBranches: []string{"legacy"},
+			},
+		},
+		"UnmanagedContent": {
+			mg: newApplication(v1alpha1.ApplicationParameters{
+				Key:         "my-app",
+				Name:        "My Application",
+				Description: ptr.To("All services"),
+			}, appObservation),
+			want: calls{updated: true},
+		},
+		"EmptyListsRemoveLastContent": {
+			mg: roundTrip(newApplication(v1alpha1.ApplicationParameters{
+				Key:         "my-app",
+				Name:        "My Application",
+				Description: ptr.To("Backend services"),
+				Projects:    []string{},
+				Branches:    []v1alpha1.ApplicationBranch{},
+			}, appObservation)),
+			want: calls{
+				removedProjects: []string{"backend"},
+				deletedBranches: []string{"release"},
+			},
+		},
+		"UpdateFailsReturnsError": {
+			mg:   newApplication(v1alpha1.ApplicationParameters{Key: "my-app", Name: "Renamed Application"}, appObservation),
+			fail: true,
+			err:  errors.Wrap(errors.New("bad request"), errUpdateApplication),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got calls
+			client := &fake.MockApplicationsClient{
+				UpdateFn: func(opt *instance.ApplicationsUpdateOption) (*http.Response, error) {
+					if tc.fail {
+						return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("bad request")
+					}
+					got.updated = true
+					return nil, nil
+				},
+				AddProjectFn: func(opt *instance.ApplicationsAddProjectOption) (*http.Response, error) {
+					got.addedProjects = append(got.addedProjects, opt.Project)
+					return nil, nil
+				},
+				RemoveProjectFn: func(opt *instance.ApplicationsRemoveProjectOption) (*http.Response, error) {
+					got.removedProjects = append(got.removedProjects, opt.Project)
+					return nil, nil
+				},
+				CreateBranchFn: func(opt *instance.ApplicationsCreateBranchOption) (*http.Response, error) {
+					got.createdBranches = append(got.createdBranches, opt.Branch)
+					return nil, nil
+				},
+				UpdateBranchFn: func(opt *instance.ApplicationsUpdateBranchOption) (*http.Response, error) {
+					got.updatedBranches = append(got.updatedBranches, opt.Branch)
+					return nil, nil
+				},
+				DeleteBranchFn: func(opt *instance.ApplicationsDeleteBranchOption) (*http.Response, error) {
+					got.deletedBranches = append(got.deletedBranches, opt.Branch)
+					return nil, nil
+				},
+			}
+			e := external{applicationsClient: client}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("\ne.Update(...): -want calls, +got calls:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockApplicationsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotApplicationError": {
+			client: &fake.MockApplicationsClient{},
+			mg:     &notApplication{},
+			want:   errors.New(errNotApplication),
+		},
+		"Delete": {
+			client: &fake.MockApplicationsClient{
+				DeleteFn: func(opt *instance.ApplicationsDeleteOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newApplication(appParams, appObservation),
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockApplicationsClient{
+				DeleteFn: func(opt *instance.ApplicationsDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newApplication(appParams, appObservation),
+		},
+		"DeleteFailsReturnsError": {
+			client: &fake.MockApplicationsClient{
+				DeleteFn: func(opt *instance.ApplicationsDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg:   newApplication(appParams, appObservation),
+			want: errors.Wrap(errors.New("forbidden"), errDeleteApplication),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{applicationsClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -17,23 +17,33 @@ limitations under the License.
 package config
 
 import (
+	"time"
+
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage, and one that rotates their token when asked to.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	if err := setupNamespacedProviderConfig(mgr, o); err != nil {
 		return err
 	}
-	return setupClusterProviderConfig(mgr, o)
+	if err := setupClusterProviderConfig(mgr, o); err != nil {
+		return err
+	}
+	if err := setupTokenRotation(mgr, o, v1alpha1.ProviderConfigGroupKind, func() client.Object { return &v1alpha1.ProviderConfig{} }); err != nil {
+		return err
+	}
+	return setupTokenRotation(mgr, o, v1alpha1.ClusterProviderConfigGroupKind, func() client.Object { return &v1alpha1.ClusterProviderConfig{} })
 }
 
 func setupNamespacedProviderConfig(mgr ctrl.Manager, o controller.Options) error {
@@ -76,3 +86,22 @@ func setupClusterProviderConfig(mgr ctrl.Manager, o controller.Options) error {
 		Watches(&v1alpha1.ClusterProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
+
+func setupTokenRotation(mgr ctrl.Manager, o controller.Options, kind string, newConfig func() client.Object) error {
+	name := tokenRotationControllerName(kind)
+
+	r := &tokenRotationReconciler{
+		client:       mgr.GetClient(),
+		newConfig:    newConfig,
+		newServiceFn: instance.NewUserTokensClient,
+		now:          time.Now,
+		log:          o.Logger.WithValues("controller", name),
+		record:       event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(newConfig()).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errGetProviderConfig    = "cannot get ProviderConfig"
+	errUnknownConfig        = "unknown ProviderConfig type"
+	errTokenSecretNotSet    = "tokenRotation requires the token to be read from a Secret"
+	errGetTokenSecret       = "cannot get token Secret"
+	errTokenSecretKeyNotSet = "cannot find token key in token Secret"
+	errGenerateToken        = "cannot generate SonarQube token"
+	errUpdateTokenSecret    = "cannot write rotated token to token Secret"
+	errRevokeToken          = "cannot revoke previous SonarQube token"
+	errRevokeGeneratedToken = "cannot revoke SonarQube token generated by the failed rotation"
+	errRestoreTokenSecret   = "cannot restore current token in token Secret"
+	errUpdateRotationStatus = "cannot record token rotation in ProviderConfig status"
+
+	reasonRotatedToken event.Reason = "RotatedToken"
+)
+
+// tokenRotationControllerName returns the name of the controller rotating the token of the given kind of ProviderConfig.
+func tokenRotationControllerName(kind string) string {
+	return "tokenrotation/" + strings.ToLower(kind)
+}
+
+// A tokenRotationReconciler periodically replaces the token of a ProviderConfig or ClusterProviderConfig.
+// It runs next to the usage reconciler of crossplane-runtime, which owns the rest of the ProviderConfig lifecycle.
+type tokenRotationReconciler struct {
+	client       client.Client
+	newConfig    func() client.Object
+	newServiceFn func(config common.Config) instance.UserTokensClient
+	now          func() time.Time
+	log          logging.Logger
+	record       event.Recorder
+}
+
+// providerConfigSpecAndStatus returns the spec and status shared by ProviderConfigs and ClusterProviderConfigs.
+func providerConfigSpecAndStatus(obj client.Object) (*v1alpha1.ProviderConfigSpec, *v1alpha1.ProviderConfigStatus, error) {
+	switch pc := obj.(type) {
+	case *v1alpha1.ProviderConfig:
+		return &pc.Spec, &pc.Status, nil
+	case *v1alpha1.ClusterProviderConfig:
+		return &pc.Spec, &pc.Status, nil
+	default:
+		return nil, nil, errors.New(errUnknownConfig)
+	}
+}
+
+// Reconcile rotates the token of the ProviderConfig when it is due.
+// The new token is generated with the current one and stored in the Secret before the previous token is revoked,
+// so that the managed resources reading the Secret always find a valid token.
+// The previous token stays recorded in the status until it is revoked, so that a failed revocation is retried.
+// A rotation that cannot be stored or recorded is rolled back, so that it does not leave an untracked token behind.
+func (r *tokenRotationReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	pc := r.newConfig()
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	spec, status, err := providerConfigSpecAndStatus(pc)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if spec.TokenRotation == nil {
+		return reconcile.Result{}, nil
+	}
+	rotation := *spec.TokenRotation
+	if spec.Token == nil || spec.Token.Source != xpv1.CredentialsSourceSecret || spec.Token.SecretRef == nil {
+		return reconcile.Result{}, errors.New(errTokenSecretNotSet)
+	}
+
+	// The token replaced by a previous rotation must be gone before another one is generated
+	if status.PendingRevocationTokenName != "" {
+		if err := r.revokePendingToken(ctx, pc, spec, status); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	now := r.now()
+	if delay := instance.ProviderTokenRotationDelay(rotation, status.LastTokenRotationTime, now); delay > 0 {
+		return reconcile.Result{RequeueAfter: delay}, nil
+	}
+
+	ref := spec.Token.SecretRef
+	secret, current, err := r.getTokenSecret(ctx, ref)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	config := tokenConfig(spec, current)
+	generated, resp, err := r.newServiceFn(config).Generate(instance.GenerateProviderTokenGenerateOption(rotation, now)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(common.NewAPIError(resp, err), errGenerateToken)
+	}
+
+	secret.Data[ref.Key] = []byte(generated.Token)
+	if err := r.client.Update(ctx, secret); err != nil {
+		// The Secret still holds the current token, so the generated token is never used
+		if revokeErr := r.revokeToken(config, generated.Name, errRevokeGeneratedToken); revokeErr != nil {
+			return reconcile.Result{}, revokeErr
+		}
+		return reconcile.Result{}, errors.Wrap(err, errUpdateTokenSecret)
+	}
+
+	// The new token is stored, record it along with the previous one before revoking it,
+	// so that the new token is never generated twice and the previous one is revoked even if this attempt fails
+	orig := pc.DeepCopyObject().(client.Object) //nolint:forcetypeassert // DeepCopyObject preserves the type
+	status.PendingRevocationTokenName = instance.ProviderTokenToRevoke(rotation, *status)
+	status.LastTokenRotationTime = &metav1.Time{Time: now}
+	status.TokenName = generated.Name
+	if err := r.client.Status().Patch(ctx, pc, client.MergeFrom(orig)); err != nil {
+		// An unrecorded token would never be revoked and the next attempt would generate another one,
+		// so the current token is put back in the Secret and the generated token is revoked,
+		// even when the Secret cannot be restored
+		secret.Data[ref.Key] = current
+		restoreErr := r.client.Update(ctx, secret)
+		if revokeErr := r.revokeToken(config, generated.Name, errRevokeGeneratedToken); revokeErr != nil {
+			return reconcile.Result{}, revokeErr
+		}
+		if restoreErr != nil {
+			return reconcile.Result{}, errors.Wrap(restoreErr, errRestoreTokenSecret)
+		}
+		return reconcile.Result{}, errors.Wrap(err, errUpdateRotationStatus)
+	}
+
+	if status.PendingRevocationTokenName != "" {
+		if err := r.revokePendingToken(ctx, pc, spec, status); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	log.Debug("Rotated token", "token", generated.Name)
+	r.record.Event(pc, event.Normal(reasonRotatedToken, "Rotated token, now using "+generated.Name))
+	return reconcile.Result{RequeueAfter: rotation.Interval.Duration}, nil
+}
+
+// getTokenSecret returns the Secret holding the token of the ProviderConfig, along with the token.
+func (r *tokenRotationReconciler) getTokenSecret(ctx context.Context, ref *xpv1.SecretKeySelector) (*corev1.Secret, []byte, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, secret); err != nil {
+		return nil, nil, errors.Wrap(err, errGetTokenSecret)
+	}
+	token, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, nil, errors.New(errTokenSecretKeyNotSet)
+	}
+	return secret, token, nil
+}
+
+// tokenConfig returns the configuration of a SonarQube client authenticating with the given token.
+func tokenConfig(spec *v1alpha1.ProviderConfigSpec, token []byte) common.Config {
+	return common.Config{
+		AuthType:           common.PersonalAccessToken,
+		BaseURL:            spec.BaseURL,
+		InsecureSkipVerify: ptr.Deref(spec.InsecureSkipVerify, false),
+		Token:              string(token),
+	}
+}
+
+// revokePendingToken revokes the token replaced by the last rotation with the token held by the Secret,
+// then forgets it once it is revoked.
+func (r *tokenRotationReconciler) revokePendingToken(ctx context.Context, pc client.Object, spec *v1alpha1.ProviderConfigSpec, status *v1alpha1.ProviderConfigStatus) error {
+	_, current, err := r.getTokenSecret(ctx, spec.Token.SecretRef)
+	if err != nil {
+		return err
+	}
+	if err := r.revokeToken(tokenConfig(spec, current), status.PendingRevocationTokenName, errRevokeToken); err != nil {
+		r.record.Event(pc, event.Warning(reasonRotatedToken, err))
+		return err
+	}
+
+	orig := pc.DeepCopyObject().(client.Object) //nolint:forcetypeassert // DeepCopyObject preserves the type
+	status.PendingRevocationTokenName = ""
+	if err := r.client.Status().Patch(ctx, pc, client.MergeFrom(orig)); err != nil {
+		return errors.Wrap(err, errUpdateRotationStatus)
+	}
+	return nil
+}
+
+// revokeToken revokes the named token, which may already be gone.
+func (r *tokenRotationReconciler) revokeToken(config common.Config, name, message string) error {
+	resp, err := r.newServiceFn(config).Revoke(instance.GenerateUserTokenRevokeOption(nil, name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	// A token that is already gone has nothing left to revoke
+	if err != nil && !common.IsNotFound(common.NewAPIError(resp, err)) {
+		return errors.Wrap(common.NewAPIError(resp, err), message)
+	}
+	return nil
+}
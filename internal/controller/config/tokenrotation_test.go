@@ -0,0 +1,452 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+var fixedNow = time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+type providerConfigModifier func(*v1alpha1.ProviderConfigSpec, *v1alpha1.ProviderConfigStatus)
+
+func withRotation(rotation *v1alpha1.TokenRotation) providerConfigModifier {
+	return func(spec *v1alpha1.ProviderConfigSpec, _ *v1alpha1.ProviderConfigStatus) {
+		spec.TokenRotation = rotation
+	}
+}
+
+func withLastRotation(at time.Time, tokenName string) providerConfigModifier {
+	return func(_ *v1alpha1.ProviderConfigSpec, status *v1alpha1.ProviderConfigStatus) {
+		status.LastTokenRotationTime = &metav1.Time{Time: at}
+		status.TokenName = tokenName
+	}
+}
+
+func withPendingRevocation(tokenName string) providerConfigModifier {
+	return func(_ *v1alpha1.ProviderConfigSpec, status *v1alpha1.ProviderConfigStatus) {
+		status.PendingRevocationTokenName = tokenName
+	}
+}
+
+func newProviderConfigSpec(m ...providerConfigModifier) (v1alpha1.ProviderConfigSpec, v1alpha1.ProviderConfigStatus) {
+	spec := v1alpha1.ProviderConfigSpec{
+		BaseURL: "https://sonarqube.example.com",
+		Token: &v1alpha1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				SecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "sonarqube-token", Namespace: "crossplane-system"},
+					Key:             "token",
+				},
+			},
+		},
+	}
+	status := v1alpha1.ProviderConfigStatus{}
+	for _, f := range m {
+		f(&spec, &status)
+	}
+	return spec, status
+}
+
+func newProviderConfig(m ...providerConfigModifier) *v1alpha1.ProviderConfig {
+	spec, status := newProviderConfigSpec(m...)
+	return &v1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team-a"},
+		Spec:       spec,
+		Status:     status,
+	}
+}
+
+func newClusterProviderConfig(m ...providerConfigModifier) *v1alpha1.ClusterProviderConfig {
+	spec, status := newProviderConfigSpec(m...)
+	return &v1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       spec,
+		Status:     status,
+	}
+}
+
+func newTokenSecret(token string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonarqube-token", Namespace: "crossplane-system"},
+		Data:       map[string][]byte{"token": []byte(token)},
+	}
+}
+
+func newKubeClient(objs ...client.Object) client.Client {
+	return newInterceptedKubeClient(interceptor.Funcs{}, objs...)
+}
+
+// newInterceptedKubeClient returns a fake client whose calls can be intercepted to make them fail
+func newInterceptedKubeClient(funcs interceptor.Funcs, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = v1alpha1.SchemeBuilder.AddToScheme(scheme)
+	return fakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.ProviderConfig{}, &v1alpha1.ClusterProviderConfig{}).
+		WithInterceptorFuncs(funcs).
+		Build()
+}
+
+// tokenService records the token used by each call to the SonarQube API
+type tokenService struct {
+	calls      []string
+	generateFn func(opt *sonargo.UserTokensGenerateOption) (*sonargo.UserTokensGenerateObject, *http.Response, error)
+	revokeFn   func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error)
+}
+
+func (s *tokenService) newServiceFn(config common.Config) instance.UserTokensClient {
+	return &fake.MockUserTokensClient{
+		GenerateFn: func(opt *sonargo.UserTokensGenerateOption) (*sonargo.UserTokensGenerateObject, *http.Response, error) {
+			s.calls = append(s.calls, "Generate "+opt.Name+" with "+config.Token)
+			if s.generateFn != nil {
+				return s.generateFn(opt)
+			}
+			return &sonargo.UserTokensGenerateObject{Name: opt.Name, Token: "squ_new"}, nil, nil
+		},
+		RevokeFn: func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error) {
+			s.calls = append(s.calls, "Revoke "+opt.Name+" with "+config.Token)
+			if s.revokeFn != nil {
+				return s.revokeFn(opt)
+			}
+			return nil, nil
+		},
+	}
+}
+
+func TestTokenRotationReconcile(t *testing.T) {
+	daily := &v1alpha1.TokenRotation{Interval: metav1.Duration{Duration: 24 * time.Hour}, TokenNamePrefix: ptr.To("admin")}
+	dailyFromBootstrap := &v1alpha1.TokenRotation{Interval: metav1.Duration{Duration: 24 * time.Hour}, TokenNamePrefix: ptr.To("admin"), InitialTokenName: ptr.To("bootstrap")}
+
+	type want struct {
+		result     reconcile.Result
+		err        error
+		calls      []string
+		token      string
+		lastRotate *metav1.Time
+		tokenName  string
+		pending    string
+	}
+
+	tests := map[string]struct {
+		service   *tokenService
+		kube      client.Client
+		newConfig func() client.Object
+		want      want
+	}{
+		"ProviderConfigNotFound": {
+			service:   &tokenService{},
+			kube:      newKubeClient(),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want:      want{},
+		},
+		"RotationNotEnabled": {
+			service:   &tokenService{},
+			kube:      newKubeClient(newProviderConfig(), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				token: "squ_old",
+			},
+		},
+		"RotationNotDue": {
+			service:   &tokenService{},
+			kube:      newKubeClient(newProviderConfig(withRotation(daily), withLastRotation(fixedNow.Add(-20*time.Hour), "admin-20260309-160000")), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				result:     reconcile.Result{RequeueAfter: 4 * time.Hour},
+				token:      "squ_old",
+				lastRotate: &metav1.Time{Time: fixedNow.Add(-20 * time.Hour)},
+				tokenName:  "admin-20260309-160000",
+			},
+		},
+		"FirstRotationRevokesInitialToken": {
+			service:   &tokenService{},
+			kube:      newKubeClient(newProviderConfig(withRotation(dailyFromBootstrap)), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				result:     reconcile.Result{RequeueAfter: 24 * time.Hour},
+				calls:      []string{"Generate admin-20260310-120000 with squ_old", "Revoke bootstrap with squ_new"},
+				token:      "squ_new",
+				lastRotate: &metav1.Time{Time: fixedNow},
+				tokenName:  "admin-20260310-120000",
+			},
+		},
+		"FirstRotationWithoutInitialTokenName": {
+			service:   &tokenService{},
+			kube:      newKubeClient(newProviderConfig(withRotation(daily)), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				result:     reconcile.Result{RequeueAfter: 24 * time.Hour},
+				calls:      []string{"Generate admin-20260310-120000 with squ_old"},
+				token:      "squ_new",
+				lastRotate: &metav1.Time{Time: fixedNow},
+				tokenName:  "admin-20260310-120000",
+			},
+		},
+		"ClusterProviderConfigRevokesPreviousToken": {
+			service: &tokenService{
+				revokeFn: func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			kube:      newKubeClient(newClusterProviderConfig(withRotation(dailyFromBootstrap), withLastRotation(fixedNow.Add(-25*time.Hour), "admin-20260309-110000")), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ClusterProviderConfig{} },
+			want: want{
+				result:     reconcile.Result{RequeueAfter: 24 * time.Hour},
+				calls:      []string{"Generate admin-20260310-120000 with squ_old", "Revoke admin-20260309-110000 with squ_new"},
+				token:      "squ_new",
+				lastRotate: &metav1.Time{Time: fixedNow},
+				tokenName:  "admin-20260310-120000",
+			},
+		},
+		"MissingSecret": {
+			service:   &tokenService{},
+			kube:      newKubeClient(newProviderConfig(withRotation(daily))),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "sonarqube-token"), errGetTokenSecret),
+			},
+		},
+		"GenerateFails": {
+			service: &tokenService{
+				generateFn: func(opt *sonargo.UserTokensGenerateOption) (*sonargo.UserTokensGenerateObject, *http.Response, error) {
+					return nil, nil, errors.New("boom")
+				},
+			},
+			kube:      newKubeClient(newProviderConfig(withRotation(dailyFromBootstrap)), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err:   errors.Wrap(common.NewAPIError(nil, errors.New("boom")), errGenerateToken),
+				calls: []string{"Generate admin-20260310-120000 with squ_old"},
+				token: "squ_old",
+			},
+		},
+		"RevokeFailsAfterRecordingRotation": {
+			service: &tokenService{
+				revokeFn: func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			kube:      newKubeClient(newProviderConfig(withRotation(dailyFromBootstrap)), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err:        errors.Wrap(common.NewAPIError(nil, errors.New("boom")), errRevokeToken),
+				calls:      []string{"Generate admin-20260310-120000 with squ_old", "Revoke bootstrap with squ_new"},
+				token:      "squ_new",
+				lastRotate: &metav1.Time{Time: fixedNow},
+				tokenName:  "admin-20260310-120000",
+				pending:    "bootstrap",
+			},
+		},
+		"PendingRevocationIsRetried": {
+			service:   &tokenService{},
+			kube:      newKubeClient(newProviderConfig(withRotation(daily), withLastRotation(fixedNow.Add(-20*time.Hour), "admin-20260309-160000"), withPendingRevocation("bootstrap")), newTokenSecret("squ_new")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				result:     reconcile.Result{RequeueAfter: 4 * time.Hour},
+				calls:      []string{"Revoke bootstrap with squ_new"},
+				token:      "squ_new",
+				lastRotate: &metav1.Time{Time: fixedNow.Add(-20 * time.Hour)},
+				tokenName:  "admin-20260309-160000",
+			},
+		},
+		"PendingRevocationFailureBlocksRotation": {
+			service: &tokenService{
+				revokeFn: func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			kube:      newKubeClient(newProviderConfig(withRotation(daily), withLastRotation(fixedNow.Add(-25*time.Hour), "admin-20260309-110000"), withPendingRevocation("bootstrap")), newTokenSecret("squ_new")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err:        errors.Wrap(common.NewAPIError(nil, errors.New("boom")), errRevokeToken),
+				calls:      []string{"Revoke bootstrap with squ_new"},
+				token:      "squ_new",
+				lastRotate: &metav1.Time{Time: fixedNow.Add(-25 * time.Hour)},
+				tokenName:  "admin-20260309-110000",
+				pending:    "bootstrap",
+			},
+		},
+		"SecretUpdateFailsRevokesGeneratedToken": {
+			service: &tokenService{},
+			kube: newInterceptedKubeClient(interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					return errors.New("boom")
+				},
+			}, newProviderConfig(withRotation(dailyFromBootstrap)), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err:   errors.Wrap(errors.New("boom"), errUpdateTokenSecret),
+				calls: []string{"Generate admin-20260310-120000 with squ_old", "Revoke admin-20260310-120000 with squ_old"},
+				token: "squ_old",
+			},
+		},
+		"StatusPatchFailsRestoresSecretAndRevokesGeneratedToken": {
+			service: &tokenService{},
+			kube: newInterceptedKubeClient(interceptor.Funcs{
+				SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+					return errors.New("boom")
+				},
+			}, newProviderConfig(withRotation(dailyFromBootstrap)), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err:   errors.Wrap(errors.New("boom"), errUpdateRotationStatus),
+				calls: []string{"Generate admin-20260310-120000 with squ_old", "Revoke admin-20260310-120000 with squ_old"},
+				token: "squ_old",
+			},
+		},
+		"StatusPatchAndSecretRestoreFailRevokesGeneratedToken": {
+			service: &tokenService{},
+			kube: func() client.Client {
+				updates := 0
+				return newInterceptedKubeClient(interceptor.Funcs{
+					Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+						// Only the rotation is stored, the restoration of the current token fails
+						if updates++; updates > 1 {
+							return errors.New("restore boom")
+						}
+						return c.Update(ctx, obj, opts...)
+					},
+					SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						return errors.New("boom")
+					},
+				}, newProviderConfig(withRotation(dailyFromBootstrap)), newTokenSecret("squ_old"))
+			}(),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err:   errors.Wrap(errors.New("restore boom"), errRestoreTokenSecret),
+				calls: []string{"Generate admin-20260310-120000 with squ_old", "Revoke admin-20260310-120000 with squ_old"},
+				token: "squ_new",
+			},
+		},
+		"StatusPatchAndRevocationFail": {
+			service: &tokenService{
+				revokeFn: func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error) {
+					return nil, errors.New("revoke boom")
+				},
+			},
+			kube: newInterceptedKubeClient(interceptor.Funcs{
+				SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+					return errors.New("boom")
+				},
+			}, newProviderConfig(withRotation(dailyFromBootstrap)), newTokenSecret("squ_old")),
+			newConfig: func() client.Object { return &v1alpha1.ProviderConfig{} },
+			want: want{
+				err:   errors.Wrap(common.NewAPIError(nil, errors.New("revoke boom")), errRevokeGeneratedToken),
+				calls: []string{"Generate admin-20260310-120000 with squ_old", "Revoke admin-20260310-120000 with squ_old"},
+				token: "squ_old",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &tokenRotationReconciler{
+				client:       tc.kube,
+				newConfig:    tc.newConfig,
+				newServiceFn: tc.service.newServiceFn,
+				now:          func() time.Time { return fixedNow },
+				log:          logging.NewNopLogger(),
+				record:       event.NewNopRecorder(),
+			}
+			key := types.NamespacedName{Name: "default"}
+			if _, ok := tc.newConfig().(*v1alpha1.ProviderConfig); ok {
+				key.Namespace = "team-a"
+			}
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Reconcile(...): -want result, +got result:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.service.calls); diff != "" {
+				t.Errorf("Reconcile(...): -want calls, +got calls:\n%s", diff)
+			}
+
+			secret := &corev1.Secret{}
+			if err := tc.kube.Get(context.Background(), types.NamespacedName{Name: "sonarqube-token", Namespace: "crossplane-system"}, secret); err == nil {
+				if diff := cmp.Diff(tc.want.token, string(secret.Data["token"])); diff != "" {
+					t.Errorf("Reconcile(...): -want token, +got token:\n%s", diff)
+				}
+			}
+
+			pc := tc.newConfig()
+			if err := tc.kube.Get(context.Background(), key, pc); err == nil {
+				_, status, _ := providerConfigSpecAndStatus(pc)
+				if diff := cmp.Diff(tc.want.lastRotate, status.LastTokenRotationTime); diff != "" {
+					t.Errorf("Reconcile(...): -want last rotation, +got last rotation:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.tokenName, status.TokenName); diff != "" {
+					t.Errorf("Reconcile(...): -want token name, +got token name:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.pending, status.PendingRevocationTokenName); diff != "" {
+					t.Errorf("Reconcile(...): -want pending revocation, +got pending revocation:\n%s", diff)
+				}
+			}
+		})
+	}
+}
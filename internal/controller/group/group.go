@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotGroup     = "managed resource is not a Group custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errCreateGroup = "cannot create SonarQube Group"
+	errSearchGroup = "cannot get SonarQube Group"
+	errUpdateGroup = "cannot update SonarQube Group"
+	errDeleteGroup = "cannot delete SonarQube Group"
+)
+
+// SetupGated adds a controller that reconciles Group managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup Group controller"))
+		}
+	}, v1alpha1.GroupGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.GroupGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewUserGroupsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.GroupList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.GroupList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.GroupGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Group{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.UserGroupsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return nil, errors.New(errNotGroup)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{userGroupsClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// userGroupsClient is used to interact with SonarQube User Groups API
+	userGroupsClient instance.UserGroupsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroup)
+	}
+
+	// The external name is the name of the Group
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	group, err := c.searchGroup(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The Group may have been renamed by a previous Update, whose new external name is only persisted from Observe
+	renamed := false
+	if group == nil && cr.Spec.ForProvider.Name != "" && cr.Spec.ForProvider.Name != externalName {
+		group, err = c.searchGroup(cr.Spec.ForProvider.Name)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if group != nil {
+			meta.SetExternalName(cr, group.Name)
+			renamed = true
+		}
+	}
+	if group == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateGroupObservation(group)
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeGroup(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsGroupUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider) || renamed,
+	}, nil
+}
+
+// searchGroup retrieves the Group with the given name, or nil if there is none
+func (c *external) searchGroup(name string) (*sonargo.UserGroupsSearchObject_sub1, error) {
+	groups, resp, err := c.userGroupsClient.Search(instance.GenerateGroupSearchOption(name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchGroup)
+	}
+	return instance.FindGroup(groups, name), nil
+}
+
+// Create creates the external resource and sets the external name
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	group, resp, err := c.userGroupsClient.Create(instance.GenerateGroupCreateOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateGroup)
+	}
+
+	// Set the external name to the name of the created Group
+	meta.SetExternalName(cr, group.Group.Name)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update updates the external resource to match the desired state of the managed resource
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroup)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for Group %s", cr.Name)
+	}
+
+	// The name and the description are updated at once, renaming the Group if needed
+	resp, err := c.userGroupsClient.Update(instance.GenerateGroupUpdateOption(externalName, cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errUpdateGroup)
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the Group, which removes all its memberships
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	resp, err := c.userGroupsClient.Delete(instance.GenerateGroupDeleteOption(externalName)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Group is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteGroup)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notGroup struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newGroup(externalName string, params v1alpha1.GroupParameters) *v1alpha1.Group {
+	g := &v1alpha1.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-group",
+			Namespace:   "default",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.GroupSpec{
+			ForProvider: params,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(g, externalName)
+	}
+	return g
+}
+
+func searchReturning(groups ...sonargo.UserGroupsSearchObject_sub1) func(opt *sonargo.UserGroupsSearchOption) (*sonargo.UserGroupsSearchObject, *http.Response, error) {
+	return func(opt *sonargo.UserGroupsSearchOption) (*sonargo.UserGroupsSearchObject, *http.Response, error) {
+		return &sonargo.UserGroupsSearchObject{Groups: groups}, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		observation  v1alpha1.GroupObservation
+		externalName string
+		err          error
+	}
+
+	developers := sonargo.UserGroupsSearchObject_sub1{Name: "developers", Description: "All the developers", MembersCount: 3}
+	params := v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("All the developers")}
+
+	cases := map[string]struct {
+		client *fake.MockUserGroupsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotGroupError": {
+			client: &fake.MockUserGroupsClient{},
+			mg:     &notGroup{},
+			want: want{
+				err: errors.New(errNotGroup),
+			},
+		},
+		"EmptyExternalNameReturnsNotExists": {
+			client: &fake.MockUserGroupsClient{},
+			mg:     newGroup("", params),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchFailsReturnsError": {
+			client: &fake.MockUserGroupsClient{
+				SearchFn: func(opt *sonargo.UserGroupsSearchOption) (*sonargo.UserGroupsSearchObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newGroup("developers", params),
+			want: want{
+				err:          errors.Wrap(errors.New("forbidden"), errSearchGroup),
+				externalName: "developers",
+			},
+		},
+		"MissingGroupReturnsNotExists": {
+			client: &fake.MockUserGroupsClient{
+				SearchFn: searchReturning(sonargo.UserGroupsSearchObject_sub1{Name: "developers-admin"}),
+			},
+			mg: newGroup("developers", params),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: false},
+				externalName: "developers",
+			},
+		},
+		"UpToDateGroup": {
+			client: &fake.MockUserGroupsClient{
+				SearchFn: searchReturning(developers),
+			},
+			mg: newGroup("developers", params),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation:  v1alpha1.GroupObservation{Name: "developers", Description: "All the developers", MembersCount: 3},
+				externalName: "developers",
+			},
+		},
+		"DescriptionIsLateInitialized": {
+			client: &fake.MockUserGroupsClient{
+				SearchFn: searchReturning(developers),
+			},
+			mg: newGroup("developers", v1alpha1.GroupParameters{Name: "developers"}),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				observation:  v1alpha1.GroupObservation{Name: "developers", Description: "All the developers", MembersCount: 3},
+				externalName: "developers",
+			},
+		},
+		"RenamedGroupIsFoundByName": {
+			client: &fake.MockUserGroupsClient{
+				SearchFn: func(opt *sonargo.UserGroupsSearchOption) (*sonargo.UserGroupsSearchObject, *http.Response, error) {
+					if opt.Q == "developers" {
+						return &sonargo.UserGroupsSearchObject{Groups: []sonargo.UserGroupsSearchObject_sub1{developers}}, nil, nil
+					}
+					return &sonargo.UserGroupsSearchObject{}, nil, nil
+				},
+			},
+			mg: newGroup("engineers", params),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				observation:  v1alpha1.GroupObservation{Name: "developers", Description: "All the developers", MembersCount: 3},
+				externalName: "developers",
+			},
+		},
+		"DescriptionDriftIsNotUpToDate": {
+			client: &fake.MockUserGroupsClient{
+				SearchFn: searchReturning(developers),
+			},
+			mg: newGroup("developers", v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("Developers")}),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation:  v1alpha1.GroupObservation{Name: "developers", Description: "All the developers", MembersCount: 3},
+				externalName: "developers",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{userGroupsClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Group); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Observe() external name mismatch (-want +got):\n%s", diff)
+				}
+				if got.ResourceExists {
+					if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+						t.Errorf("Observe() status mismatch (-want +got):\n%s", diff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		create error
+		want   want
+	}{
+		"NotGroupError": {
+			mg: &notGroup{},
+			want: want{
+				err: errors.New(errNotGroup),
+			},
+		},
+		"CreateFails": {
+			mg:     newGroup("", v1alpha1.GroupParameters{Name: "developers"}),
+			create: errors.New("create error"),
+			want: want{
+				err: errors.Wrap(errors.New("create error"), errCreateGroup),
+			},
+		},
+		"SuccessfulCreateSetsExternalName": {
+			mg: newGroup("", v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("All the developers")}),
+			want: want{
+				externalName: "developers",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{userGroupsClient: &fake.MockUserGroupsClient{
+				CreateFn: func(opt *sonargo.UserGroupsCreateOption) (*sonargo.UserGroupsCreateObject, *http.Response, error) {
+					if tc.create != nil {
+						return nil, nil, tc.create
+					}
+					return &sonargo.UserGroupsCreateObject{Group: sonargo.UserGroupsCreateObject_sub1{Name: opt.Name, Description: opt.Description}}, nil, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Group); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Create() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		externalName string
+		option       *instance.UserGroupsUpdateOption
+		err          error
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		update error
+		want   want
+	}{
+		"NotGroupError": {
+			mg: &notGroup{},
+			want: want{
+				err: errors.New(errNotGroup),
+			},
+		},
+		"MissingExternalNameError": {
+			mg: newGroup("", v1alpha1.GroupParameters{Name: "developers"}),
+			want: want{
+				err: fmt.Errorf("external name is not set for Group test-group"),
+			},
+		},
+		"DescriptionIsUpdated": {
+			mg: newGroup("developers", v1alpha1.GroupParameters{Name: "developers", Description: ptr.To("Developers")}),
+			want: want{
+				externalName: "developers",
+				option:       &instance.UserGroupsUpdateOption{CurrentName: "developers", Description: ptr.To("Developers")},
+			},
+		},
+		"RenameSetsExternalName": {
+			mg: newGroup("engineers", v1alpha1.GroupParameters{Name: "developers"}),
+			want: want{
+				externalName: "developers",
+				option:       &instance.UserGroupsUpdateOption{CurrentName: "engineers", Name: "developers"},
+			},
+		},
+		"UpdateFails": {
+			mg:     newGroup("engineers", v1alpha1.GroupParameters{Name: "developers"}),
+			update: errors.New("update error"),
+			want: want{
+				externalName: "engineers",
+				option:       &instance.UserGroupsUpdateOption{CurrentName: "engineers", Name: "developers"},
+				err:          errors.Wrap(errors.New("update error"), errUpdateGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent *instance.UserGroupsUpdateOption
+			e := &external{userGroupsClient: &fake.MockUserGroupsClient{
+				UpdateFn: func(opt *instance.UserGroupsUpdateOption) (*http.Response, error) {
+					sent = opt
+					return nil, tc.update
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Update() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.option, sent); diff != "" {
+				t.Errorf("Update() option mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Group); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Update() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		delete *http.Response
+		err    error
+		want   want
+	}{
+		"NotGroupError": {
+			mg: &notGroup{},
+			want: want{
+				err: errors.New(errNotGroup),
+			},
+		},
+		"NeverCreatedDoesNothing": {
+			mg: newGroup("", v1alpha1.GroupParameters{Name: "developers"}),
+		},
+		"SuccessfulDelete": {
+			mg: newGroup("developers", v1alpha1.GroupParameters{Name: "developers"}),
+			want: want{
+				calls: []string{"Delete:developers"},
+			},
+		},
+		"AlreadyDeletedIsIgnored": {
+			mg:     newGroup("developers", v1alpha1.GroupParameters{Name: "developers"}),
+			delete: &http.Response{StatusCode: http.StatusNotFound},
+			err:    errors.New("not found"),
+			want: want{
+				calls: []string{"Delete:developers"},
+			},
+		},
+		"DeleteFails": {
+			mg:     newGroup("developers", v1alpha1.GroupParameters{Name: "developers"}),
+			delete: &http.Response{StatusCode: http.StatusBadRequest},
+			err:    errors.New("default group cannot be deleted"),
+			want: want{
+				calls: []string{"Delete:developers"},
+				err:   errors.Wrap(errors.New("default group cannot be deleted"), errDeleteGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{userGroupsClient: &fake.MockUserGroupsClient{
+				DeleteFn: func(opt *sonargo.UserGroupsDeleteOption) (*http.Response, error) {
+					calls = append(calls, "Delete:"+opt.Name)
+					return tc.delete, tc.err
+				},
+			}}
+			_, err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Delete() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupmembership
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotGroupMembership = "managed resource is not a GroupMembership custom resource"
+	errTrackPCUsage       = "cannot track ProviderConfig usage"
+	errGetPC              = "cannot get ProviderConfig"
+
+	errSearchGroup           = "cannot get SonarQube Group"
+	errSearchUser            = "cannot get SonarQube User"
+	errSearchGroupMembership = "cannot get SonarQube Group membership"
+	errCreateGroupMembership = "cannot create SonarQube Group membership"
+	errDeleteGroupMembership = "cannot delete SonarQube Group membership"
+	errGroupNotFound         = "SonarQube Group %s not found"
+	errUserNotFound          = "SonarQube User %s not found"
+)
+
+// SetupGated adds a controller that reconciles GroupMembership managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup GroupMembership controller"))
+		}
+	}, v1alpha1.GroupMembershipGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.GroupMembershipGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewGroupMembershipsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.GroupMembershipList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.GroupMembershipList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.GroupMembershipGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.GroupMembership{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.GroupMembershipsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return nil, errors.New(errNotGroupMembership)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{groupMembershipsClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// groupMembershipsClient is used to interact with SonarQube v2 Group Memberships API
+	groupMembershipsClient instance.GroupMembershipsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroupMembership)
+	}
+
+	// The membership is looked up by its Group and User, as the external name is only known once it exists
+	group, err := c.searchGroup(ptr.Deref(cr.Spec.ForProvider.Group, ""))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	user, err := c.searchUser(cr.Spec.ForProvider.Login)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if group == nil || user == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	memberships, resp, err := c.groupMembershipsClient.Search(instance.GenerateGroupMembershipsSearchOption(group.ID, user.ID)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(resp, err), errSearchGroupMembership)
+	}
+	membership := instance.FindGroupMembership(memberships, group.ID, user.ID)
+	if membership == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The external name is the ID of the membership
+	lateInitialized := false
+	if meta.GetExternalName(cr) != membership.ID {
+		meta.SetExternalName(cr, membership.ID)
+		lateInitialized = true
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateGroupMembershipObservation(membership)
+	cr.Status.SetConditions(xpv1.Available())
+
+	// The Group and the User are immutable, so an existing membership is always up to date
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// searchGroup retrieves the Group with the given name, or nil if there is none
+func (c *external) searchGroup(name string) (*instance.AuthorizationsGroupObject, error) {
+	groups, resp, err := c.groupMembershipsClient.SearchGroups(instance.GenerateAuthorizationsGroupsSearchOption(name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchGroup)
+	}
+	return instance.FindAuthorizationsGroup(groups, name), nil
+}
+
+// searchUser retrieves the active User with the given login, or nil if there is none
+func (c *external) searchUser(login string) (*instance.UsersManagementUserObject, error) {
+	users, resp, err := c.groupMembershipsClient.SearchUsers(instance.GenerateUsersManagementSearchOption(login)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchUser)
+	}
+	return instance.FindUsersManagementUser(users, login), nil
+}
+
+// Create creates the external resource and sets the external name
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroupMembership)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// The Group and the User must exist before the User can be added to the Group
+	name := ptr.Deref(cr.Spec.ForProvider.Group, "")
+	group, err := c.searchGroup(name)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if group == nil {
+		return managed.ExternalCreation{}, errors.Errorf(errGroupNotFound, name)
+	}
+	user, err := c.searchUser(cr.Spec.ForProvider.Login)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if user == nil {
+		return managed.ExternalCreation{}, errors.Errorf(errUserNotFound, cr.Spec.ForProvider.Login)
+	}
+
+	membership, resp, err := c.groupMembershipsClient.Create(instance.GenerateGroupMembershipsCreateOption(group.ID, user.ID)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateGroupMembership)
+	}
+
+	// Set the external name to the ID of the created membership
+	meta.SetExternalName(cr, membership.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update does nothing, as the Group and the User of a membership are immutable
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.GroupMembership); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroupMembership)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete removes the User from the Group
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotGroupMembership)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	id := cr.Status.AtProvider.ID
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	resp, err := c.groupMembershipsClient.Delete(instance.GenerateGroupMembershipsDeleteOption(id)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The membership is already gone, for instance because its Group or its User was deleted
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteGroupMembership)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
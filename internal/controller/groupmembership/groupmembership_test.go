@@ -0,0 +1,344 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupmembership
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notGroupMembership struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newGroupMembership(externalName string) *v1alpha1.GroupMembership {
+	gm := &v1alpha1.GroupMembership{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-groupmembership",
+			Namespace:   "default",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.GroupMembershipSpec{
+			ForProvider: v1alpha1.GroupMembershipParameters{Group: ptr.To("developers"), Login: "jdoe"},
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(gm, externalName)
+	}
+	return gm
+}
+
+// newClient returns a client knowing the developers Group and the jdoe User, and the given memberships
+func newClient(memberships ...instance.GroupMembershipObject) *fake.MockGroupMembershipsClient {
+	return &fake.MockGroupMembershipsClient{
+		SearchGroupsFn: func(opt *instance.AuthorizationsGroupsSearchOption) (*instance.AuthorizationsGroupsSearchObject, *http.Response, error) {
+			return &instance.AuthorizationsGroupsSearchObject{Groups: []instance.AuthorizationsGroupObject{{ID: "g-2", Name: "developers-admin"}, {ID: "g-1", Name: "developers"}}}, nil, nil
+		},
+		SearchUsersFn: func(opt *instance.UsersManagementSearchOption) (*instance.UsersManagementSearchObject, *http.Response, error) {
+			return &instance.UsersManagementSearchObject{Users: []instance.UsersManagementUserObject{{ID: "u-1", Login: "jdoe"}}}, nil, nil
+		},
+		SearchFn: func(opt *instance.GroupMembershipsSearchOption) (*instance.GroupMembershipsSearchObject, *http.Response, error) {
+			if opt.GroupID != "g-1" || opt.UserID != "u-1" {
+				return nil, &http.Response{StatusCode: http.StatusBadRequest}, errors.New("unexpected search")
+			}
+			return &instance.GroupMembershipsSearchObject{GroupMemberships: memberships}, nil, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		observation  v1alpha1.GroupMembershipObservation
+		externalName string
+		err          error
+	}
+
+	missingUser := newClient()
+	missingUser.SearchUsersFn = func(opt *instance.UsersManagementSearchOption) (*instance.UsersManagementSearchObject, *http.Response, error) {
+		return &instance.UsersManagementSearchObject{}, nil, nil
+	}
+	failingSearch := newClient()
+	failingSearch.SearchGroupsFn = func(opt *instance.AuthorizationsGroupsSearchOption) (*instance.AuthorizationsGroupsSearchObject, *http.Response, error) {
+		return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+	}
+
+	cases := map[string]struct {
+		client *fake.MockGroupMembershipsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotGroupMembershipError": {
+			client: newClient(),
+			mg:     &notGroupMembership{},
+			want: want{
+				err: errors.New(errNotGroupMembership),
+			},
+		},
+		"SearchGroupFailsReturnsError": {
+			client: failingSearch,
+			mg:     newGroupMembership(""),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errSearchGroup),
+			},
+		},
+		"MissingUserReturnsNotExists": {
+			client: missingUser,
+			mg:     newGroupMembership(""),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MissingMembershipReturnsNotExists": {
+			client: newClient(instance.GroupMembershipObject{ID: "m-2", GroupID: "g-1", UserID: "u-2"}),
+			mg:     newGroupMembership("test-groupmembership"),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: false},
+				externalName: "test-groupmembership",
+			},
+		},
+		"ExistingMembershipSetsExternalName": {
+			client: newClient(instance.GroupMembershipObject{ID: "m-1", GroupID: "g-1", UserID: "u-1"}),
+			mg:     newGroupMembership("test-groupmembership"),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				observation:  v1alpha1.GroupMembershipObservation{ID: "m-1", GroupID: "g-1", UserID: "u-1"},
+				externalName: "m-1",
+			},
+		},
+		"ExistingMembershipIsUpToDate": {
+			client: newClient(instance.GroupMembershipObject{ID: "m-1", GroupID: "g-1", UserID: "u-1"}),
+			mg:     newGroupMembership("m-1"),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation:  v1alpha1.GroupMembershipObservation{ID: "m-1", GroupID: "g-1", UserID: "u-1"},
+				externalName: "m-1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{groupMembershipsClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.GroupMembership); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Observe() external name mismatch (-want +got):\n%s", diff)
+				}
+				if got.ResourceExists {
+					if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+						t.Errorf("Observe() status mismatch (-want +got):\n%s", diff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		calls        []string
+		err          error
+	}
+
+	missingGroup := func(c *fake.MockGroupMembershipsClient) {
+		c.SearchGroupsFn = func(opt *instance.AuthorizationsGroupsSearchOption) (*instance.AuthorizationsGroupsSearchObject, *http.Response, error) {
+			return &instance.AuthorizationsGroupsSearchObject{}, nil, nil
+		}
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		setup  func(c *fake.MockGroupMembershipsClient)
+		create error
+		want   want
+	}{
+		"NotGroupMembershipError": {
+			mg: &notGroupMembership{},
+			want: want{
+				err: errors.New(errNotGroupMembership),
+			},
+		},
+		"MissingGroupError": {
+			mg:    newGroupMembership(""),
+			setup: missingGroup,
+			want: want{
+				err: errors.Errorf(errGroupNotFound, "developers"),
+			},
+		},
+		"CreateFails": {
+			mg:     newGroupMembership(""),
+			create: errors.New("create error"),
+			want: want{
+				calls: []string{"Create:g-1/u-1"},
+				err:   errors.Wrap(errors.New("create error"), errCreateGroupMembership),
+			},
+		},
+		"SuccessfulCreateSetsExternalName": {
+			mg: newGroupMembership(""),
+			want: want{
+				externalName: "m-1",
+				calls:        []string{"Create:g-1/u-1"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			client := newClient()
+			client.CreateFn = func(opt *instance.GroupMembershipsCreateOption) (*instance.GroupMembershipObject, *http.Response, error) {
+				calls = append(calls, "Create:"+opt.GroupID+"/"+opt.UserID)
+				if tc.create != nil {
+					return nil, nil, tc.create
+				}
+				return &instance.GroupMembershipObject{ID: "m-1", GroupID: opt.GroupID, UserID: opt.UserID}, nil, nil
+			}
+			if tc.setup != nil {
+				tc.setup(client)
+			}
+			e := &external{groupMembershipsClient: client}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Create() calls mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.GroupMembership); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Create() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		id     string
+		delete *http.Response
+		err    error
+		want   want
+	}{
+		"NotGroupMembershipError": {
+			mg: &notGroupMembership{},
+			want: want{
+				err: errors.New(errNotGroupMembership),
+			},
+		},
+		"NeverObservedDoesNothing": {
+			mg: newGroupMembership(""),
+		},
+		"SuccessfulDelete": {
+			mg: newGroupMembership("m-1"),
+			id: "m-1",
+			want: want{
+				calls: []string{"Delete:m-1"},
+			},
+		},
+		"AlreadyDeletedIsIgnored": {
+			mg:     newGroupMembership("m-1"),
+			id:     "m-1",
+			delete: &http.Response{StatusCode: http.StatusNotFound},
+			err:    errors.New("not found"),
+			want: want{
+				calls: []string{"Delete:m-1"},
+			},
+		},
+		"DeleteFails": {
+			mg:     newGroupMembership("m-1"),
+			id:     "m-1",
+			delete: &http.Response{StatusCode: http.StatusForbidden},
+			err:    errors.New("forbidden"),
+			want: want{
+				calls: []string{"Delete:m-1"},
+				err:   errors.Wrap(errors.New("forbidden"), errDeleteGroupMembership),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{groupMembershipsClient: &fake.MockGroupMembershipsClient{
+				DeleteFn: func(opt *instance.GroupMembershipsDeleteOption) (*http.Response, error) {
+					calls = append(calls, "Delete:"+opt.ID)
+					return tc.delete, tc.err
+				},
+			}}
+			if cr, ok := tc.mg.(*v1alpha1.GroupMembership); ok {
+				cr.Status.AtProvider.ID = tc.id
+			}
+			_, err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Delete() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
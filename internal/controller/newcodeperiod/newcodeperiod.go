@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package newcodeperiod
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotNewCodePeriod = "managed resource is not a NewCodePeriod custom resource"
+	errTrackPCUsage     = "cannot track ProviderConfig usage"
+	errGetPC            = "cannot get ProviderConfig"
+
+	errGetNewCodePeriod   = "cannot get SonarQube new code definition"
+	errSetNewCodePeriod   = "cannot set SonarQube new code definition"
+	errUnsetNewCodePeriod = "cannot unset SonarQube new code definition"
+)
+
+// SetupGated adds a controller that reconciles NewCodePeriod managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup NewCodePeriod controller"))
+		}
+	}, v1alpha1.NewCodePeriodGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.NewCodePeriodGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewNewCodePeriodsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.NewCodePeriodList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.NewCodePeriodList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.NewCodePeriodGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.NewCodePeriod{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.NewCodePeriodsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NewCodePeriod)
+	if !ok {
+		return nil, errors.New(errNotNewCodePeriod)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{newCodePeriodsClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// newCodePeriodsClient is used to interact with SonarQube New Code Periods API
+	newCodePeriodsClient instance.NewCodePeriodsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NewCodePeriod)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNewCodePeriod)
+	}
+
+	period, resp, err := c.newCodePeriodsClient.Show(instance.GenerateNewCodePeriodShowOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Project or the branch the new code definition applies to is gone, and so is the definition
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetNewCodePeriod)
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateNewCodePeriodObservation(period)
+
+	// Once unset, the new code definition is inherited again, which completes its deletion
+	if meta.WasDeleted(cr) && instance.IsNewCodePeriodUnset(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: instance.IsNewCodePeriodUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+	}, nil
+}
+
+// Create sets the new code definition
+// A definition is always in effect, inherited if need be, so that Create is only reached when the Project or the branch is not found
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NewCodePeriod)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNewCodePeriod)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.setNewCodePeriod(&cr.Spec.ForProvider)
+}
+
+// Update defines the new code in the scope with the desired type and value
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NewCodePeriod)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNewCodePeriod)
+	}
+
+	return managed.ExternalUpdate{}, c.setNewCodePeriod(&cr.Spec.ForProvider)
+}
+
+// setNewCodePeriod sets the type and the value of the new code definition
+func (c *external) setNewCodePeriod(spec *v1alpha1.NewCodePeriodParameters) error {
+	resp, err := c.newCodePeriodsClient.Set(instance.GenerateNewCodePeriodSetOption(*spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return errors.Wrap(common.NewAPIError(resp, err), errSetNewCodePeriod)
+	}
+	return nil
+}
+
+// Delete unsets the new code definition, so that it is inherited again
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.NewCodePeriod)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotNewCodePeriod)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	resp, err := c.newCodePeriodsClient.Unset(instance.GenerateNewCodePeriodUnsetOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Project or the branch the new code definition applies to is already gone, and so is the definition
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errUnsetNewCodePeriod)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package newcodeperiod
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notNewCodePeriod struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newNewCodePeriod(params v1alpha1.NewCodePeriodParameters) *v1alpha1.NewCodePeriod {
+	return &v1alpha1.NewCodePeriod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-new-code-period",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.NewCodePeriodSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func showReturning(period instance.NewCodePeriodsShowObject) func(opt *sonargo.NewCodePeriodsShowOption) (*instance.NewCodePeriodsShowObject, *http.Response, error) {
+	return func(opt *sonargo.NewCodePeriodsShowOption) (*instance.NewCodePeriodsShowObject, *http.Response, error) {
+		return &period, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.NewCodePeriodObservation
+		err         error
+	}
+
+	projectParams := v1alpha1.NewCodePeriodParameters{
+		Scope:      ptr.To(v1alpha1.NewCodePeriodScopeProject),
+		ProjectKey: ptr.To("my-project"),
+		Type:       v1alpha1.NewCodePeriodTypeNumberOfDays,
+		Value:      ptr.To("30"),
+	}
+	defined := instance.NewCodePeriodsShowObject{ProjectKey: "my-project", Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "30", EffectiveValue: "30"}
+	inherited := instance.NewCodePeriodsShowObject{ProjectKey: "my-project", Type: v1alpha1.NewCodePeriodTypePreviousVersion, Inherited: true}
+	deleted := newNewCodePeriod(projectParams)
+	deleted.SetDeletionTimestamp(ptr.To(metav1.Now()))
+	deletedInstance := newNewCodePeriod(v1alpha1.NewCodePeriodParameters{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: ptr.To("30")})
+	deletedInstance.SetDeletionTimestamp(ptr.To(metav1.Now()))
+
+	cases := map[string]struct {
+		client *fake.MockNewCodePeriodsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotNewCodePeriodError": {
+			client: &fake.MockNewCodePeriodsClient{},
+			mg:     &notNewCodePeriod{},
+			want: want{
+				err: errors.New(errNotNewCodePeriod),
+			},
+		},
+		"ShowFailsReturnsError": {
+			client: &fake.MockNewCodePeriodsClient{
+				ShowFn: func(opt *sonargo.NewCodePeriodsShowOption) (*instance.NewCodePeriodsShowObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newNewCodePeriod(projectParams),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errGetNewCodePeriod),
+			},
+		},
+		"MissingProjectReturnsNotExists": {
+			client: &fake.MockNewCodePeriodsClient{
+				ShowFn: func(opt *sonargo.NewCodePeriodsShowOption) (*instance.NewCodePeriodsShowObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newNewCodePeriod(projectParams),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DefinedUpToDate": {
+			client: &fake.MockNewCodePeriodsClient{
+				ShowFn: showReturning(defined),
+			},
+			mg: newNewCodePeriod(projectParams),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.NewCodePeriodObservation{ProjectKey: "my-project", Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "30", EffectiveValue: "30"},
+			},
+		},
+		"InheritedNeedsUpdate": {
+			client: &fake.MockNewCodePeriodsClient{
+				ShowFn: showReturning(inherited),
+			},
+			mg: newNewCodePeriod(projectParams),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.NewCodePeriodObservation{ProjectKey: "my-project", Type: v1alpha1.NewCodePeriodTypePreviousVersion, Inherited: true},
+			},
+		},
+		"UnsetWhileDeletedReturnsNotExists": {
+			client: &fake.MockNewCodePeriodsClient{
+				ShowFn: showReturning(inherited),
+			},
+			mg: deleted,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DefinedWhileDeletedReturnsExists": {
+			client: &fake.MockNewCodePeriodsClient{
+				ShowFn: showReturning(defined),
+			},
+			mg: deleted,
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.NewCodePeriodObservation{ProjectKey: "my-project", Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "30", EffectiveValue: "30"},
+			},
+		},
+		"InstanceResetWhileDeletedReturnsNotExists": {
+			client: &fake.MockNewCodePeriodsClient{
+				ShowFn: showReturning(instance.NewCodePeriodsShowObject{Type: v1alpha1.NewCodePeriodTypePreviousVersion}),
+			},
+			mg: deletedInstance,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{newCodePeriodsClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.NewCodePeriod); ok && tc.want.err == nil && tc.want.o.ResourceExists {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		option *sonargo.NewCodePeriodsSetOption
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		fail bool
+		want want
+	}{
+		"NotNewCodePeriodError": {
+			mg: &notNewCodePeriod{},
+			want: want{
+				err: errors.New(errNotNewCodePeriod),
+			},
+		},
+		"SetsBranch": {
+			mg: newNewCodePeriod(v1alpha1.NewCodePeriodParameters{
+				Scope:      ptr.To(v1alpha1.NewCodePeriodScopeBranch),
+				ProjectKey: ptr.To("my-project"),
+				Branch:     ptr.To("feature"),
+				Type:       v1alpha1.NewCodePeriodTypeReferenceBranch,
+				Value:      ptr.To("main"),
+			}),
+			want: want{
+				option: &sonargo.NewCodePeriodsSetOption{Project: "my-project", Branch: "feature", Type: v1alpha1.NewCodePeriodTypeReferenceBranch, Value: "main"},
+			},
+		},
+		"SetFailsReturnsError": {
+			mg:   newNewCodePeriod(v1alpha1.NewCodePeriodParameters{Scope: ptr.To(v1alpha1.NewCodePeriodScopeProject), ProjectKey: ptr.To("my-project"), Type: v1alpha1.NewCodePeriodTypePreviousVersion}),
+			fail: true,
+			want: want{
+				option: &sonargo.NewCodePeriodsSetOption{Project: "my-project", Type: v1alpha1.NewCodePeriodTypePreviousVersion},
+				err:    errors.Wrap(errors.New("project not found"), errSetNewCodePeriod),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var option *sonargo.NewCodePeriodsSetOption
+			client := &fake.MockNewCodePeriodsClient{
+				SetFn: func(opt *sonargo.NewCodePeriodsSetOption) (*http.Response, error) {
+					option = opt
+					if tc.fail {
+						return &http.Response{StatusCode: http.StatusNotFound}, errors.New("project not found")
+					}
+					return nil, nil
+				},
+			}
+			e := external{newCodePeriodsClient: client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.option, option); diff != "" {
+				t.Errorf("\ne.Create(...): -want option, +got option:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var option *sonargo.NewCodePeriodsSetOption
+	client := &fake.MockNewCodePeriodsClient{
+		SetFn: func(opt *sonargo.NewCodePeriodsSetOption) (*http.Response, error) {
+			option = opt
+			return nil, nil
+		},
+	}
+	e := external{newCodePeriodsClient: client}
+
+	if _, err := e.Update(context.Background(), &notNewCodePeriod{}); !errComparer(err, errors.New(errNotNewCodePeriod)) {
+		t.Errorf("e.Update(...) error = %v, want %s", err, errNotNewCodePeriod)
+	}
+
+	mg := newNewCodePeriod(v1alpha1.NewCodePeriodParameters{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: ptr.To("14")})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("e.Update(...) error = %v", err)
+	}
+	if diff := cmp.Diff(&sonargo.NewCodePeriodsSetOption{Type: v1alpha1.NewCodePeriodTypeNumberOfDays, Value: "14"}, option); diff != "" {
+		t.Errorf("\ne.Update(...): -want option, +got option:\n%s\n", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockNewCodePeriodsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotNewCodePeriodError": {
+			client: &fake.MockNewCodePeriodsClient{},
+			mg:     &notNewCodePeriod{},
+			want:   errors.New(errNotNewCodePeriod),
+		},
+		"Unset": {
+			client: &fake.MockNewCodePeriodsClient{
+				UnsetFn: func(opt *sonargo.NewCodePeriodsUnsetOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newNewCodePeriod(v1alpha1.NewCodePeriodParameters{Scope: ptr.To(v1alpha1.NewCodePeriodScopeProject), ProjectKey: ptr.To("my-project")}),
+		},
+		"MissingProject": {
+			client: &fake.MockNewCodePeriodsClient{
+				UnsetFn: func(opt *sonargo.NewCodePeriodsUnsetOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newNewCodePeriod(v1alpha1.NewCodePeriodParameters{Scope: ptr.To(v1alpha1.NewCodePeriodScopeProject), ProjectKey: ptr.To("my-project")}),
+		},
+		"UnsetFailsReturnsError": {
+			client: &fake.MockNewCodePeriodsClient{
+				UnsetFn: func(opt *sonargo.NewCodePeriodsUnsetOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("not compatible with Clean as You Code")
+				},
+			},
+			mg:   newNewCodePeriod(v1alpha1.NewCodePeriodParameters{}),
+			want: errors.Wrap(errors.New("not compatible with Clean as You Code"), errUnsetNewCodePeriod),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{newCodePeriodsClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
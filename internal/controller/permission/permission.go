@@ -0,0 +1,324 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"context"
+	"net/http"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotPermission = "managed resource is not a Permission custom resource"
+	errTrackPCUsage  = "cannot track ProviderConfig usage"
+	errGetPC         = "cannot get ProviderConfig"
+
+	errSearchPermissions = "cannot get SonarQube permissions"
+	errAddPermission     = "cannot add SonarQube permission"
+	errRemovePermission  = "cannot remove SonarQube permission"
+)
+
+// SetupGated adds a controller that reconciles Permission managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup Permission controller"))
+		}
+	}, v1alpha1.PermissionGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PermissionGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewPermissionsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.PermissionList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.PermissionList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.PermissionGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Permission{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.PermissionsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Permission)
+	if !ok {
+		return nil, errors.New(errNotPermission)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{permissionsClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// permissionsClient is used to interact with SonarQube Permissions API
+	permissionsClient instance.PermissionsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Permission)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPermission)
+	}
+
+	permissions, err := c.observePermissions(&cr.Spec.ForProvider)
+	if err != nil {
+		// The Project the permissions are granted on is gone, and so are its permissions
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errSearchPermissions)
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GeneratePermissionObservation(permissions)
+	if !instance.PermissionExists(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: instance.IsPermissionUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+	}, nil
+}
+
+// observePermissions retrieves the permissions held directly by the User or Group in the scope
+func (c *external) observePermissions(spec *v1alpha1.PermissionParameters) ([]string, error) {
+	if instance.IsUserPermission(spec) {
+		return c.observeUserPermissions(spec)
+	}
+	return c.observeGroupPermissions(spec)
+}
+
+// observeUserPermissions retrieves the permissions of the User, going through the pages of users until it is found
+func (c *external) observeUserPermissions(spec *v1alpha1.PermissionParameters) ([]string, error) {
+	for page := 1; ; page++ {
+		search, resp, err := c.permissionsClient.Users(instance.GeneratePermissionUsersSearchOption(spec, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, common.NewAPIError(resp, err)
+		}
+		if permissions, found := instance.FindUserPermissions(search, ptr.Deref(spec.Login, "")); found {
+			return permissions, nil
+		}
+		if !instance.HasMorePermissionUsers(search) {
+			return nil, nil
+		}
+	}
+}
+
+// observeGroupPermissions retrieves the permissions of the Group, going through the pages of groups until it is found
+func (c *external) observeGroupPermissions(spec *v1alpha1.PermissionParameters) ([]string, error) {
+	for page := 1; ; page++ {
+		search, resp, err := c.permissionsClient.Groups(instance.GeneratePermissionGroupsSearchOption(spec, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, common.NewAPIError(resp, err)
+		}
+		if permissions, found := instance.FindGroupPermissions(search, ptr.Deref(spec.GroupName, "")); found {
+			return permissions, nil
+		}
+		if !instance.HasMorePermissionGroups(search) {
+			return nil, nil
+		}
+	}
+}
+
+// Create grants the desired permissions to the User or Group
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Permission)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPermission)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	if err := c.syncPermissions(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update grants the missing permissions and, when exclusive, removes the ones that are not desired
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Permission)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPermission)
+	}
+
+	if err := c.syncPermissions(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// syncPermissions reconciles the permissions observed by the last Observe with the desired ones
+// Missing permissions are granted before the extra ones are removed, so that a User or Group is never left without the admin permission it keeps
+func (c *external) syncPermissions(cr *v1alpha1.Permission) error {
+	for _, permission := range instance.FindPermissionsToAdd(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		if err := c.addPermission(&cr.Spec.ForProvider, permission); err != nil {
+			return err
+		}
+	}
+	for _, permission := range instance.FindPermissionsToRemove(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		if err := c.removePermission(&cr.Spec.ForProvider, permission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addPermission grants a permission to the User or Group
+func (c *external) addPermission(spec *v1alpha1.PermissionParameters, permission string) error {
+	var resp *http.Response
+	var err error
+	if instance.IsUserPermission(spec) {
+		resp, err = c.permissionsClient.AddUser(instance.GeneratePermissionAddUserOption(spec, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	} else {
+		resp, err = c.permissionsClient.AddGroup(instance.GeneratePermissionAddGroupOption(spec, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	}
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return errors.Wrapf(common.NewAPIError(resp, err), "%s %s", errAddPermission, permission)
+	}
+	return nil
+}
+
+// removePermission revokes a permission from the User or Group
+func (c *external) removePermission(spec *v1alpha1.PermissionParameters, permission string) error {
+	var resp *http.Response
+	var err error
+	if instance.IsUserPermission(spec) {
+		resp, err = c.permissionsClient.RemoveUser(instance.GeneratePermissionRemoveUserOption(spec, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	} else {
+		resp, err = c.permissionsClient.RemoveGroup(instance.GeneratePermissionRemoveGroupOption(spec, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	}
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return errors.Wrapf(common.NewAPIError(resp, err), "%s %s", errRemovePermission, permission)
+	}
+	return nil
+}
+
+// Delete removes the managed permissions of the User or Group, every permission in the scope when exclusive
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Permission)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPermission)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	for _, permission := range instance.FindPermissionsToRevoke(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		if err := c.removePermission(&cr.Spec.ForProvider, permission); err != nil {
+			// The Project, User or Group is already gone, and so is the permission
+			if common.IsNotFound(err) {
+				continue
+			}
+			return managed.ExternalDelete{}, err
+		}
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notPermission struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newPermission(params v1alpha1.PermissionParameters, observed ...string) *v1alpha1.Permission {
+	return &v1alpha1.Permission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-permission",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.PermissionSpec{
+			ForProvider: params,
+		},
+		Status: v1alpha1.PermissionStatus{
+			AtProvider: v1alpha1.PermissionObservation{Permissions: observed},
+		},
+	}
+}
+
+// recordingClient returns a MockPermissionsClient recording the permissions added and removed
+func recordingClient(calls *[]string) *fake.MockPermissionsClient {
+	return &fake.MockPermissionsClient{
+		AddUserFn: func(opt *sonargo.PermissionsAddUserOption) (*http.Response, error) {
+			*calls = append(*calls, "AddUser "+opt.Login+" "+opt.Permission+" "+opt.ProjectKey)
+			return nil, nil
+		},
+		RemoveUserFn: func(opt *sonargo.PermissionsRemoveUserOption) (*http.Response, error) {
+			*calls = append(*calls, "RemoveUser "+opt.Login+" "+opt.Permission+" "+opt.ProjectKey)
+			return nil, nil
+		},
+		AddGroupFn: func(opt *sonargo.PermissionsAddGroupOption) (*http.Response, error) {
+			*calls = append(*calls, "AddGroup "+opt.GroupName+" "+opt.Permission+" "+opt.ProjectKey)
+			return nil, nil
+		},
+		RemoveGroupFn: func(opt *sonargo.PermissionsRemoveGroupOption) (*http.Response, error) {
+			*calls = append(*calls, "RemoveGroup "+opt.GroupName+" "+opt.Permission+" "+opt.ProjectKey)
+			return nil, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.PermissionObservation
+		err         error
+	}
+
+	userParams := v1alpha1.PermissionParameters{Login: ptr.To("jdoe"), Permissions: []string{"admin", "scan"}}
+	groupParams := v1alpha1.PermissionParameters{
+		Scope:       ptr.To(v1alpha1.PermissionScopeProject),
+		ProjectKey:  ptr.To("my-project"),
+		GroupName:   ptr.To("developers"),
+		Permissions: []string{"codeviewer"},
+		Exclusive:   ptr.To(true),
+	}
+
+	cases := map[string]struct {
+		client *fake.MockPermissionsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotPermissionError": {
+			client: &fake.MockPermissionsClient{},
+			mg:     &notPermission{},
+			want: want{
+				err: errors.New(errNotPermission),
+			},
+		},
+		"SearchFailsReturnsError": {
+			client: &fake.MockPermissionsClient{
+				UsersFn: func(opt *sonargo.PermissionsUsersOption) (*sonargo.PermissionsUsersObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newPermission(userParams),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errSearchPermissions),
+			},
+		},
+		"MissingProjectReturnsNotExists": {
+			client: &fake.MockPermissionsClient{
+				GroupsFn: func(opt *sonargo.PermissionsGroupsOption) (*instance.PermissionsGroupsObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newPermission(groupParams),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UserWithoutManagedPermissionReturnsNotExists": {
+			client: &fake.MockPermissionsClient{
+				UsersFn: func(opt *sonargo.PermissionsUsersOption) (*sonargo.PermissionsUsersObject, *http.Response, error) {
+					return &sonargo.PermissionsUsersObject{Users: []sonargo.PermissionsUsersObject_sub2{{Login: "jdoe", Permissions: []string{"provisioning"}}}}, nil, nil
+				},
+			},
+			mg: newPermission(userParams),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: false},
+				observation: v1alpha1.PermissionObservation{Permissions: []string{"provisioning"}},
+			},
+		},
+		"UserFoundOnSecondPage": {
+			client: &fake.MockPermissionsClient{
+				UsersFn: func(opt *sonargo.PermissionsUsersOption) (*sonargo.PermissionsUsersObject, *http.Response, error) {
+					if opt.P == "1" {
+						return &sonargo.PermissionsUsersObject{
+							Paging: sonargo.PermissionsUsersObject_sub1{PageIndex: 1, PageSize: 1, Total: 2},
+							Users:  []sonargo.PermissionsUsersObject_sub2{{Login: "jdoe2", Permissions: []string{"admin"}}},
+						}, nil, nil
+					}
+					return &sonargo.PermissionsUsersObject{
+						Paging: sonargo.PermissionsUsersObject_sub1{PageIndex: 2, PageSize: 1, Total: 2},
+						Users:  []sonargo.PermissionsUsersObject_sub2{{Login: "jdoe", Permissions: []string{"scan", "admin"}}},
+					}, nil, nil
+				},
+			},
+			mg: newPermission(userParams),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.PermissionObservation{Permissions: []string{"scan", "admin"}},
+			},
+		},
+		"ExclusiveGroupWithExtraPermissionIsNotUpToDate": {
+			client: &fake.MockPermissionsClient{
+				GroupsFn: func(opt *sonargo.PermissionsGroupsOption) (*instance.PermissionsGroupsObject, *http.Response, error) {
+					if opt.ProjectKey != "my-project" {
+						return nil, nil, errors.New("unexpected project")
+					}
+					return &instance.PermissionsGroupsObject{Groups: []instance.PermissionsGroupObject{{Name: "developers", Permissions: []string{"codeviewer", "issueadmin"}}}}, nil, nil
+				},
+			},
+			mg: newPermission(groupParams),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.PermissionObservation{Permissions: []string{"codeviewer", "issueadmin"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{permissionsClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Permission); ok {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("Observe(...): -want observation, +got observation:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		mg    resource.Managed
+		err   error
+		calls []string
+	}{
+		"NotPermissionError": {
+			mg:  &notPermission{},
+			err: errors.New(errNotPermission),
+		},
+		"GrantsMissingPermissions": {
+			mg:    newPermission(v1alpha1.PermissionParameters{Login: ptr.To("jdoe"), Permissions: []string{"admin", "scan"}}, "provisioning"),
+			calls: []string{"AddUser jdoe admin ", "AddUser jdoe scan "},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{permissionsClient: recordingClient(&calls)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Create(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	projectGroup := v1alpha1.PermissionParameters{
+		Scope:       ptr.To(v1alpha1.PermissionScopeProject),
+		ProjectKey:  ptr.To("my-project"),
+		GroupName:   ptr.To("developers"),
+		Permissions: []string{"codeviewer", "user"},
+	}
+	exclusive := projectGroup.DeepCopy()
+	exclusive.Exclusive = ptr.To(true)
+
+	cases := map[string]struct {
+		client func(calls *[]string) *fake.MockPermissionsClient
+		mg     resource.Managed
+		err    error
+		calls  []string
+	}{
+		"NotPermissionError": {
+			client: recordingClient,
+			mg:     &notPermission{},
+			err:    errors.New(errNotPermission),
+		},
+		"ExtraPermissionIsKeptWhenNotExclusive": {
+			client: recordingClient,
+			mg:     newPermission(projectGroup, "codeviewer", "issueadmin"),
+			calls:  []string{"AddGroup developers user my-project"},
+		},
+		"ExtraPermissionIsRemovedAfterGrantingWhenExclusive": {
+			client: recordingClient,
+			mg:     newPermission(*exclusive, "codeviewer", "issueadmin"),
+			calls:  []string{"AddGroup developers user my-project", "RemoveGroup developers issueadmin my-project"},
+		},
+		"AddFailsReturnsError": {
+			client: func(calls *[]string) *fake.MockPermissionsClient {
+				client := recordingClient(calls)
+				client.AddGroupFn = func(opt *sonargo.PermissionsAddGroupOption) (*http.Response, error) {
+					return nil, errors.New("boom")
+				}
+				return client
+			},
+			mg:  newPermission(*exclusive, "codeviewer", "issueadmin"),
+			err: errors.Wrap(errors.New("boom"), errAddPermission+" user"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{permissionsClient: tc.client(&calls)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client func(calls *[]string) *fake.MockPermissionsClient
+		mg     resource.Managed
+		err    error
+		calls  []string
+	}{
+		"NotPermissionError": {
+			client: recordingClient,
+			mg:     &notPermission{},
+			err:    errors.New(errNotPermission),
+		},
+		"RemovesManagedPermissionsOnly": {
+			client: recordingClient,
+			mg:     newPermission(v1alpha1.PermissionParameters{Login: ptr.To("jdoe"), Permissions: []string{"admin", "scan"}}, "scan", "provisioning"),
+			calls:  []string{"RemoveUser jdoe scan "},
+		},
+		"RemovesEveryPermissionWhenExclusive": {
+			client: recordingClient,
+			mg:     newPermission(v1alpha1.PermissionParameters{Login: ptr.To("jdoe"), Permissions: []string{"scan"}, Exclusive: ptr.To(true)}, "scan", "provisioning"),
+			calls:  []string{"RemoveUser jdoe scan ", "RemoveUser jdoe provisioning "},
+		},
+		"NotFoundIsIgnored": {
+			client: func(calls *[]string) *fake.MockPermissionsClient {
+				return &fake.MockPermissionsClient{
+					RemoveUserFn: func(opt *sonargo.PermissionsRemoveUserOption) (*http.Response, error) {
+						*calls = append(*calls, "RemoveUser "+opt.Login+" "+opt.Permission)
+						return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+					},
+				}
+			},
+			mg:    newPermission(v1alpha1.PermissionParameters{Login: ptr.To("jdoe"), Permissions: []string{"admin", "scan"}}, "admin", "scan"),
+			calls: []string{"RemoveUser jdoe admin", "RemoveUser jdoe scan"},
+		},
+		"RemoveFailsReturnsError": {
+			client: func(calls *[]string) *fake.MockPermissionsClient {
+				return &fake.MockPermissionsClient{
+					RemoveUserFn: func(opt *sonargo.PermissionsRemoveUserOption) (*http.Response, error) {
+						return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("last administrator")
+					},
+				}
+			},
+			mg:  newPermission(v1alpha1.PermissionParameters{Login: ptr.To("jdoe"), Permissions: []string{"admin"}}, "admin"),
+			err: errors.Wrap(errors.New("last administrator"), errRemovePermission+" admin"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{permissionsClient: tc.client(&calls)}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Delete(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissiontemplate
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotPermissionTemplate = "managed resource is not a PermissionTemplate custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errGetPC                 = "cannot get ProviderConfig"
+
+	errSearchPermissionTemplates      = "cannot search SonarQube Permission Templates"
+	errSearchPermissionTemplateUsers  = "cannot list users of SonarQube Permission Template"
+	errSearchPermissionTemplateGroups = "cannot list groups of SonarQube Permission Template"
+	errCreatePermissionTemplate       = "cannot create SonarQube Permission Template"
+	errUpdatePermissionTemplate       = "cannot update SonarQube Permission Template"
+	errDeletePermissionTemplate       = "cannot delete SonarQube Permission Template"
+	errDefaultPermissionTemplate      = "cannot set SonarQube Permission Template as default for"
+	errSyncPermissionTemplateUsers    = "cannot sync Permission Template users"
+	errSyncPermissionTemplateGroups   = "cannot sync Permission Template groups"
+	errSyncPermissionTemplateCreator  = "cannot sync Permission Template project creator permissions"
+)
+
+// SetupGated adds a controller that reconciles PermissionTemplate managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup PermissionTemplate controller"))
+		}
+	}, v1alpha1.PermissionTemplateGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PermissionTemplateGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewPermissionsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.PermissionTemplateList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.PermissionTemplateList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.PermissionTemplateGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.PermissionTemplate{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.PermissionsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PermissionTemplate)
+	if !ok {
+		return nil, errors.New(errNotPermissionTemplate)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{permissionsClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// permissionsClient is used to interact with SonarQube Permissions API
+	permissionsClient instance.PermissionsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PermissionTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPermissionTemplate)
+	}
+
+	// The external name is the ID of the Permission Template, which does not change when it is renamed
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Permission Templates cannot be shown individually, find this one among all of them by its ID
+	search, resp, err := c.permissionsClient.SearchTemplates(instance.GeneratePermissionTemplateSearchOption()) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(resp, err), errSearchPermissionTemplates)
+	}
+	template := instance.FindPermissionTemplateByID(search, externalName)
+	if template == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Retrieve the users and groups granted permissions by the Permission Template
+	users, err := c.observePermissionTemplateUsers(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	groups, err := c.observePermissionTemplateGroups(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GeneratePermissionTemplateObservation(template, search.DefaultTemplates)
+	cr.Status.AtProvider.Users = users
+	cr.Status.AtProvider.Groups = groups
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializePermissionTemplate(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	userAssociations := instance.GeneratePermissionTemplateUsersAssociation(cr.Spec.ForProvider.Users, cr.Status.AtProvider.Users)
+	groupAssociations := instance.GeneratePermissionTemplateGroupsAssociation(cr.Spec.ForProvider.Groups, cr.Status.AtProvider.Groups)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsPermissionTemplateUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, userAssociations, groupAssociations),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// observePermissionTemplateUsers retrieves all the users granted permissions by the Permission Template
+func (c *external) observePermissionTemplateUsers(id string) ([]v1alpha1.PermissionTemplateUserObservation, error) {
+	var users []v1alpha1.PermissionTemplateUserObservation
+	for page := 1; ; page++ {
+		search, resp, err := c.permissionsClient.TemplateUsers(instance.GeneratePermissionTemplateUsersSearchOption(id, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchPermissionTemplateUsers)
+		}
+		users = append(users, instance.GeneratePermissionTemplateUsersObservation(search)...)
+		if !instance.HasMorePermissionTemplateUsers(search) {
+			return users, nil
+		}
+	}
+}
+
+// observePermissionTemplateGroups retrieves all the groups granted permissions by the Permission Template
+func (c *external) observePermissionTemplateGroups(id string) ([]v1alpha1.PermissionTemplateGroupObservation, error) {
+	var groups []v1alpha1.PermissionTemplateGroupObservation
+	for page := 1; ; page++ {
+		search, resp, err := c.permissionsClient.TemplateGroups(instance.GeneratePermissionTemplateGroupsSearchOption(id, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchPermissionTemplateGroups)
+		}
+		groups = append(groups, instance.GeneratePermissionTemplateGroupsObservation(search)...)
+		if !instance.HasMorePermissionTemplateGroups(search) {
+			return groups, nil
+		}
+	}
+}
+
+// Create creates the external resource and sets the external name
+// The users, groups, project creator permissions and defaults are synced by the first Update, once the new Permission Template is observed
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PermissionTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPermissionTemplate)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	template, resp, err := c.permissionsClient.CreateTemplate(instance.GeneratePermissionTemplateCreateOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreatePermissionTemplate)
+	}
+
+	// Set the external name to the ID of the created Permission Template
+	meta.SetExternalName(cr, template.PermissionTemplate.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update updates the external resource to match the desired state of the managed resource
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PermissionTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPermissionTemplate)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for Permission Template %s", cr.Name)
+	}
+
+	spec := &cr.Spec.ForProvider
+	observation := &cr.Status.AtProvider
+
+	if !isPermissionTemplateInfoUpToDate(spec, observation) {
+		updateResp, err := c.permissionsClient.UpdateTemplate(instance.GeneratePermissionTemplateUpdateOption(externalName, *spec)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(updateResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(updateResp, err), errUpdatePermissionTemplate)
+		}
+	}
+
+	userAssociations := instance.GeneratePermissionTemplateUsersAssociation(spec.Users, observation.Users)
+	if err := c.syncPermissionTemplateEntries(userAssociations, func(name, permission string) error {
+		return c.addPermissionTemplateUser(externalName, name, permission)
+	}, func(name, permission string) error {
+		return c.removePermissionTemplateUser(externalName, name, permission)
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSyncPermissionTemplateUsers)
+	}
+
+	groupAssociations := instance.GeneratePermissionTemplateGroupsAssociation(spec.Groups, observation.Groups)
+	if err := c.syncPermissionTemplateEntries(groupAssociations, func(name, permission string) error {
+		return c.addPermissionTemplateGroup(externalName, name, permission)
+	}, func(name, permission string) error {
+		return c.removePermissionTemplateGroup(externalName, name, permission)
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSyncPermissionTemplateGroups)
+	}
+
+	if err := c.syncPermissionTemplateProjectCreator(externalName, spec, observation); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSyncPermissionTemplateCreator)
+	}
+
+	for _, qualifier := range instance.FindPermissionTemplateDefaultsToSet(spec, observation) {
+		defaultResp, err := c.permissionsClient.SetDefaultTemplate(instance.GeneratePermissionTemplateSetDefaultOption(externalName, qualifier)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(defaultResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(common.NewAPIError(defaultResp, err), "%s %s", errDefaultPermissionTemplate, qualifier)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// isPermissionTemplateInfoUpToDate checks whether the name, description and project key pattern of the Permission Template are up to date
+func isPermissionTemplateInfoUpToDate(spec *v1alpha1.PermissionTemplateParameters, observation *v1alpha1.PermissionTemplateObservation) bool {
+	return spec.Name == observation.Name &&
+		helpers.IsComparablePtrEqualComparable(spec.Description, observation.Description) &&
+		helpers.IsComparablePtrEqualComparable(spec.ProjectKeyPattern, observation.ProjectKeyPattern)
+}
+
+// syncPermissionTemplateEntries revokes the permissions of the undesired users or groups, then grants the permissions of the missing ones and fixes the out-of-date ones
+func (c *external) syncPermissionTemplateEntries(associations map[string]instance.PermissionTemplateEntryAssociation, add, remove func(name, permission string) error) error {
+	for _, assoc := range instance.FindMissingPermissionTemplateEntries(associations) {
+		for _, permission := range instance.FindPermissionTemplateEntryPermissionsToRemove(assoc) {
+			if err := remove(assoc.Name, permission); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, assoc := range instance.FindNonExistingPermissionTemplateEntries(associations) {
+		for _, permission := range instance.FindPermissionTemplateEntryPermissionsToAdd(assoc) {
+			if err := add(assoc.Name, permission); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, assoc := range instance.FindNotUpToDatePermissionTemplateEntries(associations) {
+		for _, permission := range instance.FindPermissionTemplateEntryPermissionsToAdd(assoc) {
+			if err := add(assoc.Name, permission); err != nil {
+				return err
+			}
+		}
+		for _, permission := range instance.FindPermissionTemplateEntryPermissionsToRemove(assoc) {
+			if err := remove(assoc.Name, permission); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addPermissionTemplateUser grants a permission to a user in the Permission Template
+func (c *external) addPermissionTemplateUser(id, login, permission string) error {
+	resp, err := c.permissionsClient.AddUserToTemplate(instance.GeneratePermissionTemplateAddUserOption(id, login, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return errors.Wrapf(common.NewAPIError(resp, err), "cannot grant permission %s to user %s", permission, login)
+	}
+	return nil
+}
+
+// removePermissionTemplateUser revokes a permission from a user in the Permission Template
+func (c *external) removePermissionTemplateUser(id, login, permission string) error {
+	resp, err := c.permissionsClient.RemoveUserFromTemplate(instance.GeneratePermissionTemplateRemoveUserOption(id, login, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	// A user that no longer exists is no longer granted the permission
+	if err = common.NewAPIError(resp, err); err != nil && !common.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot revoke permission %s from user %s", permission, login)
+	}
+	return nil
+}
+
+// addPermissionTemplateGroup grants a permission to a group in the Permission Template
+func (c *external) addPermissionTemplateGroup(id, groupName, permission string) error {
+	resp, err := c.permissionsClient.AddGroupToTemplate(instance.GeneratePermissionTemplateAddGroupOption(id, groupName, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return errors.Wrapf(common.NewAPIError(resp, err), "cannot grant permission %s to group %s", permission, groupName)
+	}
+	return nil
+}
+
+// removePermissionTemplateGroup revokes a permission from a group in the Permission Template
+func (c *external) removePermissionTemplateGroup(id, groupName, permission string) error {
+	resp, err := c.permissionsClient.RemoveGroupFromTemplate(instance.GeneratePermissionTemplateRemoveGroupOption(id, groupName, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	// A group that no longer exists is no longer granted the permission
+	if err = common.NewAPIError(resp, err); err != nil && !common.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot revoke permission %s from group %s", permission, groupName)
+	}
+	return nil
+}
+
+// syncPermissionTemplateProjectCreator grants the missing permissions of the Project creator and revokes the undesired ones
+func (c *external) syncPermissionTemplateProjectCreator(id string, spec *v1alpha1.PermissionTemplateParameters, observation *v1alpha1.PermissionTemplateObservation) error {
+	for _, permission := range instance.FindProjectCreatorPermissionsToAdd(spec, observation) {
+		resp, err := c.permissionsClient.AddProjectCreatorToTemplate(instance.GeneratePermissionTemplateAddProjectCreatorOption(id, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrapf(common.NewAPIError(resp, err), "cannot grant permission %s to project creator", permission)
+		}
+	}
+
+	for _, permission := range instance.FindProjectCreatorPermissionsToRemove(spec, observation) {
+		resp, err := c.permissionsClient.RemoveProjectCreatorFromTemplate(instance.GeneratePermissionTemplateRemoveProjectCreatorOption(id, permission)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrapf(common.NewAPIError(resp, err), "cannot revoke permission %s from project creator", permission)
+		}
+	}
+	return nil
+}
+
+// Delete deletes the external resource
+// SonarQube refuses to delete a Permission Template that is the default one for a qualifier
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.PermissionTemplate)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPermissionTemplate)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	resp, err := c.permissionsClient.DeleteTemplate(instance.GeneratePermissionTemplateDeleteOption(externalName)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Permission Template is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeletePermissionTemplate)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
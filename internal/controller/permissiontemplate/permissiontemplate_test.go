@@ -0,0 +1,486 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissiontemplate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notPermissionTemplate struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newPermissionTemplate(externalName string, params v1alpha1.PermissionTemplateParameters, observation v1alpha1.PermissionTemplateObservation) *v1alpha1.PermissionTemplate {
+	pt := &v1alpha1.PermissionTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-permission-template",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.PermissionTemplateSpec{
+			ForProvider: params,
+		},
+		Status: v1alpha1.PermissionTemplateStatus{
+			AtProvider: observation,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(pt, externalName)
+	}
+	return pt
+}
+
+// searchTemplates returns a SearchTemplatesFn finding the given Permission Template
+// roundTrip returns the PermissionTemplate as stored by the API server, which drops the fields omitted from its JSON
+func roundTrip(cr *v1alpha1.PermissionTemplate) *v1alpha1.PermissionTemplate {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		panic(err)
+	}
+	stored := &v1alpha1.PermissionTemplate{}
+	if err := json.Unmarshal(raw, stored); err != nil {
+		panic(err)
+	}
+	return stored
+}
+
+func searchTemplates(templates []instance.PermissionTemplateObject, defaults []sonargo.PermissionsSearchTemplatesObject_sub1) func(opt *sonargo.PermissionsSearchTemplatesOption) (*instance.PermissionsSearchTemplatesObject, *http.Response, error) {
+	return func(opt *sonargo.PermissionsSearchTemplatesOption) (*instance.PermissionsSearchTemplatesObject, *http.Response, error) {
+		return &instance.PermissionsSearchTemplatesObject{PermissionTemplates: templates, DefaultTemplates: defaults}, nil, nil
+	}
+}
+
+// recordingClient returns a MockPermissionsClient recording the changes made to the Permission Template
+func recordingClient(calls *[]string) *fake.MockPermissionsClient {
+	return &fake.MockPermissionsClient{
+		UpdateTemplateFn: func(opt *instance.PermissionsUpdateTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "UpdateTemplate "+opt.Id+" "+opt.Name)
+			return nil, nil
+		},
+		AddUserToTemplateFn: func(opt *sonargo.PermissionsAddUserToTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "AddUser "+opt.Login+" "+opt.Permission)
+			return nil, nil
+		},
+		RemoveUserFromTemplateFn: func(opt *sonargo.PermissionsRemoveUserFromTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "RemoveUser "+opt.Login+" "+opt.Permission)
+			return nil, nil
+		},
+		AddGroupToTemplateFn: func(opt *sonargo.PermissionsAddGroupToTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "AddGroup "+opt.GroupName+" "+opt.Permission)
+			return nil, nil
+		},
+		RemoveGroupFromTemplateFn: func(opt *sonargo.PermissionsRemoveGroupFromTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "RemoveGroup "+opt.GroupName+" "+opt.Permission)
+			return nil, nil
+		},
+		AddProjectCreatorToTemplateFn: func(opt *sonargo.PermissionsAddProjectCreatorToTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "AddProjectCreator "+opt.Permission)
+			return nil, nil
+		},
+		RemoveProjectCreatorFromTemplateFn: func(opt *sonargo.PermissionsRemoveProjectCreatorFromTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "RemoveProjectCreator "+opt.Permission)
+			return nil, nil
+		},
+		SetDefaultTemplateFn: func(opt *sonargo.PermissionsSetDefaultTemplateOption) (*http.Response, error) {
+			*calls = append(*calls, "SetDefaultTemplate "+opt.Qualifier)
+			return nil, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.PermissionTemplateObservation
+		err         error
+	}
+
+	template := instance.PermissionTemplateObject{
+		ID:          "tpl-1",
+		Name:        "Default",
+		Description: "For all projects",
+		Permissions: []sonargo.PermissionsSearchTemplatesObject_sub2{{Key: "admin", WithProjectCreator: true}},
+	}
+	users := func(opt *sonargo.PermissionsTemplateUsersOption) (*sonargo.PermissionsTemplateUsersObject, *http.Response, error) {
+		return &sonargo.PermissionsTemplateUsersObject{
+			Users: []sonargo.PermissionsTemplateUsersObject_sub2{{Login: "jdoe", Permissions: []string{"admin"}}},
+		}, nil, nil
+	}
+	groups := func(opt *sonargo.PermissionsTemplateGroupsOption) (*sonargo.PermissionsTemplateGroupsObject, *http.Response, error) {
+		return &sonargo.PermissionsTemplateGroupsObject{
+			Groups: []sonargo.PermissionsTemplateGroupsObject_sub1{{Name: "Anyone", Permissions: []string{"user"}}},
+		}, nil, nil
+	}
+	observed := v1alpha1.PermissionTemplateObservation{
+		ID:                        "tpl-1",
+		Name:                      "Default",
+		Description:               "For all projects",
+		Users:                     []v1alpha1.PermissionTemplateUserObservation{{Login: "jdoe", Permissions: []string{"admin"}}},
+		Groups:                    []v1alpha1.PermissionTemplateGroupObservation{{GroupName: "Anyone", Permissions: []string{"user"}}},
+		ProjectCreatorPermissions: []string{"admin"},
+		DefaultFor:                []string{"Project"},
+	}
+
+	cases := map[string]struct {
+		client *fake.MockPermissionsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotPermissionTemplateError": {
+			client: &fake.MockPermissionsClient{},
+			mg:     &notPermissionTemplate{},
+			want: want{
+				err: errors.New(errNotPermissionTemplate),
+			},
+		},
+		"NoExternalNameReturnsNotExists": {
+			client: &fake.MockPermissionsClient{},
+			mg:     newPermissionTemplate("", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchFailsReturnsError": {
+			client: &fake.MockPermissionsClient{
+				SearchTemplatesFn: func(opt *sonargo.PermissionsSearchTemplatesOption) (*instance.PermissionsSearchTemplatesObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errSearchPermissionTemplates),
+			},
+		},
+		"UnknownIDReturnsNotExists": {
+			client: &fake.MockPermissionsClient{
+				SearchTemplatesFn: searchTemplates([]instance.PermissionTemplateObject{template}, nil),
+			},
+			mg: newPermissionTemplate("tpl-2", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockPermissionsClient{
+				SearchTemplatesFn: searchTemplates([]instance.PermissionTemplateObject{template}, []sonargo.PermissionsSearchTemplatesObject_sub1{{Qualifier: "TRK", TemplateID: "tpl-1"}}),
+				TemplateUsersFn:   users,
+				TemplateGroupsFn:  groups,
+			},
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{
+				Name:                      "Default",
+				Description:               ptr.To("For all projects"),
+				ProjectKeyPattern:         ptr.To(""),
+				Groups:                    []v1alpha1.PermissionTemplateGroupParameters{{GroupName: "anyone", Permissions: []string{"user"}}},
+				ProjectCreatorPermissions: []string{"admin"},
+				DefaultFor:                []string{"Project"},
+			}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: observed,
+			},
+		},
+		"OutdatedUsersAndLateInitialized": {
+			client: &fake.MockPermissionsClient{
+				SearchTemplatesFn: searchTemplates([]instance.PermissionTemplateObject{template}, []sonargo.PermissionsSearchTemplatesObject_sub1{{Qualifier: "TRK", TemplateID: "tpl-1"}}),
+				TemplateUsersFn:   users,
+				TemplateGroupsFn:  groups,
+			},
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{
+				Name:  "Default",
+				Users: []v1alpha1.PermissionTemplateUserParameters{{Login: "jdoe", Permissions: []string{"admin", "scan"}}},
+			}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+				observation: observed,
+			},
+		},
+		"TemplateUsersFailsReturnsError": {
+			client: &fake.MockPermissionsClient{
+				SearchTemplatesFn: searchTemplates([]instance.PermissionTemplateObject{template}, nil),
+				TemplateUsersFn: func(opt *sonargo.PermissionsTemplateUsersOption) (*sonargo.PermissionsTemplateUsersObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errSearchPermissionTemplateUsers),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{permissionsClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.PermissionTemplate); ok && tc.want.err == nil && tc.want.o.ResourceExists {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockPermissionsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotPermissionTemplateError": {
+			client: &fake.MockPermissionsClient{},
+			mg:     &notPermissionTemplate{},
+			want: want{
+				err: errors.New(errNotPermissionTemplate),
+			},
+		},
+		"CreateFailsReturnsError": {
+			client: &fake.MockPermissionsClient{
+				CreateTemplateFn: func(opt *sonargo.PermissionsCreateTemplateOption) (*instance.PermissionsCreateTemplateObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusBadRequest}, errors.New("already exists")
+				},
+			},
+			mg: newPermissionTemplate("test-permission-template", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				externalName: "test-permission-template",
+				err:          errors.Wrap(errors.New("already exists"), errCreatePermissionTemplate),
+			},
+		},
+		"CreateSetsExternalNameToID": {
+			client: &fake.MockPermissionsClient{
+				CreateTemplateFn: func(opt *sonargo.PermissionsCreateTemplateOption) (*instance.PermissionsCreateTemplateObject, *http.Response, error) {
+					return &instance.PermissionsCreateTemplateObject{PermissionTemplate: instance.PermissionTemplateObject{ID: "tpl-1", Name: opt.Name}}, nil, nil
+				},
+			},
+			mg: newPermissionTemplate("test-permission-template", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+			want: want{
+				externalName: "tpl-1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{permissionsClient: tc.client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.PermissionTemplate); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\ne.Create(...): -want external name, +got external name:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		fail bool
+		want want
+	}{
+		"NotPermissionTemplateError": {
+			mg: &notPermissionTemplate{},
+			want: want{
+				err: errors.New(errNotPermissionTemplate),
+			},
+		},
+		"SyncsEverything": {
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{
+				Name:                      "Finance",
+				Users:                     []v1alpha1.PermissionTemplateUserParameters{{Login: "jdoe", Permissions: []string{"admin", "scan"}}},
+				Groups:                    []v1alpha1.PermissionTemplateGroupParameters{{GroupName: "qa", Permissions: []string{"issueadmin"}}},
+				ProjectCreatorPermissions: []string{"user"},
+				DefaultFor:                []string{"Project", "Application"},
+			}, v1alpha1.PermissionTemplateObservation{
+				Name:                      "Default",
+				Users:                     []v1alpha1.PermissionTemplateUserObservation{{Login: "jdoe", Permissions: []string{"admin", "user"}}},
+				Groups:                    []v1alpha1.PermissionTemplateGroupObservation{{GroupName: "legacy", Permissions: []string{"admin"}}},
+				ProjectCreatorPermissions: []string{"admin"},
+				DefaultFor:                []string{"Project"},
+			}),
+			want: want{
+				calls: []string{
+					"UpdateTemplate tpl-1 Finance",
+					"AddUser jdoe scan",
+					"RemoveUser jdoe user",
+					"RemoveGroup legacy admin",
+					"AddGroup qa issueadmin",
+					"AddProjectCreator user",
+					"RemoveProjectCreator admin",
+					"SetDefaultTemplate APP",
+				},
+			},
+		},
+		"UnmanagedEntriesAreKept": {
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{
+				Name:       "Default",
+				DefaultFor: []string{"Portfolio"},
+			}, v1alpha1.PermissionTemplateObservation{
+				Name:                      "Default",
+				Users:                     []v1alpha1.PermissionTemplateUserObservation{{Login: "jdoe", Permissions: []string{"admin"}}},
+				ProjectCreatorPermissions: []string{"admin"},
+			}),
+			want: want{
+				calls: []string{"SetDefaultTemplate VW"},
+			},
+		},
+		"EmptyListsRemoveLastEntries": {
+			mg: roundTrip(newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{
+				Name:                      "Default",
+				Users:                     []v1alpha1.PermissionTemplateUserParameters{},
+				Groups:                    []v1alpha1.PermissionTemplateGroupParameters{},
+				ProjectCreatorPermissions: []string{},
+			}, v1alpha1.PermissionTemplateObservation{
+				Name:                      "Default",
+				Users:                     []v1alpha1.PermissionTemplateUserObservation{{Login: "jdoe", Permissions: []string{"admin"}}},
+				Groups:                    []v1alpha1.PermissionTemplateGroupObservation{{GroupName: "legacy", Permissions: []string{"admin"}}},
+				ProjectCreatorPermissions: []string{"admin"},
+			})),
+			want: want{
+				calls: []string{
+					"RemoveUser jdoe admin",
+					"RemoveGroup legacy admin",
+					"RemoveProjectCreator admin",
+				},
+			},
+		},
+		"SetDefaultFailsReturnsError": {
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{
+				Name:       "Default",
+				DefaultFor: []string{"Project"},
+			}, v1alpha1.PermissionTemplateObservation{Name: "Default"}),
+			fail: true,
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errDefaultPermissionTemplate+" Project"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			client := recordingClient(&calls)
+			if tc.fail {
+				client.SetDefaultTemplateFn = func(opt *sonargo.PermissionsSetDefaultTemplateOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				}
+			}
+			e := external{permissionsClient: client}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\ne.Update(...): -want calls, +got calls:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockPermissionsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotPermissionTemplateError": {
+			client: &fake.MockPermissionsClient{},
+			mg:     &notPermissionTemplate{},
+			want:   errors.New(errNotPermissionTemplate),
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockPermissionsClient{
+				DeleteTemplateFn: func(opt *sonargo.PermissionsDeleteTemplateOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+		},
+		"DeleteFailsReturnsError": {
+			client: &fake.MockPermissionsClient{
+				DeleteTemplateFn: func(opt *sonargo.PermissionsDeleteTemplateOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("default template")
+				},
+			},
+			mg:   newPermissionTemplate("tpl-1", v1alpha1.PermissionTemplateParameters{Name: "Default"}, v1alpha1.PermissionTemplateObservation{}),
+			want: errors.Wrap(errors.New("default template"), errDeletePermissionTemplate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{permissionsClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotPortfolio = "managed resource is not a Portfolio custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errGetSystemInfo         = "cannot get SonarQube system information"
+	errUnsupportedEdition    = "cannot manage Portfolios on the %s edition of SonarQube, they require the Enterprise Edition or above"
+	errShowPortfolio         = "cannot get SonarQube Portfolio"
+	errCreatePortfolio       = "cannot create SonarQube Portfolio"
+	errUpdatePortfolio       = "cannot update SonarQube Portfolio"
+	errDeletePortfolio       = "cannot delete SonarQube Portfolio"
+	errSetSelectionMode      = "cannot set SonarQube Portfolio selection mode"
+	errAddProject            = "cannot add Project to SonarQube Portfolio"
+	errRemoveProject         = "cannot remove Project from SonarQube Portfolio"
+	errAddSubPortfolio       = "cannot add sub-portfolio to SonarQube Portfolio"
+	errRemoveSubPortfolio    = "cannot remove sub-portfolio from SonarQube Portfolio"
+	errUnsupportedSelectMode = "unsupported Portfolio selection mode %s"
+)
+
+// editionTTL is the duration for which the edition of the SonarQube instance of a ProviderConfig is cached
+const editionTTL = 10 * time.Minute
+
+// SetupGated adds a controller that reconciles Portfolio managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup Portfolio controller"))
+		}
+	}, v1alpha1.PortfolioGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PortfolioGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:               mgr.GetClient(),
+			usage:              resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:       instance.NewPortfoliosClient,
+			newSystemServiceFn: instance.NewSystemClient,
+			editions:           common.NewTTLCache[string](editionTTL)}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.PortfolioList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.PortfolioList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.PortfolioGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Portfolio{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube               client.Client
+	usage              *resource.ProviderConfigUsageTracker
+	newServiceFn       func(config common.Config) instance.PortfoliosClient
+	newSystemServiceFn func(config common.Config) instance.SystemClient
+	editions           *common.TTLCache[string]
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Portfolio)
+	if !ok {
+		return nil, errors.New(errNotPortfolio)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{
+		portfoliosClient:  c.newServiceFn(*config),
+		systemClient:      c.newSystemServiceFn(*config),
+		editions:          c.editions,
+		providerConfigKey: common.ProviderConfigKey(m),
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// portfoliosClient is used to interact with SonarQube Views API, which manages Portfolios
+	portfoliosClient instance.PortfoliosClient
+	// systemClient is used to detect the edition of SonarQube
+	systemClient instance.SystemClient
+	// editions caches the edition of the SonarQube instance of each ProviderConfig
+	editions *common.TTLCache[string]
+	// providerConfigKey identifies the ProviderConfig of the managed resource in the editions cache
+	providerConfigKey string
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Portfolio)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPortfolio)
+	}
+
+	edition, err := instance.GetCachedSystemEdition(c.systemClient, c.editions, c.providerConfigKey)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSystemInfo)
+	}
+	cr.Status.SetConditions(instance.GenerateEditionCondition(edition))
+	if !instance.IsEnterpriseEdition(edition) {
+		// The Portfolio cannot exist on this edition, so there is nothing to delete
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf(errUnsupportedEdition, edition)
+	}
+
+	portfolio, err := c.show(cr.Spec.ForProvider)
+	if err != nil {
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errShowPortfolio)
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GeneratePortfolioObservation(portfolio)
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializePortfolio(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsPortfolioUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// show retrieves the Portfolio from SonarQube
+// The returned error is not wrapped, so that callers can check whether the Portfolio is not found.
+func (c *external) show(spec v1alpha1.PortfolioParameters) (*instance.ViewsShowObject, error) {
+	portfolio, resp, err := c.portfoliosClient.Show(instance.GeneratePortfolioShowOption(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, common.NewAPIError(resp, err)
+	}
+	return portfolio, nil
+}
+
+// Create creates the Portfolio, then selects its Projects and sub-portfolios
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Portfolio)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPortfolio)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	resp, err := c.portfoliosClient.Create(instance.GeneratePortfolioCreateOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreatePortfolio)
+	}
+
+	// A new Portfolio selects no Project and has no sub-portfolio
+	return managed.ExternalCreation{}, c.updateSelection(cr.Spec.ForProvider, v1alpha1.PortfolioObservation{SelectionMode: v1alpha1.PortfolioSelectionModeNone})
+}
+
+// Update updates the Portfolio, then its selected Projects and sub-portfolios
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Portfolio)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPortfolio)
+	}
+
+	if cr.Spec.ForProvider.Name != cr.Status.AtProvider.Name || !helpers.IsComparablePtrEqualComparable(cr.Spec.ForProvider.Description, cr.Status.AtProvider.Description) {
+		resp, err := c.portfoliosClient.Update(instance.GeneratePortfolioUpdateOption(cr.Spec.ForProvider, cr.Status.AtProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errUpdatePortfolio)
+		}
+	}
+
+	return managed.ExternalUpdate{}, c.updateSelection(cr.Spec.ForProvider, cr.Status.AtProvider)
+}
+
+// updateSelection updates the selection mode, the manually selected Projects and the sub-portfolios of the Portfolio from their observed state
+func (c *external) updateSelection(spec v1alpha1.PortfolioParameters, observation v1alpha1.PortfolioObservation) error {
+	if !instance.IsPortfolioSelectionModeUpToDate(&spec, &observation) {
+		if err := c.setSelectionMode(spec); err != nil {
+			return err
+		}
+		// Switching an existing Portfolio to the MANUAL selection mode may restore its previous manual selection, which is observed again
+		if ptr.Deref(spec.SelectionMode, v1alpha1.PortfolioSelectionModeNone) == v1alpha1.PortfolioSelectionModeManual && observation.Key != "" {
+			portfolio, err := c.show(spec)
+			if err != nil {
+				return errors.Wrap(err, errShowPortfolio)
+			}
+			observation.Projects = instance.GeneratePortfolioObservation(portfolio).Projects
+		}
+	}
+
+	if ptr.Deref(spec.SelectionMode, v1alpha1.PortfolioSelectionModeNone) == v1alpha1.PortfolioSelectionModeManual {
+		for _, project := range instance.FindPortfolioProjectsToAdd(spec.Projects, observation.Projects) {
+			resp, err := c.portfoliosClient.AddProject(instance.GeneratePortfolioAddProjectOption(spec.Key, project)) //nolint:bodyclose // closed via helpers.CloseBody
+			defer helpers.CloseBody(resp)
+			if err != nil {
+				return errors.Wrap(common.NewAPIError(resp, err), errAddProject)
+			}
+		}
+
+		for _, project := range instance.FindPortfolioProjectsToRemove(spec.Projects, observation.Projects) {
+			resp, err := c.portfoliosClient.RemoveProject(instance.GeneratePortfolioRemoveProjectOption(spec.Key, project)) //nolint:bodyclose // closed via helpers.CloseBody
+			defer helpers.CloseBody(resp)
+			if err != nil {
+				return errors.Wrap(common.NewAPIError(resp, err), errRemoveProject)
+			}
+		}
+	}
+
+	for _, subPortfolio := range instance.FindSubPortfoliosToAdd(spec.SubPortfolios, observation.SubPortfolios) {
+		resp, err := c.portfoliosClient.AddPortfolio(instance.GeneratePortfolioAddPortfolioOption(spec.Key, subPortfolio)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrap(common.NewAPIError(resp, err), errAddSubPortfolio)
+		}
+	}
+
+	for _, subPortfolio := range instance.FindSubPortfoliosToRemove(spec.SubPortfolios, observation.SubPortfolios) {
+		resp, err := c.portfoliosClient.RemovePortfolio(instance.GeneratePortfolioRemovePortfolioOption(spec.Key, subPortfolio)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return errors.Wrap(common.NewAPIError(resp, err), errRemoveSubPortfolio)
+		}
+	}
+
+	return nil
+}
+
+// setSelectionMode calls the endpoint setting the desired selection mode of the Portfolio
+func (c *external) setSelectionMode(spec v1alpha1.PortfolioParameters) error {
+	var resp *http.Response
+	var err error
+	switch mode := ptr.Deref(spec.SelectionMode, v1alpha1.PortfolioSelectionModeNone); mode {
+	case v1alpha1.PortfolioSelectionModeNone:
+		resp, err = c.portfoliosClient.SetNoneMode(instance.GeneratePortfolioSetNoneModeOption(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	case v1alpha1.PortfolioSelectionModeManual:
+		resp, err = c.portfoliosClient.SetManualMode(instance.GeneratePortfolioSetManualModeOption(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	case v1alpha1.PortfolioSelectionModeTags:
+		resp, err = c.portfoliosClient.SetTagsMode(instance.GeneratePortfolioSetTagsModeOption(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	case v1alpha1.PortfolioSelectionModeRegexp:
+		resp, err = c.portfoliosClient.SetRegexpMode(instance.GeneratePortfolioSetRegexpModeOption(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	case v1alpha1.PortfolioSelectionModeRest:
+		resp, err = c.portfoliosClient.SetRemainingProjectsMode(instance.GeneratePortfolioSetRemainingProjectsModeOption(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	default:
+		return fmt.Errorf(errUnsupportedSelectMode, mode)
+	}
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return errors.Wrap(common.NewAPIError(resp, err), errSetSelectionMode)
+	}
+	return nil
+}
+
+// Delete deletes the Portfolio
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Portfolio)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPortfolio)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	resp, err := c.portfoliosClient.Delete(instance.GeneratePortfolioDeleteOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Portfolio is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeletePortfolio)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,586 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notPortfolio struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newPortfolio(params v1alpha1.PortfolioParameters, observation v1alpha1.PortfolioObservation) *v1alpha1.Portfolio {
+	return &v1alpha1.Portfolio{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-portfolio",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.PortfolioSpec{
+			ForProvider: params,
+		},
+		Status: v1alpha1.PortfolioStatus{
+			AtProvider: observation,
+		},
+	}
+}
+
+// roundTrip returns the Portfolio as stored by the API server, which drops the fields omitted from its JSON
+func roundTrip(cr *v1alpha1.Portfolio) *v1alpha1.Portfolio {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		panic(err)
+	}
+	stored := &v1alpha1.Portfolio{}
+	if err := json.Unmarshal(raw, stored); err != nil {
+		panic(err)
+	}
+	return stored
+}
+
+func systemReturning(edition string) *fake.MockSystemClient {
+	return &fake.MockSystemClient{
+		InfoFn: func() (*instance.SystemInfoObject, *http.Response, error) {
+			return &instance.SystemInfoObject{System: instance.SystemInfoSystemObject{Edition: edition}}, nil, nil
+		},
+	}
+}
+
+var (
+	portfolioParams = v1alpha1.PortfolioParameters{
+		Key:           "my-portfolio",
+		Name:          "My Portfolio",
+		Description:   ptr.To("Backend services"),
+		SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual),
+		Projects:      []string{"backend"},
+		SubPortfolios: []string{"child"},
+	}
+	portfolioObservation = v1alpha1.PortfolioObservation{
+		Key:           "my-portfolio",
+		Name:          "My Portfolio",
+		Description:   "Backend services",
+		Qualifier:     "VW",
+		Visibility:    "public",
+		SelectionMode: v1alpha1.PortfolioSelectionModeManual,
+		Projects:      []string{"backend"},
+		SubPortfolios: []string{"child"},
+	}
+)
+
+func showPortfolio(opt *instance.ViewsShowOption) (*instance.ViewsShowObject, *http.Response, error) {
+	return &instance.ViewsShowObject{
+		Key:              "my-portfolio",
+		Name:             "My Portfolio",
+		Desc:             "Backend services",
+		Qualifier:        "VW",
+		Visibility:       "public",
+		SelectionMode:    v1alpha1.PortfolioSelectionModeManual,
+		SelectedProjects: []instance.ViewsSelectedProjectObject{{ProjectKey: "backend"}},
+		SubViews:         []instance.ViewsSubViewObject{{Key: "my-portfolio:child", OriginalKey: "child", Qualifier: "SVW"}},
+	}, nil, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.PortfolioObservation
+		edition     corev1.ConditionStatus
+		err         error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockPortfoliosClient
+		system *fake.MockSystemClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotPortfolioError": {
+			client: &fake.MockPortfoliosClient{},
+			system: systemReturning(instance.EditionEnterprise),
+			mg:     &notPortfolio{},
+			want: want{
+				err: errors.New(errNotPortfolio),
+			},
+		},
+		"SystemInfoFailsReturnsError": {
+			client: &fake.MockPortfoliosClient{},
+			system: &fake.MockSystemClient{
+				InfoFn: func() (*instance.SystemInfoObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errGetSystemInfo),
+			},
+		},
+		"UnsupportedEditionReturnsError": {
+			client: &fake.MockPortfoliosClient{},
+			system: systemReturning("Developer"),
+			mg:     newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{}),
+			want: want{
+				edition: corev1.ConditionFalse,
+				err:     fmt.Errorf(errUnsupportedEdition, "Developer"),
+			},
+		},
+		"UnsupportedEditionDeleted": {
+			client: &fake.MockPortfoliosClient{},
+			system: systemReturning("Community"),
+			mg: func() resource.Managed {
+				cr := newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{})
+				cr.SetDeletionTimestamp(ptr.To(metav1.Now()))
+				return cr
+			}(),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: false},
+				edition: corev1.ConditionFalse,
+			},
+		},
+		"NotFound": {
+			client: &fake.MockPortfoliosClient{
+				ShowFn: func(opt *instance.ViewsShowOption) (*instance.ViewsShowObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			system: systemReturning(instance.EditionEnterprise),
+			mg:     newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{}),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: false},
+				edition: corev1.ConditionTrue,
+			},
+		},
+		"ShowFailsReturnsError": {
+			client: &fake.MockPortfoliosClient{
+				ShowFn: func(opt *instance.ViewsShowOption) (*instance.ViewsShowObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("internal error")
+				},
+			},
+			system: systemReturning(instance.EditionEnterprise),
+			mg:     newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{}),
+			want: want{
+				edition: corev1.ConditionTrue,
+				err:     errors.Wrap(errors.New("internal error"), errShowPortfolio),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockPortfoliosClient{ShowFn: showPortfolio},
+			system: systemReturning(instance.EditionDataCenter),
+			mg:     newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: portfolioObservation,
+				edition:     corev1.ConditionTrue,
+			},
+		},
+		"SelectionModeChangedNeedsUpdate": {
+			client: &fake.MockPortfoliosClient{ShowFn: showPortfolio},
+			system: systemReturning(instance.EditionEnterprise),
+			mg: newPortfolio(v1alpha1.PortfolioParameters{
+				Key:           "my-portfolio",
+				Name:          "My Portfolio",
+				Description:   ptr.To("Backend services"),
+				SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeTags),
+				Tags:          []string{"backend"},
+				SubPortfolios: []string{"child"},
+			}, v1alpha1.PortfolioObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: portfolioObservation,
+				edition:     corev1.ConditionTrue,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{portfoliosClient: tc.client, systemClient: tc.system}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			cr, ok := tc.mg.(*v1alpha1.Portfolio)
+			if !ok {
+				return
+			}
+			if tc.want.edition != "" {
+				if diff := cmp.Diff(tc.want.edition, cr.Status.GetCondition(v1alpha1.TypeEditionSupported).Status); diff != "" {
+					t.Errorf("\ne.Observe(...): -want EditionSupported status, +got EditionSupported status:\n%s\n", diff)
+				}
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+// calls records the selection calls made to the Portfolio
+type calls struct {
+	created           bool
+	updated           bool
+	modes             []string
+	addedProjects     []string
+	removedProjects   []string
+	addedPortfolios   []string
+	removedPortfolios []string
+}
+
+// recordingClient returns a client recording its calls, with Show returning the given Portfolio
+func recordingClient(got *calls, shown *instance.ViewsShowObject) *fake.MockPortfoliosClient {
+	return &fake.MockPortfoliosClient{
+		CreateFn: func(opt *instance.ViewsCreateOption) (*http.Response, error) {
+			got.created = true
+			return nil, nil
+		},
+		UpdateFn: func(opt *instance.ViewsUpdateOption) (*http.Response, error) {
+			got.updated = true
+			return nil, nil
+		},
+		ShowFn: func(opt *instance.ViewsShowOption) (*instance.ViewsShowObject, *http.Response, error) {
+			return shown, nil, nil
+		},
+		SetNoneModeFn: func(opt *instance.ViewsSetNoneModeOption) (*http.Response, error) {
+			got.modes = append(got.modes, v1alpha1.PortfolioSelectionModeNone)
+			return nil, nil
+		},
+		SetManualModeFn: func(opt *instance.ViewsSetManualModeOption) (*http.Response, error) {
+			got.modes = append(got.modes, v1alpha1.PortfolioSelectionModeManual)
+			return nil, nil
+		},
+		SetTagsModeFn: func(opt *instance.ViewsSetTagsModeOption) (*http.Response, error) {
+			got.modes = append(got.modes, v1alpha1.PortfolioSelectionModeTags+":"+opt.Tags)
+			return nil, nil
+		},
+		SetRegexpModeFn: func(opt *instance.ViewsSetRegexpModeOption) (*http.Response, error) {
+			got.modes = append(got.modes, v1alpha1.PortfolioSelectionModeRegexp+":"+opt.Regexp)
+			return nil, nil
+		},
+		SetRemainingProjectsModeFn: func(opt *instance.ViewsSetRemainingProjectsModeOption) (*http.Response, error) {
+			got.modes = append(got.modes, v1alpha1.PortfolioSelectionModeRest)
+			return nil, nil
+		},
+		AddProjectFn: func(opt *instance.ViewsAddProjectOption) (*http.Response, error) {
+			got.addedProjects = append(got.addedProjects, opt.Project)
+			return nil, nil
+		},
+		RemoveProjectFn: func(opt *instance.ViewsRemoveProjectOption) (*http.Response, error) {
+			got.removedProjects = append(got.removedProjects, opt.Project)
+			return nil, nil
+		},
+		AddPortfolioFn: func(opt *instance.ViewsAddPortfolioOption) (*http.Response, error) {
+			got.addedPortfolios = append(got.addedPortfolios, opt.Reference)
+			return nil, nil
+		},
+		RemovePortfolioFn: func(opt *instance.ViewsRemovePortfolioOption) (*http.Response, error) {
+			got.removedPortfolios = append(got.removedPortfolios, opt.Reference)
+			return nil, nil
+		},
+	}
+}
+
+func TestObserveCachesEdition(t *testing.T) {
+	infos := 0
+	system := &fake.MockSystemClient{
+		InfoFn: func() (*instance.SystemInfoObject, *http.Response, error) {
+			infos++
+			return &instance.SystemInfoObject{System: instance.SystemInfoSystemObject{Edition: "Community"}}, nil, nil
+		},
+	}
+	editions := common.NewTTLCache[string](time.Minute)
+
+	for _, key := range []string{"ProviderConfig/default/example", "ProviderConfig/default/example", "ProviderConfig/default/other"} {
+		e := external{portfoliosClient: &fake.MockPortfoliosClient{}, systemClient: system, editions: editions, providerConfigKey: key}
+		if _, err := e.Observe(context.Background(), newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{})); !errComparer(err, fmt.Errorf(errUnsupportedEdition, "Community")) {
+			t.Errorf("e.Observe(...) error = %v, want %s", err, fmt.Sprintf(errUnsupportedEdition, "Community"))
+		}
+	}
+	if infos != 2 {
+		t.Errorf("e.Observe(...) retrieved the edition %d times, want once per ProviderConfig", infos)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want calls
+		err  error
+	}{
+		"NotPortfolioError": {
+			mg:  &notPortfolio{},
+			err: errors.New(errNotPortfolio),
+		},
+		"Manual": {
+			mg: newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{}),
+			want: calls{
+				created:         true,
+				modes:           []string{v1alpha1.PortfolioSelectionModeManual},
+				addedProjects:   []string{"backend"},
+				addedPortfolios: []string{"child"},
+			},
+		},
+		"Tags": {
+			mg: newPortfolio(v1alpha1.PortfolioParameters{
+				Key:           "my-portfolio",
+				Name:          "My Portfolio",
+				SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeTags),
+				Tags:          []string{"backend", "java"},
+			}, v1alpha1.PortfolioObservation{}),
+			want: calls{
+				created: true,
+				modes:   []string{v1alpha1.PortfolioSelectionModeTags + ":backend,java"},
+			},
+		},
+		"None": {
+			mg: newPortfolio(v1alpha1.PortfolioParameters{Key: "my-portfolio", Name: "My Portfolio"}, v1alpha1.PortfolioObservation{}),
+			want: calls{
+				created: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got calls
+			e := external{portfoliosClient: recordingClient(&got, nil)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("\ne.Create(...): -want calls, +got calls:\n%s\n", diff)
+			}
+		})
+	}
+
+	t.Run("CreateFailsReturnsError", func(t *testing.T) {
+		client := &fake.MockPortfoliosClient{
+			CreateFn: func(opt *instance.ViewsCreateOption) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("already exists")
+			},
+		}
+		e := external{portfoliosClient: client}
+		_, err := e.Create(context.Background(), newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{}))
+		want := errors.Wrap(errors.New("already exists"), errCreatePortfolio)
+		if diff := cmp.Diff(want, err, cmp.Comparer(errComparer)); diff != "" {
+			t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+		}
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg    resource.Managed
+		shown *instance.ViewsShowObject
+		want  calls
+		err   error
+	}{
+		"NotPortfolioError": {
+			mg:  &notPortfolio{},
+			err: errors.New(errNotPortfolio),
+		},
+		"UpdatesNameAndManualSelection": {
+			mg: newPortfolio(v1alpha1.PortfolioParameters{
+				Key:           "my-portfolio",
+				Name:          "Renamed Portfolio",
+				Description:   ptr.To("Backend services"),
+				SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual),
+				Projects:      []string{"frontend"},
+				SubPortfolios: []string{"other"},
+			}, portfolioObservation),
+			want: calls{
+				updated:           true,
+				addedProjects:     []string{"frontend"},
+				removedProjects:   []string{"backend"},
+				addedPortfolios:   []string{"other"},
+				removedPortfolios: []string{"child"},
+			},
+		},
+		"EmptyListsRemoveLastSelection": {
+			mg: roundTrip(newPortfolio(v1alpha1.PortfolioParameters{
+				Key:           "my-portfolio",
+				Name:          "My Portfolio",
+				Description:   ptr.To("Backend services"),
+				SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeManual),
+				Projects:      []string{},
+				SubPortfolios: []string{},
+			}, portfolioObservation)),
+			want: calls{
+				removedProjects:   []string{"backend"},
+				removedPortfolios: []string{"child"},
+			},
+		},
+		"SwitchesToManualRestoringPreviousSelection": {
+			mg: newPortfolio(portfolioParams, v1alpha1.PortfolioObservation{
+				Key:           "my-portfolio",
+				Name:          "My Portfolio",
+				Description:   "Backend services",
+				SelectionMode: v1alpha1.PortfolioSelectionModeRegexp,
+				Regexp:        ".*",
+				SubPortfolios: []string{"child"},
+			}),
+			shown: &instance.ViewsShowObject{
+				Key:              "my-portfolio",
+				SelectionMode:    v1alpha1.PortfolioSelectionModeManual,
+				SelectedProjects: []instance.ViewsSelectedProjectObject{{ProjectKey: "legacy"}},
+			},
+			want: calls{
+				modes:           []string{v1alpha1.PortfolioSelectionModeManual},
+				addedProjects:   []string{"backend"},
+				removedProjects: []string{"legacy"},
+			},
+		},
+		"SwitchesToRegexp": {
+			mg: newPortfolio(v1alpha1.PortfolioParameters{
+				Key:           "my-portfolio",
+				Name:          "My Portfolio",
+				Description:   ptr.To("Backend services"),
+				SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeRegexp),
+				Regexp:        ptr.To("backend-.*"),
+				SubPortfolios: []string{"child"},
+			}, portfolioObservation),
+			want: calls{
+				modes: []string{v1alpha1.PortfolioSelectionModeRegexp + ":backend-.*"},
+			},
+		},
+		"SwitchesToRest": {
+			mg: newPortfolio(v1alpha1.PortfolioParameters{
+				Key:           "my-portfolio",
+				Name:          "My Portfolio",
+				Description:   ptr.To("Backend services"),
+				SelectionMode: ptr.To(v1alpha1.PortfolioSelectionModeRest),
+				SubPortfolios: []string{"child"},
+			}, portfolioObservation),
+			want: calls{
+				modes: []string{v1alpha1.PortfolioSelectionModeRest},
+			},
+		},
+		"UnsupportedSelectionModeReturnsError": {
+			mg: newPortfolio(v1alpha1.PortfolioParameters{
+				Key:           "my-portfolio",
+				Name:          "My Portfolio",
+				Description:   ptr.To("Backend services"),
+				SelectionMode: ptr.To("UNKNOWN"),
+			}, portfolioObservation),
+			err: fmt.Errorf(errUnsupportedSelectMode, "UNKNOWN"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got calls
+			e := external{portfoliosClient: recordingClient(&got, tc.shown)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("\ne.Update(...): -want calls, +got calls:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockPortfoliosClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotPortfolioError": {
+			client: &fake.MockPortfoliosClient{},
+			mg:     &notPortfolio{},
+			want:   errors.New(errNotPortfolio),
+		},
+		"Delete": {
+			client: &fake.MockPortfoliosClient{
+				DeleteFn: func(opt *instance.ViewsDeleteOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newPortfolio(portfolioParams, portfolioObservation),
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockPortfoliosClient{
+				DeleteFn: func(opt *instance.ViewsDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newPortfolio(portfolioParams, portfolioObservation),
+		},
+		"DeleteFailsReturnsError": {
+			client: &fake.MockPortfoliosClient{
+				DeleteFn: func(opt *instance.ViewsDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg:   newPortfolio(portfolioParams, portfolioObservation),
+			want: errors.Wrap(errors.New("forbidden"), errDeletePortfolio),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{portfoliosClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"fmt"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotProject   = "managed resource is not a Project custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errCreateProject     = "cannot create SonarQube Project"
+	errDeleteProject     = "cannot delete SonarQube Project"
+	errSearchProject     = "cannot get SonarQube Project"
+	errListBranches      = "cannot list SonarQube Project branches"
+	errSearchProjectTags = "cannot get SonarQube Project tags"
+	errUpdateKey         = "cannot update SonarQube Project key"
+	errUpdateVisibility  = "cannot update SonarQube Project visibility"
+	errRenameMainBranch  = "cannot rename SonarQube Project main branch"
+	errSetTags           = "cannot set SonarQube Project tags"
+)
+
+// SetupGated adds a controller that reconciles Project managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup Project controller"))
+		}
+	}, v1alpha1.ProjectGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:                   mgr.GetClient(),
+			usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:           instance.NewProjectsClient,
+			newBranchesServiceFn:   instance.NewProjectBranchesClient,
+			newTagsServiceFn:       instance.NewProjectTagsClient,
+			newComponentsServiceFn: instance.NewComponentsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ProjectList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.ProjectList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.ProjectGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Project{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube                   client.Client
+	usage                  *resource.ProviderConfigUsageTracker
+	newServiceFn           func(config common.Config) instance.ProjectsClient
+	newBranchesServiceFn   func(config common.Config) instance.ProjectBranchesClient
+	newTagsServiceFn       func(config common.Config) instance.ProjectTagsClient
+	newComponentsServiceFn func(config common.Config) instance.ComponentsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return nil, errors.New(errNotProject)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{
+		projectsClient:        c.newServiceFn(*config),
+		projectBranchesClient: c.newBranchesServiceFn(*config),
+		projectTagsClient:     c.newTagsServiceFn(*config),
+		componentsClient:      c.newComponentsServiceFn(*config),
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// projectsClient is used to interact with SonarQube Projects API
+	projectsClient instance.ProjectsClient
+	// projectBranchesClient is used to read and rename the main branch of the Project
+	projectBranchesClient instance.ProjectBranchesClient
+	// projectTagsClient is used to set the tags of the Project
+	projectTagsClient instance.ProjectTagsClient
+	// componentsClient is used to read the tags of the Project
+	componentsClient instance.ComponentsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProject)
+	}
+
+	// Use external name as the identifier to check if the resource exists
+	// This allows returning early when the external name is not set
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Retrieve the Project from SonarQube
+	project, err := c.searchProject(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The project key may have been updated by a previous Update, whose new external name is only persisted from Observe
+	renamed := false
+	if project == nil && cr.Spec.ForProvider.Key != "" && cr.Spec.ForProvider.Key != externalName {
+		project, err = c.searchProject(cr.Spec.ForProvider.Key)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if project != nil {
+			externalName = project.Key
+			meta.SetExternalName(cr, externalName)
+			renamed = true
+		}
+	}
+	if project == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	branches, branchesResp, err := c.projectBranchesClient.List(&sonargo.ProjectBranchesListOption{ //nolint:bodyclose // closed via helpers.CloseBody
+		Project: externalName,
+	})
+	defer helpers.CloseBody(branchesResp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(branchesResp, err), errListBranches)
+	}
+
+	components, componentsResp, err := c.componentsClient.SearchProjects(instance.GenerateProjectComponentSearchOptions(externalName)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(componentsResp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(componentsResp, err), errSearchProjectTags)
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateProjectObservation(project, instance.FindMainBranch(branches), instance.FindProjectTags(components, externalName))
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeProject(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsProjectUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider) || renamed,
+	}, nil
+}
+
+// searchProject retrieves the Project with the given key from SonarQube
+// It returns nil if the Project does not exist
+func (c *external) searchProject(key string) (*sonargo.ProjectsSearchObject_sub1, error) {
+	projects, resp, err := c.projectsClient.Search(instance.GenerateProjectSearchOptions(key)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		if common.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errSearchProject)
+	}
+
+	// The search API returns an empty list when the project does not exist
+	return instance.FindProject(projects, key), nil
+}
+
+// Create creates the external resource and sets the external name
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	project, resp, err := c.projectsClient.Create(instance.GenerateProjectCreateOptions(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateProject)
+	}
+
+	// Set the external name to the Key of the created Project
+	meta.SetExternalName(cr, project.Project.Key)
+
+	if len(cr.Spec.ForProvider.Tags) > 0 {
+		tagsResp, err := c.projectTagsClient.Set(instance.GenerateProjectTagsSetOptions(project.Project.Key, cr.Spec.ForProvider.Tags)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(tagsResp)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(tagsResp, err), errSetTags)
+		}
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update updates the external resource to match the desired state of the managed resource
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProject)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for Project %s", cr.Name)
+	}
+
+	// Rename the Project key first so that the following updates target the desired key
+	if cr.Spec.ForProvider.Key != externalName {
+		updateKeyResp, err := c.projectsClient.UpdateKey(&sonargo.ProjectsUpdateKeyOption{ //nolint:bodyclose // closed via helpers.CloseBody
+			From: externalName,
+			To:   cr.Spec.ForProvider.Key,
+		})
+		defer helpers.CloseBody(updateKeyResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(updateKeyResp, err), errUpdateKey)
+		}
+		externalName = cr.Spec.ForProvider.Key
+		meta.SetExternalName(cr, externalName)
+	}
+
+	if !helpers.IsComparablePtrEqualComparable(cr.Spec.ForProvider.Visibility, cr.Status.AtProvider.Visibility) {
+		visibilityResp, err := c.projectsClient.UpdateVisibility(&sonargo.ProjectsUpdateVisibilityOption{ //nolint:bodyclose // closed via helpers.CloseBody
+			Project:    externalName,
+			Visibility: *cr.Spec.ForProvider.Visibility,
+		})
+		defer helpers.CloseBody(visibilityResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(visibilityResp, err), errUpdateVisibility)
+		}
+	}
+
+	if !helpers.IsComparablePtrEqualComparable(cr.Spec.ForProvider.MainBranch, cr.Status.AtProvider.MainBranch) {
+		renameResp, err := c.projectBranchesClient.Rename(&sonargo.ProjectBranchesRenameOption{ //nolint:bodyclose // closed via helpers.CloseBody
+			Project: externalName,
+			Name:    *cr.Spec.ForProvider.MainBranch,
+		})
+		defer helpers.CloseBody(renameResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(renameResp, err), errRenameMainBranch)
+		}
+	}
+
+	if cr.Spec.ForProvider.Tags != nil && !helpers.IsComparableSliceEqualIgnoringOrder(cr.Spec.ForProvider.Tags, cr.Status.AtProvider.Tags) {
+		tagsResp, err := c.projectTagsClient.Set(instance.GenerateProjectTagsSetOptions(externalName, cr.Spec.ForProvider.Tags)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(tagsResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(tagsResp, err), errSetTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the external resource
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotProject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// Use external name as the identifier to delete the resource
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	deleteResp, err := c.projectsClient.Delete(&sonargo.ProjectsDeleteOption{ //nolint:bodyclose // closed via helpers.CloseBody
+		Project: externalName,
+	})
+	defer helpers.CloseBody(deleteResp)
+	if err != nil {
+		err = common.NewAPIError(deleteResp, err)
+		// The project is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteProject)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
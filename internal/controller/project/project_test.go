@@ -0,0 +1,589 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notProject struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newProject(externalName string, params v1alpha1.ProjectParameters) *v1alpha1.Project {
+	p := &v1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-project",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.ProjectSpec{
+			ForProvider: params,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(p, externalName)
+	}
+	return p
+}
+
+// roundTrip returns the Project as stored by the API server, which drops the fields omitted from its JSON
+func roundTrip(cr *v1alpha1.Project) *v1alpha1.Project {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		panic(err)
+	}
+	stored := &v1alpha1.Project{}
+	if err := json.Unmarshal(raw, stored); err != nil {
+		panic(err)
+	}
+	return stored
+}
+
+func searchReturning(projects ...sonargo.ProjectsSearchObject_sub1) func(opt *sonargo.ProjectsSearchOption) (*sonargo.ProjectsSearchObject, *http.Response, error) {
+	return func(opt *sonargo.ProjectsSearchOption) (*sonargo.ProjectsSearchObject, *http.Response, error) {
+		return &sonargo.ProjectsSearchObject{Components: projects}, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		projects   *fake.MockProjectsClient
+		branches   *fake.MockProjectBranchesClient
+		components *fake.MockComponentsClient
+	}
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	mainBranch := &fake.MockProjectBranchesClient{
+		ListFn: func(opt *sonargo.ProjectBranchesListOption) (*sonargo.ProjectBranchesListObject, *http.Response, error) {
+			return &sonargo.ProjectBranchesListObject{
+				Branches: []sonargo.ProjectBranchesListObject_sub2{{Name: "main", IsMain: true}},
+			}, nil, nil
+		},
+	}
+	financeTags := &fake.MockComponentsClient{
+		SearchProjectsFn: func(opt *sonargo.ComponentsSearchProjectsOption) (*sonargo.ComponentsSearchProjectsObject, *http.Response, error) {
+			return &sonargo.ComponentsSearchProjectsObject{
+				Components: []sonargo.ComponentsSearchProjectsObject_sub1{{Key: "my-project", Tags: []string{"finance"}}},
+			}, nil, nil
+		},
+	}
+
+	cases := map[string]struct {
+		fields fields
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectError": {
+			fields: fields{},
+			mg:     &notProject{},
+			want: want{
+				err: errors.New(errNotProject),
+			},
+		},
+		"EmptyExternalNameReturnsNotExists": {
+			fields: fields{},
+			mg:     newProject("", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchNotFoundReturnsNotExists": {
+			fields: fields{
+				projects: &fake.MockProjectsClient{
+					SearchFn: func(opt *sonargo.ProjectsSearchOption) (*sonargo.ProjectsSearchObject, *http.Response, error) {
+						return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+					},
+				},
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchFailsReturnsError": {
+			fields: fields{
+				projects: &fake.MockProjectsClient{
+					SearchFn: func(opt *sonargo.ProjectsSearchOption) (*sonargo.ProjectsSearchObject, *http.Response, error) {
+						return nil, &http.Response{StatusCode: http.StatusServiceUnavailable}, errors.New("unavailable")
+					},
+				},
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: want{
+				err: errors.Wrap(errors.New("unavailable"), errSearchProject),
+			},
+		},
+		"EmptySearchReturnsNotExists": {
+			fields: fields{
+				projects: &fake.MockProjectsClient{SearchFn: searchReturning()},
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListBranchesFailsReturnsError": {
+			fields: fields{
+				projects: &fake.MockProjectsClient{SearchFn: searchReturning(sonargo.ProjectsSearchObject_sub1{Key: "my-project"})},
+				branches: &fake.MockProjectBranchesClient{
+					ListFn: func(opt *sonargo.ProjectBranchesListOption) (*sonargo.ProjectBranchesListObject, *http.Response, error) {
+						return nil, nil, errors.New("list error")
+					},
+				},
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: want{
+				err: errors.Wrap(errors.New("list error"), errListBranches),
+			},
+		},
+		"UpToDate": {
+			fields: fields{
+				projects:   &fake.MockProjectsClient{SearchFn: searchReturning(sonargo.ProjectsSearchObject_sub1{Key: "my-project", Name: "My Project", Visibility: "public"})},
+				branches:   mainBranch,
+				components: financeTags,
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-project",
+				Name:       "My Project",
+				Visibility: ptr.To("public"),
+				MainBranch: ptr.To("main"),
+				Tags:       []string{"finance"},
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitializesVisibilityAndMainBranch": {
+			fields: fields{
+				projects:   &fake.MockProjectsClient{SearchFn: searchReturning(sonargo.ProjectsSearchObject_sub1{Key: "my-project", Name: "My Project", Visibility: "public"})},
+				branches:   mainBranch,
+				components: financeTags,
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"VisibilityDrift": {
+			fields: fields{
+				projects:   &fake.MockProjectsClient{SearchFn: searchReturning(sonargo.ProjectsSearchObject_sub1{Key: "my-project", Name: "My Project", Visibility: "public"})},
+				branches:   mainBranch,
+				components: financeTags,
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-project",
+				Name:       "My Project",
+				Visibility: ptr.To("private"),
+				MainBranch: ptr.To("main"),
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"KeyRenamed": {
+			fields: fields{
+				projects:   &fake.MockProjectsClient{SearchFn: searchReturning(sonargo.ProjectsSearchObject_sub1{Key: "my-project", Name: "My Project", Visibility: "public"})},
+				branches:   mainBranch,
+				components: financeTags,
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-renamed-project",
+				Name:       "My Project",
+				Visibility: ptr.To("public"),
+				MainBranch: ptr.To("main"),
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"AdoptsUpdatedKey": {
+			fields: fields{
+				projects:   &fake.MockProjectsClient{SearchFn: searchReturning(sonargo.ProjectsSearchObject_sub1{Key: "my-renamed-project", Name: "My Project", Visibility: "public"})},
+				branches:   mainBranch,
+				components: financeTags,
+			},
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-renamed-project",
+				Name:       "My Project",
+				Visibility: ptr.To("public"),
+				MainBranch: ptr.To("main"),
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				projectsClient:        tc.fields.projects,
+				projectBranchesClient: tc.fields.branches,
+				componentsClient:      tc.fields.components,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		projects *fake.MockProjectsClient
+		tags     *fake.MockProjectTagsClient
+		mg       resource.Managed
+		want     want
+	}{
+		"NotProjectError": {
+			mg: &notProject{},
+			want: want{
+				err: errors.New(errNotProject),
+			},
+		},
+		"CreateFails": {
+			projects: &fake.MockProjectsClient{
+				CreateFn: func(opt *sonargo.ProjectsCreateOption) (*sonargo.ProjectsCreateObject, *http.Response, error) {
+					return nil, nil, errors.New("create error")
+				},
+			},
+			mg: newProject("", v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project"}),
+			want: want{
+				err: errors.Wrap(errors.New("create error"), errCreateProject),
+			},
+		},
+		"SuccessfulCreateSetsExternalName": {
+			projects: &fake.MockProjectsClient{
+				CreateFn: func(opt *sonargo.ProjectsCreateOption) (*sonargo.ProjectsCreateObject, *http.Response, error) {
+					return &sonargo.ProjectsCreateObject{Project: sonargo.ProjectsCreateObject_sub1{Key: opt.Project, Name: opt.Name}}, nil, nil
+				},
+			},
+			tags: &fake.MockProjectTagsClient{
+				SetFn: func(opt *sonargo.ProjectTagsSetOption) (*http.Response, error) {
+					return nil, errors.New("tags should not be set when none are specified")
+				},
+			},
+			mg: newProject("", v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project"}),
+			want: want{
+				externalName: "my-project",
+			},
+		},
+		"CreateWithTags": {
+			projects: &fake.MockProjectsClient{
+				CreateFn: func(opt *sonargo.ProjectsCreateOption) (*sonargo.ProjectsCreateObject, *http.Response, error) {
+					return &sonargo.ProjectsCreateObject{Project: sonargo.ProjectsCreateObject_sub1{Key: opt.Project, Name: opt.Name}}, nil, nil
+				},
+			},
+			tags: &fake.MockProjectTagsClient{
+				SetFn: func(opt *sonargo.ProjectTagsSetOption) (*http.Response, error) {
+					if opt.Project != "my-project" || opt.Tags != "finance,offshore" {
+						return nil, errors.Errorf("unexpected tags option: %+v", opt)
+					}
+					return nil, nil
+				},
+			},
+			mg: newProject("", v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project", Tags: []string{"finance", "offshore"}}),
+			want: want{
+				externalName: "my-project",
+			},
+		},
+		"SetTagsFails": {
+			projects: &fake.MockProjectsClient{
+				CreateFn: func(opt *sonargo.ProjectsCreateOption) (*sonargo.ProjectsCreateObject, *http.Response, error) {
+					return &sonargo.ProjectsCreateObject{Project: sonargo.ProjectsCreateObject_sub1{Key: opt.Project, Name: opt.Name}}, nil, nil
+				},
+			},
+			tags: &fake.MockProjectTagsClient{
+				SetFn: func(opt *sonargo.ProjectTagsSetOption) (*http.Response, error) {
+					return nil, errors.New("tags error")
+				},
+			},
+			mg: newProject("", v1alpha1.ProjectParameters{Key: "my-project", Name: "My Project", Tags: []string{"finance"}}),
+			want: want{
+				externalName: "my-project",
+				err:          errors.Wrap(errors.New("tags error"), errSetTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{projectsClient: tc.projects, projectTagsClient: tc.tags}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Project); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Create() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		externalName string
+		calls        []string
+		err          error
+	}
+
+	upToDate := v1alpha1.ProjectObservation{
+		Key:        "my-project",
+		Name:       "My Project",
+		Visibility: "public",
+		MainBranch: "main",
+		Tags:       []string{"finance"},
+	}
+
+	cases := map[string]struct {
+		mg          *v1alpha1.Project
+		observation v1alpha1.ProjectObservation
+		updateKey   error
+		want        want
+	}{
+		"EmptyExternalNameReturnsError": {
+			mg: newProject("", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: want{
+				err: fmt.Errorf("external name is not set for Project %s", "test-project"),
+			},
+		},
+		"NothingToUpdate": {
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-project",
+				Name:       "My Project",
+				Visibility: ptr.To("public"),
+				MainBranch: ptr.To("main"),
+				Tags:       []string{"finance"},
+			}),
+			observation: upToDate,
+			want: want{
+				externalName: "my-project",
+			},
+		},
+		"RenameKeyUpdatesExternalName": {
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-renamed-project",
+				Name:       "My Project",
+				Visibility: ptr.To("public"),
+				MainBranch: ptr.To("main"),
+			}),
+			observation: upToDate,
+			want: want{
+				externalName: "my-renamed-project",
+				calls:        []string{"UpdateKey:my-project->my-renamed-project"},
+			},
+		},
+		"RenameKeyFails": {
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:  "my-renamed-project",
+				Name: "My Project",
+			}),
+			observation: upToDate,
+			updateKey:   errors.New("update key error"),
+			want: want{
+				externalName: "my-project",
+				calls:        []string{"UpdateKey:my-project->my-renamed-project"},
+				err:          errors.Wrap(errors.New("update key error"), errUpdateKey),
+			},
+		},
+		"ClearTags": {
+			mg: roundTrip(newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-project",
+				Name:       "My Project",
+				Visibility: ptr.To("public"),
+				MainBranch: ptr.To("main"),
+				Tags:       []string{},
+			})),
+			observation: upToDate,
+			want: want{
+				externalName: "my-project",
+				calls:        []string{"SetTags:my-project="},
+			},
+		},
+		"UpdateVisibilityBranchAndTags": {
+			mg: newProject("my-project", v1alpha1.ProjectParameters{
+				Key:        "my-project",
+				Name:       "My Project",
+				Visibility: ptr.To("private"),
+				MainBranch: ptr.To("develop"),
+				Tags:       []string{"offshore"},
+			}),
+			observation: upToDate,
+			want: want{
+				externalName: "my-project",
+				calls: []string{
+					"UpdateVisibility:my-project=private",
+					"Rename:my-project=develop",
+					"SetTags:my-project=offshore",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{
+				projectsClient: &fake.MockProjectsClient{
+					UpdateKeyFn: func(opt *sonargo.ProjectsUpdateKeyOption) (*http.Response, error) {
+						calls = append(calls, "UpdateKey:"+opt.From+"->"+opt.To)
+						return nil, tc.updateKey
+					},
+					UpdateVisibilityFn: func(opt *sonargo.ProjectsUpdateVisibilityOption) (*http.Response, error) {
+						calls = append(calls, "UpdateVisibility:"+opt.Project+"="+opt.Visibility)
+						return nil, nil
+					},
+				},
+				projectBranchesClient: &fake.MockProjectBranchesClient{
+					RenameFn: func(opt *sonargo.ProjectBranchesRenameOption) (*http.Response, error) {
+						calls = append(calls, "Rename:"+opt.Project+"="+opt.Name)
+						return nil, nil
+					},
+				},
+				projectTagsClient: &fake.MockProjectTagsClient{
+					SetFn: func(opt *sonargo.ProjectTagsSetOption) (*http.Response, error) {
+						calls = append(calls, "SetTags:"+opt.Project+"="+opt.Tags)
+						return nil, nil
+					},
+				},
+			}
+			tc.mg.Status.AtProvider = tc.observation
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Update() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update() calls mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("Update() external name mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockProjectsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotProjectError": {
+			client: &fake.MockProjectsClient{},
+			mg:     &notProject{},
+			want:   errors.New(errNotProject),
+		},
+		"EmptyExternalNameDoesNothing": {
+			client: &fake.MockProjectsClient{},
+			mg:     newProject("", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want:   nil,
+		},
+		"SuccessfulDelete": {
+			client: &fake.MockProjectsClient{
+				DeleteFn: func(opt *sonargo.ProjectsDeleteOption) (*http.Response, error) {
+					if opt.Project != "my-project" {
+						return nil, errors.New("expected external name 'my-project' but got: " + opt.Project)
+					}
+					return nil, nil
+				},
+			},
+			mg:   newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: nil,
+		},
+		"DeleteNotFoundSucceeds": {
+			client: &fake.MockProjectsClient{
+				DeleteFn: func(opt *sonargo.ProjectsDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg:   newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: nil,
+		},
+		"DeleteFails": {
+			client: &fake.MockProjectsClient{
+				DeleteFn: func(opt *sonargo.ProjectsDeleteOption) (*http.Response, error) {
+					return nil, errors.New("delete error")
+				},
+			},
+			mg:   newProject("my-project", v1alpha1.ProjectParameters{Key: "my-project"}),
+			want: errors.Wrap(errors.New("delete error"), errDeleteProject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{projectsClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
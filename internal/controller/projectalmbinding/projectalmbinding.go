@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectalmbinding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotProjectAlmBinding = "managed resource is not a ProjectAlmBinding custom resource"
+	errTrackPCUsage         = "cannot track ProviderConfig usage"
+	errGetPC                = "cannot get ProviderConfig"
+
+	errGetBinding             = "cannot get SonarQube Project binding"
+	errListAlmSettings        = "cannot list SonarQube DevOps Platform Settings"
+	errSetBinding             = "cannot set SonarQube Project binding"
+	errDeleteBinding          = "cannot delete SonarQube Project binding"
+	errAlmSettingNotFound     = "DevOps Platform Setting %s does not exist"
+	errUnsupportedAlmPlatform = "unsupported DevOps Platform %s"
+)
+
+// SetupGated adds a controller that reconciles ProjectAlmBinding managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup ProjectAlmBinding controller"))
+		}
+	}, v1alpha1.ProjectAlmBindingGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectAlmBindingGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewAlmSettingsClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ProjectAlmBindingList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.ProjectAlmBindingList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.ProjectAlmBindingGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.ProjectAlmBinding{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.AlmSettingsClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAlmBinding)
+	if !ok {
+		return nil, errors.New(errNotProjectAlmBinding)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{almSettingsClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// almSettingsClient is used to interact with SonarQube DevOps Platform Settings API
+	almSettingsClient instance.AlmSettingsClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAlmBinding)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectAlmBinding)
+	}
+
+	binding, resp, err := c.almSettingsClient.GetBinding(instance.GenerateProjectAlmBindingGetOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Project is not bound, or is gone along with its binding
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetBinding)
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateProjectAlmBindingObservation(binding)
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeProjectAlmBinding(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsProjectAlmBindingUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// Create binds the Project to its repository
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAlmBinding)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectAlmBinding)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.setBinding(cr.Spec.ForProvider)
+}
+
+// Update binds the Project to its repository again, replacing the previous binding
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAlmBinding)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectAlmBinding)
+	}
+
+	return managed.ExternalUpdate{}, c.setBinding(cr.Spec.ForProvider)
+}
+
+// setBinding binds the Project to its repository with the endpoint of the DevOps Platform of its AlmSetting
+func (c *external) setBinding(spec v1alpha1.ProjectAlmBindingParameters) error {
+	// The binding endpoint depends on the DevOps Platform, which is only known by the DevOps Platform Setting
+	list, resp, err := c.almSettingsClient.List(instance.GenerateProjectAlmBindingListOption(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return errors.Wrap(common.NewAPIError(resp, err), errListAlmSettings)
+	}
+	alm := instance.FindAlmSettingAlm(list, ptr.Deref(spec.AlmSetting, ""))
+	if alm == "" {
+		return fmt.Errorf(errAlmSettingNotFound, ptr.Deref(spec.AlmSetting, ""))
+	}
+
+	setResp, err := c.set(alm, spec) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(setResp)
+	if err != nil {
+		return errors.Wrap(common.NewAPIError(setResp, err), errSetBinding)
+	}
+	return nil
+}
+
+// set calls the binding endpoint of the DevOps Platform
+func (c *external) set(alm string, spec v1alpha1.ProjectAlmBindingParameters) (*http.Response, error) {
+	switch alm {
+	case v1alpha1.AlmGitHub:
+		return c.almSettingsClient.SetGithubBinding(instance.GenerateProjectAlmBindingSetGithubOption(spec))
+	case v1alpha1.AlmGitLab:
+		return c.almSettingsClient.SetGitlabBinding(instance.GenerateProjectAlmBindingSetGitlabOption(spec))
+	case v1alpha1.AlmAzureDevOps:
+		return c.almSettingsClient.SetAzureBinding(instance.GenerateProjectAlmBindingSetAzureOption(spec))
+	case v1alpha1.AlmBitbucketServer:
+		return c.almSettingsClient.SetBitbucketBinding(instance.GenerateProjectAlmBindingSetBitbucketOption(spec))
+	case v1alpha1.AlmBitbucketCloud:
+		return c.almSettingsClient.SetBitbucketcloudBinding(instance.GenerateProjectAlmBindingSetBitbucketcloudOption(spec))
+	default:
+		return nil, fmt.Errorf(errUnsupportedAlmPlatform, alm)
+	}
+}
+
+// Delete unbinds the Project from its repository
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAlmBinding)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotProjectAlmBinding)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	resp, err := c.almSettingsClient.DeleteBinding(instance.GenerateProjectAlmBindingDeleteOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Project is already unbound, or gone along with its binding
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteBinding)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectalmbinding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notProjectAlmBinding struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newProjectAlmBinding(params v1alpha1.ProjectAlmBindingParameters, observation v1alpha1.ProjectAlmBindingObservation) *v1alpha1.ProjectAlmBinding {
+	return &v1alpha1.ProjectAlmBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-project-alm-binding",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.ProjectAlmBindingSpec{
+			ForProvider: params,
+		},
+		Status: v1alpha1.ProjectAlmBindingStatus{
+			AtProvider: observation,
+		},
+	}
+}
+
+func listReturning(settings ...sonargo.AlmSettingsListObject_sub1) func(opt *sonargo.AlmSettingsListOption) (*sonargo.AlmSettingsListObject, *http.Response, error) {
+	return func(opt *sonargo.AlmSettingsListOption) (*sonargo.AlmSettingsListObject, *http.Response, error) {
+		return &sonargo.AlmSettingsListObject{AlmSettings: settings}, nil, nil
+	}
+}
+
+var (
+	githubParams = v1alpha1.ProjectAlmBindingParameters{
+		ProjectKey:            ptr.To("my-project"),
+		AlmSetting:            ptr.To("github"),
+		Repository:            "my-org/my-repo",
+		SummaryCommentEnabled: ptr.To(true),
+		Monorepo:              ptr.To(false),
+	}
+	githubBinding = instance.AlmSettingsGetBindingObject{
+		Alm:                   v1alpha1.AlmGitHub,
+		Key:                   "github",
+		Repository:            "my-org/my-repo",
+		SummaryCommentEnabled: true,
+		URL:                   "https://api.github.com/",
+	}
+	githubSetting = sonargo.AlmSettingsListObject_sub1{Alm: v1alpha1.AlmGitHub, Key: "github", URL: "https://api.github.com/"}
+	gitlabSetting = sonargo.AlmSettingsListObject_sub1{Alm: v1alpha1.AlmGitLab, Key: "gitlab", URL: "https://gitlab.com/api/v4"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.ProjectAlmBindingObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockAlmSettingsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectAlmBindingError": {
+			client: &fake.MockAlmSettingsClient{},
+			mg:     &notProjectAlmBinding{},
+			want: want{
+				err: errors.New(errNotProjectAlmBinding),
+			},
+		},
+		"NotBound": {
+			client: &fake.MockAlmSettingsClient{
+				GetBindingFn: func(opt *sonargo.AlmSettingsGetBindingOption) (*instance.AlmSettingsGetBindingObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetBindingFailsReturnsError": {
+			client: &fake.MockAlmSettingsClient{
+				GetBindingFn: func(opt *sonargo.AlmSettingsGetBindingOption) (*instance.AlmSettingsGetBindingObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errGetBinding),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockAlmSettingsClient{
+				GetBindingFn: func(opt *sonargo.AlmSettingsGetBindingOption) (*instance.AlmSettingsGetBindingObject, *http.Response, error) {
+					binding := githubBinding
+					return &binding, nil, nil
+				},
+			},
+			mg: newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: instance.GenerateProjectAlmBindingObservation(&githubBinding),
+			},
+		},
+		"RepositoryChangedNeedsUpdate": {
+			client: &fake.MockAlmSettingsClient{
+				GetBindingFn: func(opt *sonargo.AlmSettingsGetBindingOption) (*instance.AlmSettingsGetBindingObject, *http.Response, error) {
+					binding := githubBinding
+					binding.Repository = "my-org/old-repo"
+					return &binding, nil, nil
+				},
+			},
+			mg: newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.ProjectAlmBindingObservation{
+					Alm:                   v1alpha1.AlmGitHub,
+					AlmSetting:            "github",
+					Repository:            "my-org/old-repo",
+					SummaryCommentEnabled: true,
+					URL:                   "https://api.github.com/",
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockAlmSettingsClient{
+				GetBindingFn: func(opt *sonargo.AlmSettingsGetBindingOption) (*instance.AlmSettingsGetBindingObject, *http.Response, error) {
+					binding := githubBinding
+					return &binding, nil, nil
+				},
+			},
+			mg: newProjectAlmBinding(v1alpha1.ProjectAlmBindingParameters{
+				ProjectKey: ptr.To("my-project"),
+				AlmSetting: ptr.To("github"),
+				Repository: "my-org/my-repo",
+			}, v1alpha1.ProjectAlmBindingObservation{}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				observation: instance.GenerateProjectAlmBindingObservation(&githubBinding),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{almSettingsClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.ProjectAlmBinding); ok && err == nil {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockAlmSettingsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotProjectAlmBindingError": {
+			client: &fake.MockAlmSettingsClient{},
+			mg:     &notProjectAlmBinding{},
+			want:   errors.New(errNotProjectAlmBinding),
+		},
+		"SetGithubBinding": {
+			client: &fake.MockAlmSettingsClient{
+				ListFn: listReturning(gitlabSetting, githubSetting),
+				SetGithubBindingFn: func(opt *instance.AlmSettingsSetGithubBindingOption) (*http.Response, error) {
+					if opt.AlmSetting != "github" || opt.Project != "my-project" || opt.Repository != "my-org/my-repo" {
+						return nil, errors.Errorf("unexpected option %+v", opt)
+					}
+					return nil, nil
+				},
+			},
+			mg: newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+		},
+		"SetGitlabBinding": {
+			client: &fake.MockAlmSettingsClient{
+				ListFn: listReturning(gitlabSetting, githubSetting),
+				SetGitlabBindingFn: func(opt *instance.AlmSettingsSetGitlabBindingOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newProjectAlmBinding(v1alpha1.ProjectAlmBindingParameters{
+				ProjectKey: ptr.To("my-project"),
+				AlmSetting: ptr.To("gitlab"),
+				Repository: "12345",
+			}, v1alpha1.ProjectAlmBindingObservation{}),
+		},
+		"ListFailsReturnsError": {
+			client: &fake.MockAlmSettingsClient{
+				ListFn: func(opt *sonargo.AlmSettingsListOption) (*sonargo.AlmSettingsListObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg:   newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: errors.Wrap(errors.New("forbidden"), errListAlmSettings),
+		},
+		"AlmSettingNotFoundReturnsError": {
+			client: &fake.MockAlmSettingsClient{
+				ListFn: listReturning(gitlabSetting),
+			},
+			mg:   newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: fmt.Errorf(errAlmSettingNotFound, "github"),
+		},
+		"SetBindingFailsReturnsError": {
+			client: &fake.MockAlmSettingsClient{
+				ListFn: listReturning(githubSetting),
+				SetGithubBindingFn: func(opt *instance.AlmSettingsSetGithubBindingOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("bad request")
+				},
+			},
+			mg:   newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: errors.Wrap(errors.New("bad request"), errSetBinding),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{almSettingsClient: tc.client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.ProjectAlmBinding); ok && err == nil {
+				if diff := cmp.Diff(xpv1.Creating(), cr.Status.GetCondition(xpv1.TypeReady), cmp.Comparer(func(a, b metav1.Time) bool { return true })); diff != "" {
+					t.Errorf("\ne.Create(...): -want condition, +got condition:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockAlmSettingsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotProjectAlmBindingError": {
+			client: &fake.MockAlmSettingsClient{},
+			mg:     &notProjectAlmBinding{},
+			want:   errors.New(errNotProjectAlmBinding),
+		},
+		"SetAzureBinding": {
+			client: &fake.MockAlmSettingsClient{
+				ListFn: listReturning(sonargo.AlmSettingsListObject_sub1{Alm: v1alpha1.AlmAzureDevOps, Key: "azure"}),
+				SetAzureBindingFn: func(opt *instance.AlmSettingsSetAzureBindingOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newProjectAlmBinding(v1alpha1.ProjectAlmBindingParameters{
+				ProjectKey: ptr.To("my-project"),
+				AlmSetting: ptr.To("azure"),
+				Repository: "my-repo",
+				Slug:       ptr.To("my-azure-project"),
+			}, v1alpha1.ProjectAlmBindingObservation{}),
+		},
+		"UnsupportedAlmReturnsError": {
+			client: &fake.MockAlmSettingsClient{
+				ListFn: listReturning(sonargo.AlmSettingsListObject_sub1{Alm: "unknown", Key: "github"}),
+			},
+			mg:   newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: errors.Wrap(fmt.Errorf(errUnsupportedAlmPlatform, "unknown"), errSetBinding),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{almSettingsClient: tc.client}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockAlmSettingsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotProjectAlmBindingError": {
+			client: &fake.MockAlmSettingsClient{},
+			mg:     &notProjectAlmBinding{},
+			want:   errors.New(errNotProjectAlmBinding),
+		},
+		"Delete": {
+			client: &fake.MockAlmSettingsClient{
+				DeleteBindingFn: func(opt *instance.AlmSettingsDeleteBindingOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockAlmSettingsClient{
+				DeleteBindingFn: func(opt *instance.AlmSettingsDeleteBindingOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+		},
+		"DeleteFailsReturnsError": {
+			client: &fake.MockAlmSettingsClient{
+				DeleteBindingFn: func(opt *instance.AlmSettingsDeleteBindingOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg:   newProjectAlmBinding(githubParams, v1alpha1.ProjectAlmBindingObservation{}),
+			want: errors.Wrap(errors.New("forbidden"), errDeleteBinding),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{almSettingsClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
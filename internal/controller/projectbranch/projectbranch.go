@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectbranch
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotProjectBranch = "managed resource is not a ProjectBranch custom resource"
+	errTrackPCUsage     = "cannot track ProviderConfig usage"
+	errGetPC            = "cannot get ProviderConfig"
+
+	errListProjectBranches  = "cannot list SonarQube Project branches"
+	errRenameProjectBranch  = "cannot rename SonarQube Project main branch"
+	errSetMainProjectBranch = "cannot set SonarQube Project main branch"
+	errProtectProjectBranch = "cannot set SonarQube Project branch automatic deletion protection"
+	errDeleteProjectBranch  = "cannot delete SonarQube Project branch"
+	errBranchNotAnalyzed    = "branch %s of Project %s does not exist, branches are created by analyzing them"
+)
+
+// SetupGated adds a controller that reconciles ProjectBranch managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup ProjectBranch controller"))
+		}
+	}, v1alpha1.ProjectBranchGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectBranchGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewProjectBranchesClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ProjectBranchList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.ProjectBranchList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.ProjectBranchGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.ProjectBranch{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.ProjectBranchesClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBranch)
+	if !ok {
+		return nil, errors.New(errNotProjectBranch)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{projectBranchesClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// projectBranchesClient is used to interact with SonarQube Project Branches API
+	projectBranchesClient instance.ProjectBranchesClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBranch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectBranch)
+	}
+
+	branches, resp, err := c.projectBranchesClient.List(instance.GenerateProjectBranchListOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Project the branch belongs to is gone, and so is the branch
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errListProjectBranches)
+	}
+
+	branch := instance.FindProjectBranch(branches, &cr.Spec.ForProvider, &cr.Status.AtProvider)
+	if branch == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// The main branch cannot be deleted on its own, it is released and deleted along with its Project
+	if meta.WasDeleted(cr) && branch.IsMain {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateProjectBranchObservation(branch)
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeProjectBranch(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsProjectBranchUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// Create cannot create the branch, which SonarQube only creates when analyzing it
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBranch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectBranch)
+	}
+
+	return managed.ExternalCreation{}, fmt.Errorf(errBranchNotAnalyzed, cr.Spec.ForProvider.Name, ptr.Deref(cr.Spec.ForProvider.ProjectKey, ""))
+}
+
+// Update renames the main branch, makes the branch the main one and protects it from automatic deletion, as needed
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBranch)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectBranch)
+	}
+
+	spec := &cr.Spec.ForProvider
+	observation := &cr.Status.AtProvider
+
+	if instance.IsProjectBranchRenamed(spec, observation) {
+		resp, err := c.projectBranchesClient.Rename(instance.GenerateProjectBranchRenameOption(*spec)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errRenameProjectBranch)
+		}
+	}
+
+	if instance.IsProjectBranchMainMissing(spec, observation) {
+		resp, err := c.projectBranchesClient.SetMain(instance.GenerateProjectBranchSetMainOption(*spec)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errSetMainProjectBranch)
+		}
+	}
+
+	if instance.IsProjectBranchProtectionOutdated(spec, observation) {
+		resp, err := c.projectBranchesClient.SetAutomaticDeletionProtection(instance.GenerateProjectBranchSetAutomaticDeletionProtectionOption(*spec)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errProtectProjectBranch)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the branch, which is not the main one
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBranch)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotProjectBranch)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	resp, err := c.projectBranchesClient.Delete(instance.GenerateProjectBranchDeleteOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The branch or its Project is already gone
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteProjectBranch)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
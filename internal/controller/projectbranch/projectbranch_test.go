@@ -0,0 +1,368 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectbranch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notProjectBranch struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newProjectBranch(params v1alpha1.ProjectBranchParameters) *v1alpha1.ProjectBranch {
+	return &v1alpha1.ProjectBranch{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-project-branch",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.ProjectBranchSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func listReturning(branches ...sonargo.ProjectBranchesListObject_sub2) func(opt *sonargo.ProjectBranchesListOption) (*sonargo.ProjectBranchesListObject, *http.Response, error) {
+	return func(opt *sonargo.ProjectBranchesListOption) (*sonargo.ProjectBranchesListObject, *http.Response, error) {
+		return &sonargo.ProjectBranchesListObject{Branches: branches}, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.ProjectBranchObservation
+		spec        v1alpha1.ProjectBranchParameters
+		err         error
+	}
+
+	master := sonargo.ProjectBranchesListObject_sub2{Name: "master", BranchID: "b0", IsMain: true, ExcludedFromPurge: true, Type: "BRANCH", AnalysisDate: "2026-01-01T00:00:00+0000", Status: sonargo.ProjectBranchesListObject_sub1{QualityGateStatus: "OK"}}
+	release := sonargo.ProjectBranchesListObject_sub2{Name: "release-1.0", BranchID: "b1", Type: "BRANCH", Status: sonargo.ProjectBranchesListObject_sub1{QualityGateStatus: "ERROR"}}
+	observedMain := newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "main", IsMain: ptr.To(true), ExcludedFromPurge: ptr.To(true)})
+	observedMain.Status.AtProvider = v1alpha1.ProjectBranchObservation{Name: "master", BranchID: "b0", IsMain: true}
+	observedRelease := newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-2.0", IsMain: ptr.To(true)})
+	observedRelease.Status.AtProvider = v1alpha1.ProjectBranchObservation{Name: "release-1.0", BranchID: "b1"}
+	deletedMain := newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "master"})
+	deletedMain.SetDeletionTimestamp(ptr.To(metav1.Now()))
+
+	cases := map[string]struct {
+		client *fake.MockProjectBranchesClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectBranchError": {
+			client: &fake.MockProjectBranchesClient{},
+			mg:     &notProjectBranch{},
+			want: want{
+				err: errors.New(errNotProjectBranch),
+			},
+		},
+		"ListFailsReturnsError": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: func(opt *sonargo.ProjectBranchesListOption) (*sonargo.ProjectBranchesListObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errListProjectBranches),
+			},
+		},
+		"MissingProjectReturnsNotExists": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: func(opt *sonargo.ProjectBranchesListOption) (*sonargo.ProjectBranchesListObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MissingBranchReturnsNotExists": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: listReturning(master),
+			},
+			mg: newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ExistingIsLateInitialized": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: listReturning(master, release),
+			},
+			mg: newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				observation: v1alpha1.ProjectBranchObservation{BranchID: "b1", Name: "release-1.0", Type: "BRANCH", QualityGateStatus: "ERROR"},
+				spec:        v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0", ExcludedFromPurge: ptr.To(false)},
+			},
+		},
+		"MainBranchToRename": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: listReturning(master, release),
+			},
+			mg: newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "main", IsMain: ptr.To(true), ExcludedFromPurge: ptr.To(true)}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.ProjectBranchObservation{BranchID: "b0", Name: "master", IsMain: true, ExcludedFromPurge: true, Type: "BRANCH", AnalysisDate: "2026-01-01T00:00:00+0000", QualityGateStatus: "OK"},
+				spec:        v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "main", IsMain: ptr.To(true), ExcludedFromPurge: ptr.To(true)},
+			},
+		},
+		"ObservedBranchMadeMainReturnsNotExists": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: listReturning(master, release),
+			},
+			mg: observedRelease,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ObservedMainBranchToRename": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: listReturning(master, release),
+			},
+			mg: observedMain,
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.ProjectBranchObservation{BranchID: "b0", Name: "master", IsMain: true, ExcludedFromPurge: true, Type: "BRANCH", AnalysisDate: "2026-01-01T00:00:00+0000", QualityGateStatus: "OK"},
+				spec:        v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "main", IsMain: ptr.To(true), ExcludedFromPurge: ptr.To(true)},
+			},
+		},
+		"DeletedMainBranchReturnsNotExists": {
+			client: &fake.MockProjectBranchesClient{
+				ListFn: listReturning(master, release),
+			},
+			mg: deletedMain,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{projectBranchesClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.ProjectBranch); ok && tc.want.err == nil && tc.want.o.ResourceExists {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.spec, cr.Spec.ForProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want spec, +got spec:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want error
+	}{
+		"NotProjectBranchError": {
+			mg:   &notProjectBranch{},
+			want: errors.New(errNotProjectBranch),
+		},
+		"BranchNotAnalyzedError": {
+			mg:   newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+			want: fmt.Errorf(errBranchNotAnalyzed, "release-1.0", "my-project"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{projectBranchesClient: &fake.MockProjectBranchesClient{}}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		spec        v1alpha1.ProjectBranchParameters
+		observation v1alpha1.ProjectBranchObservation
+		fail        bool
+		want        want
+	}{
+		"RenamesMainBranch": {
+			spec:        v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "main", IsMain: ptr.To(true), ExcludedFromPurge: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "master", IsMain: true, ExcludedFromPurge: true},
+			want: want{
+				calls: []string{"Rename my-project main"},
+			},
+		},
+		"SetsMainBranch": {
+			spec:        v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "develop", IsMain: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "develop"},
+			want: want{
+				calls: []string{"SetMain my-project develop"},
+			},
+		},
+		"ProtectsBranch": {
+			spec:        v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0", ExcludedFromPurge: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "release-1.0"},
+			want: want{
+				calls: []string{"SetAutomaticDeletionProtection my-project release-1.0 true"},
+			},
+		},
+		"RenameFailsReturnsError": {
+			spec:        v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "main", IsMain: ptr.To(true)},
+			observation: v1alpha1.ProjectBranchObservation{Name: "master", IsMain: true},
+			fail:        true,
+			want: want{
+				calls: []string{"Rename my-project main"},
+				err:   errors.Wrap(errors.New("forbidden"), errRenameProjectBranch),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			result := func() (*http.Response, error) {
+				if tc.fail {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				}
+				return nil, nil
+			}
+			client := &fake.MockProjectBranchesClient{
+				RenameFn: func(opt *sonargo.ProjectBranchesRenameOption) (*http.Response, error) {
+					calls = append(calls, "Rename "+opt.Project+" "+opt.Name)
+					return result()
+				},
+				SetMainFn: func(opt *sonargo.ProjectBranchesSetMainOption) (*http.Response, error) {
+					calls = append(calls, "SetMain "+opt.Project+" "+opt.Branch)
+					return result()
+				},
+				SetAutomaticDeletionProtectionFn: func(opt *sonargo.ProjectBranchesSetAutomaticDeletionProtectionOption) (*http.Response, error) {
+					calls = append(calls, "SetAutomaticDeletionProtection "+opt.Project+" "+opt.Branch+" "+opt.Value)
+					return result()
+				},
+			}
+			mg := newProjectBranch(tc.spec)
+			mg.Status.AtProvider = tc.observation
+
+			e := external{projectBranchesClient: client}
+			_, err := e.Update(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\ne.Update(...): -want calls, +got calls:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockProjectBranchesClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotProjectBranchError": {
+			client: &fake.MockProjectBranchesClient{},
+			mg:     &notProjectBranch{},
+			want:   errors.New(errNotProjectBranch),
+		},
+		"Delete": {
+			client: &fake.MockProjectBranchesClient{
+				DeleteFn: func(opt *sonargo.ProjectBranchesDeleteOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+		},
+		"MissingBranch": {
+			client: &fake.MockProjectBranchesClient{
+				DeleteFn: func(opt *sonargo.ProjectBranchesDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+		},
+		"DeleteFailsReturnsError": {
+			client: &fake.MockProjectBranchesClient{
+				DeleteFn: func(opt *sonargo.ProjectBranchesDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg:   newProjectBranch(v1alpha1.ProjectBranchParameters{ProjectKey: ptr.To("my-project"), Name: "release-1.0"}),
+			want: errors.Wrap(errors.New("forbidden"), errDeleteProjectBranch),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{projectBranchesClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
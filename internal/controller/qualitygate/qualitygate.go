@@ -19,6 +19,7 @@ package qualitygate
 import (
 	"context"
 	"fmt"
+	"time"
 
 	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -51,12 +52,34 @@ const (
 	errGetPC          = "cannot get ProviderConfig"
 
 	errCreateQualityGate  = "cannot create SonarQube Quality Gate"
+	errCopyQualityGate    = "cannot copy SonarQube Quality Gate %s"
 	errDefaultQualityGate = "cannot set SonarQube Quality Gate as default"
 	errUpdateQualityGate  = "cannot update SonarQube Quality Gate"
 	errDeleteQualityGate  = "cannot delete SonarQube Quality Gate"
 	errShowQualityGate    = "cannot get SonarQube Quality Gate"
+	errRenameQualityGate  = "cannot rename SonarQube Quality Gate"
+	errAiCodeQualityGate  = "cannot set SonarQube Quality Gate AI Code Assurance"
+
+	errRenameBuiltInQualityGate   = "cannot rename SonarQube Quality Gate %s: built-in Quality Gates cannot be renamed"
+	errUpdateBuiltInQualityGate   = "cannot update SonarQube Quality Gate %s: built-in Quality Gates are read-only, only their default status and associated projects can be managed"
+	errDeleteBuiltInQualityGate   = "cannot delete SonarQube Quality Gate %s: built-in Quality Gates cannot be deleted, set the deletionPolicy to Orphan to remove the resource without deleting the Quality Gate"
+	errRenameForbiddenQualityGate = "cannot rename SonarQube Quality Gate %s: the rename action is not allowed for this Quality Gate"
+	errAiCodeForbiddenQualityGate = "cannot set SonarQube Quality Gate %s AI Code Assurance: the manageAiCodeAssurance action is not allowed for this Quality Gate"
+
+	errSearchQualityGateProjects = "cannot list projects associated with SonarQube Quality Gate"
+	errSyncQualityGateProjects   = "cannot sync Quality Gate projects"
+	errSearchQualityGateUsers    = "cannot list users allowed to edit SonarQube Quality Gate"
+	errSearchQualityGateGroups   = "cannot list groups allowed to edit SonarQube Quality Gate"
+	errSyncQualityGateDelegates  = "cannot sync Quality Gate delegated users and groups"
+	errSearchMetrics             = "cannot list SonarQube metrics"
+	errCaycQualityGate           = "cannot sync Quality Gate Conditions with the Enforce Clean as You Code policy"
+
+	reasonCaycNotCompliant event.Reason = "CaycNotCompliant"
 )
 
+// metricCatalogTTL is the duration for which the metrics catalogue of a ProviderConfig is cached
+const metricCatalogTTL = 10 * time.Minute
+
 // SetupGated adds a controller that reconciles QualityGate managed resources with safe-start support.
 func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 	o.Gate.Register(func() {
@@ -69,15 +92,19 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.QualityGateGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	opts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
-			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-			newServiceFn: instance.NewQualityGatesClient}),
+			kube:                mgr.GetClient(),
+			usage:               resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:            recorder,
+			newServiceFn:        instance.NewQualityGatesClient,
+			newMetricsServiceFn: instance.NewMetricsClient,
+			metricCatalogs:      common.NewTTLCache[instance.MetricCatalog](metricCatalogTTL)}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 	}
 
 	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
@@ -114,9 +141,12 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
-	kube         client.Client
-	usage        *resource.ProviderConfigUsageTracker
-	newServiceFn func(config common.Config) instance.QualityGatesClient
+	kube                client.Client
+	usage               *resource.ProviderConfigUsageTracker
+	recorder            event.Recorder
+	newServiceFn        func(config common.Config) instance.QualityGatesClient
+	newMetricsServiceFn func(config common.Config) instance.MetricsClient
+	metricCatalogs      *common.TTLCache[instance.MetricCatalog]
 }
 
 // Connect typically produces an ExternalClient by:
@@ -144,7 +174,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 
 	svc := c.newServiceFn(*config)
 
-	return &external{qualityGatesClient: svc}, nil
+	return &external{
+		qualityGatesClient: svc,
+		metricsClient:      c.newMetricsServiceFn(*config),
+		metricCatalogs:     c.metricCatalogs,
+		providerConfigKey:  common.ProviderConfigKey(m),
+		recorder:           c.recorder,
+	}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -152,6 +188,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 type external struct {
 	// qualityGatesClient is used to interact with SonarQube Quality Gates API
 	qualityGatesClient instance.QualityGatesClient
+	// metricsClient is used to retrieve the metrics catalogue to validate Quality Gate conditions
+	metricsClient instance.MetricsClient
+	// metricCatalogs caches the metrics catalogue of each ProviderConfig
+	metricCatalogs *common.TTLCache[instance.MetricCatalog]
+	// providerConfigKey identifies the ProviderConfig of the managed resource in the metricCatalogs cache
+	providerConfigKey string
+	// recorder is used to warn about Quality Gates that are not Clean as You Code compliant
+	recorder event.Recorder
 }
 
 // Observe checks if the external resource exists and if it matches the
@@ -174,16 +218,57 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		Name: externalName,
 	})
 	defer helpers.CloseBody(resp)
+	renamed := false
 	if err != nil {
-		// If the quality gate is not found, treat as resource doesn't exist
-		return managed.ExternalObservation{ResourceExists: false}, nil
+		err = common.NewAPIError(resp, err)
+		// Only a real 404 means the quality gate does not exist, any other error must not trigger a re-creation
+		if !common.IsNotFound(err) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errShowQualityGate)
+		}
+		// The quality gate may have been renamed by a previous Update, whose new external name is only persisted from Observe
+		qualityGate, err = c.showRenamedQualityGate(externalName, cr.Spec.ForProvider.Name)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if qualityGate == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		externalName = qualityGate.Name
+		meta.SetExternalName(cr, externalName)
+		renamed = true
+	}
+
+	// Retrieve the projects explicitly associated with the Quality Gate
+	projects, err := c.observeQualityGateProjects(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
 	}
 
-	// Update status with observed state
+	// Retrieve the users and groups allowed to edit the Quality Gate
+	users, err := c.observeQualityGateUsers(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	groups, err := c.observeQualityGateGroups(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Update status with observed state, keeping whether the copied conditions were merged into the spec
+	conditionsCopied := cr.Status.AtProvider.ConditionsCopied
 	cr.Status.AtProvider = instance.GenerateQualityGateObservation(qualityGate)
+	cr.Status.AtProvider.ConditionsCopied = conditionsCopied
+	cr.Status.AtProvider.Projects = projects
+	cr.Status.AtProvider.DelegatedUsers = users
+	cr.Status.AtProvider.DelegatedGroups = groups
 	cr.Status.SetConditions(xpv1.Available())
+	c.reportQualityGateCaycStatus(cr)
 
 	current := cr.Spec.ForProvider.DeepCopy()
+	// A Quality Gate that was not created by the provider is imported, its conditions are adopted when none is specified
+	if meta.GetExternalCreateSucceeded(cr).IsZero() {
+		instance.AdoptQualityGateConditions(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+	}
 	// Late initialize the spec with observed state (includes conditions)
 	instance.LateInitializeQualityGate(&cr.Spec.ForProvider, &cr.Status.AtProvider)
 
@@ -201,10 +286,185 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			current,
 			&cr.Spec.ForProvider,
 			cmpopts.IgnoreFields(v1alpha1.QualityGateParameters{}, "Conditions"),
-		) || conditionsLateInitialized,
+		) || conditionsLateInitialized || renamed,
 	}, nil
 }
 
+// reportQualityGateCaycStatus reports the Clean as You Code status of the Quality Gate according to its Clean as You Code policy
+// With the Warn policy, an event is also emitted when the Quality Gate becomes non-compliant or over-compliant
+// With the Ignore policy, a CaycCompliant condition reported under a previous policy is removed
+func (c *external) reportQualityGateCaycStatus(cr *v1alpha1.QualityGate) {
+	policy := instance.GetQualityGateCaycPolicy(&cr.Spec.ForProvider)
+	if policy == v1alpha1.CaycPolicyIgnore {
+		instance.RemoveQualityGateCaycCondition(&cr.Status.ConditionedStatus)
+		return
+	}
+
+	status := cr.Status.AtProvider.CaycStatus
+	previous := cr.Status.GetCondition(v1alpha1.TypeCaycCompliant)
+	condition := instance.GenerateQualityGateCaycCondition(status)
+	cr.Status.SetConditions(condition)
+
+	// Only warn when the compliance changes, rather than on every poll
+	if previous.Reason == condition.Reason {
+		return
+	}
+	if policy == v1alpha1.CaycPolicyWarn && status != v1alpha1.CaycStatusCompliant && c.recorder != nil {
+		c.recorder.Event(cr, event.Warning(reasonCaycNotCompliant, fmt.Errorf("SonarQube Quality Gate %s is %s with Clean as You Code", cr.Status.AtProvider.Name, status)))
+	}
+}
+
+// showRenamedQualityGate retrieves the Quality Gate under its desired name when it can no longer be found under its external name
+// It returns nil if the Quality Gate does not exist under its desired name either
+func (c *external) showRenamedQualityGate(externalName, name string) (*sonargo.QualitygatesShowObject, error) {
+	if name == "" || name == externalName {
+		return nil, nil
+	}
+	qualityGate, resp, err := c.qualityGatesClient.Show(&sonargo.QualitygatesShowOption{ //nolint:bodyclose // closed via helpers.CloseBody
+		Name: name,
+	})
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		if common.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errShowQualityGate)
+	}
+	return qualityGate, nil
+}
+
+// renameQualityGate renames the Quality Gate in SonarQube when its desired name differs from its external name
+// It returns the name of the Quality Gate after the rename
+func (c *external) renameQualityGate(cr *v1alpha1.QualityGate, externalName string) (string, error) {
+	name := cr.Spec.ForProvider.Name
+	if name == externalName {
+		return externalName, nil
+	}
+	if cr.Status.AtProvider.IsBuiltIn {
+		return externalName, fmt.Errorf(errRenameBuiltInQualityGate, externalName)
+	}
+	if !cr.Status.AtProvider.Actions.Rename {
+		return externalName, fmt.Errorf(errRenameForbiddenQualityGate, externalName)
+	}
+
+	renameResp, err := c.qualityGatesClient.Rename(instance.GenerateQualityGateRenameOption(externalName, name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(renameResp)
+	if err != nil {
+		return externalName, errors.Wrap(common.NewAPIError(renameResp, err), errRenameQualityGate)
+	}
+
+	meta.SetExternalName(cr, name)
+	return name, nil
+}
+
+// observeQualityGateProjects retrieves the keys of all the projects explicitly associated with the Quality Gate
+func (c *external) observeQualityGateProjects(externalName string) ([]string, error) {
+	var projects []string
+	for page := 1; ; page++ {
+		search, resp, err := c.qualityGatesClient.Search(instance.GenerateQualityGateProjectsSearchOption(externalName, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityGateProjects)
+		}
+		projects = append(projects, instance.GenerateQualityGateProjectsObservation(search)...)
+		if !instance.HasMoreQualityGateProjects(search) {
+			return projects, nil
+		}
+	}
+}
+
+// syncQualityGateProjects associates the desired projects with the Quality Gate and dissociates the undesired ones
+func (c *external) syncQualityGateProjects(externalName string, specProjects, observedProjects []string) error {
+	for _, projectKey := range instance.FindQualityGateProjectsToSelect(specProjects, observedProjects) {
+		selectResp, err := c.qualityGatesClient.Select(instance.GenerateQualityGateSelectOption(externalName, projectKey)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(selectResp)
+		if err != nil {
+			return errors.Wrapf(common.NewAPIError(selectResp, err), "cannot associate project %s with SonarQube Quality Gate %s", projectKey, externalName)
+		}
+	}
+
+	for _, projectKey := range instance.FindQualityGateProjectsToDeselect(specProjects, observedProjects) {
+		deselectResp, err := c.qualityGatesClient.Deselect(instance.GenerateQualityGateDeselectOption(projectKey)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(deselectResp)
+		// A project that no longer exists is no longer associated with the quality gate
+		if err = common.NewAPIError(deselectResp, err); err != nil && !common.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot dissociate project %s from SonarQube Quality Gate %s", projectKey, externalName)
+		}
+	}
+	return nil
+}
+
+// observeQualityGateUsers retrieves the logins of all the users allowed to edit the Quality Gate
+func (c *external) observeQualityGateUsers(externalName string) ([]string, error) {
+	var users []string
+	for page := 1; ; page++ {
+		search, resp, err := c.qualityGatesClient.SearchUsers(instance.GenerateQualityGateUsersSearchOption(externalName, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityGateUsers)
+		}
+		users = append(users, instance.GenerateQualityGateUsersObservation(search)...)
+		if !instance.HasMoreQualityGateUsers(search) {
+			return users, nil
+		}
+	}
+}
+
+// observeQualityGateGroups retrieves the names of all the groups allowed to edit the Quality Gate
+func (c *external) observeQualityGateGroups(externalName string) ([]string, error) {
+	var groups []string
+	for page := 1; ; page++ {
+		search, resp, err := c.qualityGatesClient.SearchGroups(instance.GenerateQualityGateGroupsSearchOption(externalName, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityGateGroups)
+		}
+		groups = append(groups, instance.GenerateQualityGateGroupsObservation(search)...)
+		if !instance.HasMoreQualityGateGroups(search) {
+			return groups, nil
+		}
+	}
+}
+
+// syncQualityGateDelegates grants the desired users and groups the right to edit the Quality Gate and revokes it from the undesired ones
+func (c *external) syncQualityGateDelegates(externalName string, spec *v1alpha1.QualityGateParameters, observation *v1alpha1.QualityGateObservation) error {
+	for _, login := range instance.FindQualityGateDelegatesToAdd(spec.DelegatedUsers, observation.DelegatedUsers) {
+		addResp, err := c.qualityGatesClient.AddUser(instance.GenerateQualityGateAddUserOption(externalName, login)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(addResp)
+		if err != nil {
+			return errors.Wrapf(common.NewAPIError(addResp, err), "cannot allow user %s to edit SonarQube Quality Gate %s", login, externalName)
+		}
+	}
+
+	for _, login := range instance.FindQualityGateDelegatesToRemove(spec.DelegatedUsers, observation.DelegatedUsers) {
+		removeResp, err := c.qualityGatesClient.RemoveUser(instance.GenerateQualityGateRemoveUserOption(externalName, login)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(removeResp)
+		// A user that no longer exists can no longer edit the quality gate
+		if err = common.NewAPIError(removeResp, err); err != nil && !common.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot remove the ability of user %s to edit SonarQube Quality Gate %s", login, externalName)
+		}
+	}
+
+	for _, groupName := range instance.FindQualityGateDelegatesToAdd(spec.DelegatedGroups, observation.DelegatedGroups) {
+		addResp, err := c.qualityGatesClient.AddGroup(instance.GenerateQualityGateAddGroupOption(externalName, groupName)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(addResp)
+		if err != nil {
+			return errors.Wrapf(common.NewAPIError(addResp, err), "cannot allow group %s to edit SonarQube Quality Gate %s", groupName, externalName)
+		}
+	}
+
+	for _, groupName := range instance.FindQualityGateDelegatesToRemove(spec.DelegatedGroups, observation.DelegatedGroups) {
+		removeResp, err := c.qualityGatesClient.RemoveGroup(instance.GenerateQualityGateRemoveGroupOption(externalName, groupName)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(removeResp)
+		// A group that no longer exists can no longer edit the quality gate
+		if err = common.NewAPIError(removeResp, err); err != nil && !common.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot remove the ability of group %s to edit SonarQube Quality Gate %s", groupName, externalName)
+		}
+	}
+	return nil
+}
+
 // syncQualityGateConditions synchronizes the Quality Gate Conditions in SonarQube
 // It deletes unwanted conditions, creates missing conditions, and updates out-of-date conditions
 func (c *external) syncQualityGateConditions(qualityGate *v1alpha1.QualityGate, qualityGateConditionAssociations map[string]instance.QualityGateConditionAssociation) error {
@@ -217,6 +477,24 @@ func (c *external) syncQualityGateConditions(qualityGate *v1alpha1.QualityGate,
 		return fmt.Errorf("external name is not set for Quality Gate %s", qualityGate.Name)
 	}
 
+	// Refuse conditions that would break the Clean as You Code compliance of the Quality Gate
+	if instance.GetQualityGateCaycPolicy(&qualityGate.Spec.ForProvider) == v1alpha1.CaycPolicyEnforce && !instance.AreQualityGateConditionsUpToDate(qualityGateConditionAssociations) {
+		if err := instance.ValidateQualityGateCaycCompliance(qualityGate.Spec.ForProvider.Conditions); err != nil {
+			return errors.Wrap(err, errCaycQualityGate)
+		}
+	}
+
+	// Validate the desired conditions before creating or updating any of them, to report invalid ones clearly
+	if len(instance.FindNonExistingQualityGateConditions(qualityGateConditionAssociations)) > 0 || len(instance.FindNotUpToDateQualityGateConditions(qualityGateConditionAssociations)) > 0 {
+		catalog, err := c.getMetricCatalog()
+		if err != nil {
+			return err
+		}
+		if err := instance.ValidateQualityGateConditions(qualityGate.Spec.ForProvider.Conditions, catalog); err != nil {
+			return err
+		}
+	}
+
 	if err := c.deleteUnwantedQualityGateConditions(qualityGateConditionAssociations); err != nil {
 		return err
 	}
@@ -232,6 +510,33 @@ func (c *external) syncQualityGateConditions(qualityGate *v1alpha1.QualityGate,
 	return nil
 }
 
+// getMetricCatalog returns the metrics catalogue of the SonarQube instance, retrieving it when it is not cached yet
+func (c *external) getMetricCatalog() (instance.MetricCatalog, error) {
+	if c.metricCatalogs != nil {
+		if catalog, ok := c.metricCatalogs.Get(c.providerConfigKey); ok {
+			return catalog, nil
+		}
+	}
+
+	catalog := instance.MetricCatalog{}
+	for page := 1; ; page++ {
+		search, resp, err := c.metricsClient.Search(instance.GenerateMetricsSearchOption(page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchMetrics)
+		}
+		catalog.AddMetrics(search)
+		if !instance.HasMoreMetrics(search) {
+			break
+		}
+	}
+
+	if c.metricCatalogs != nil {
+		c.metricCatalogs.Set(c.providerConfigKey, catalog)
+	}
+	return catalog, nil
+}
+
 // deleteUnwantedQualityGateConditions deletes Quality Gate Conditions that are no longer needed
 func (c *external) deleteUnwantedQualityGateConditions(qualityGateConditionAssociations map[string]instance.QualityGateConditionAssociation) error {
 	missingQualityGateConditions := instance.FindMissingQualityGateConditions(qualityGateConditionAssociations)
@@ -241,7 +546,8 @@ func (c *external) deleteUnwantedQualityGateConditions(qualityGateConditionAssoc
 		}
 		deleteResponse, err := c.qualityGatesClient.DeleteCondition(instance.GenerateDeleteQualityGateConditionOption(conditionObservation.ID)) //nolint:bodyclose // closed via helpers.CloseBody
 		defer helpers.CloseBody(deleteResponse)
-		if err != nil {
+		// A condition that no longer exists does not need to be deleted
+		if err = common.NewAPIError(deleteResponse, err); err != nil && !common.IsNotFound(err) {
 			return errors.Wrapf(err, "cannot delete SonarQube Quality Gate Condition with ID %s", conditionObservation.ID)
 		}
 		// Delete the condition from the associations map after successful deletion
@@ -270,7 +576,7 @@ func (c *external) createMissingQualityGateConditions(externalName string, quali
 		qualityGateCondition, createResponse, err := c.qualityGatesClient.CreateCondition(instance.GenerateCreateQualityGateConditionOption(externalName, *conditionSpec)) //nolint:bodyclose // closed via helpers.CloseBody
 		defer helpers.CloseBody(createResponse)
 		if err != nil {
-			return errors.Wrapf(err, "cannot create SonarQube Quality Gate Condition for Quality Gate %s", externalName)
+			return errors.Wrapf(common.NewAPIError(createResponse, err), "cannot create SonarQube Quality Gate Condition for Quality Gate %s", externalName)
 		}
 		conditionObservation := instance.GenerateQualityGateConditionObservationFromCreate(qualityGateCondition)
 
@@ -300,7 +606,7 @@ func (c *external) updateOutdatedQualityGateConditions(qualityGateConditionAssoc
 		updateResponse, err := c.qualityGatesClient.UpdateCondition(instance.GenerateUpdateQualityGateConditionOption(association.Observation.ID, *association.Spec)) //nolint:bodyclose // closed via helpers.CloseBody
 		defer helpers.CloseBody(updateResponse)
 		if err != nil {
-			return errors.Wrapf(err, "cannot update SonarQube Quality Gate Condition with ID %s", *association.Spec.Id)
+			return errors.Wrapf(common.NewAPIError(updateResponse, err), "cannot update SonarQube Quality Gate Condition with ID %s", *association.Spec.Id)
 		}
 	}
 	return nil
@@ -315,12 +621,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	cr.Status.SetConditions(xpv1.Creating())
 
-	qualityGateCreateOptions := instance.GenerateQualityGateCreateOptions(cr.Spec.ForProvider)
-
-	qualityGate, resp, err := c.qualityGatesClient.Create(qualityGateCreateOptions) //nolint:bodyclose // closed via helpers.CloseBody
-	defer helpers.CloseBody(resp)
+	qualityGate, err := c.createQualityGate(cr.Spec.ForProvider)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateQualityGate)
+		return managed.ExternalCreation{}, err
 	}
 
 	// Set the external name to the Name of the created Quality Gate
@@ -333,13 +636,51 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		})
 		defer helpers.CloseBody(setDefaultResp)
 		if err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, errDefaultQualityGate)
+			return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(setDefaultResp, err), errDefaultQualityGate)
 		}
 	}
 
+	// Qualify the newly created Quality Gate for AI Code Assurance if specified in the spec
+	if cr.Spec.ForProvider.AiCodeSupported != nil && *cr.Spec.ForProvider.AiCodeSupported {
+		if err := c.setQualityGateAiCodeAssurance(qualityGate.Name, true); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	// Associate the desired projects with the newly created Quality Gate
+	if err := c.syncQualityGateProjects(qualityGate.Name, cr.Spec.ForProvider.Projects, nil); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSyncQualityGateProjects)
+	}
+
+	// Allow the desired users and groups to edit the newly created Quality Gate
+	if err := c.syncQualityGateDelegates(qualityGate.Name, &cr.Spec.ForProvider, &v1alpha1.QualityGateObservation{}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSyncQualityGateDelegates)
+	}
+
 	return managed.ExternalCreation{}, nil
 }
 
+// createQualityGate creates the Quality Gate in SonarQube, either from scratch or by copying another Quality Gate
+// The conditions of a copied Quality Gate are late-initialized into the spec by the next Observe
+func (c *external) createQualityGate(spec v1alpha1.QualityGateParameters) (*sonargo.QualitygatesCreateObject, error) {
+	if instance.IsQualityGateCopy(spec) {
+		copyResp, err := c.qualityGatesClient.Copy(instance.GenerateQualityGateCopyOptions(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(copyResp)
+		if err != nil {
+			return nil, errors.Wrapf(common.NewAPIError(copyResp, err), errCopyQualityGate, *spec.CopyFrom)
+		}
+		// The copy API does not return the created Quality Gate, which is named after the spec
+		return &sonargo.QualitygatesCreateObject{Name: spec.Name}, nil
+	}
+
+	qualityGate, resp, err := c.qualityGatesClient.Create(instance.GenerateQualityGateCreateOptions(spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errCreateQualityGate)
+	}
+	return qualityGate, nil
+}
+
 // Update updates the external resource to match the desired state of the managed resource
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mg.(*v1alpha1.QualityGate)
@@ -352,27 +693,76 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for Quality Gate %s", cr.Name)
 	}
 
+	// Rename the Quality Gate first, so that every following call targets its new name
+	externalName, err := c.renameQualityGate(cr, externalName)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	associations := instance.GenerateQualityGateConditionsAssociation(cr.Spec.ForProvider.Conditions, cr.Status.AtProvider.Conditions)
+
+	// Refuse to modify a built-in Quality Gate before calling SonarQube, which would reject it anyway
+	if cr.Status.AtProvider.IsBuiltIn && !isBuiltInQualityGateUpdateAllowed(&cr.Spec.ForProvider, &cr.Status.AtProvider, associations) {
+		return managed.ExternalUpdate{}, fmt.Errorf(errUpdateBuiltInQualityGate, externalName)
+	}
+
 	// Set Quality Gate as default if specified in the spec (idempotent)
 	if cr.Spec.ForProvider.Default != nil && *cr.Spec.ForProvider.Default {
 		updateSetDefaultResp, err := c.qualityGatesClient.SetAsDefault(&sonargo.QualitygatesSetAsDefaultOption{ //nolint:bodyclose // closed via helpers.CloseBody
-			Name: cr.Spec.ForProvider.Name,
+			Name: externalName,
 		})
 		defer helpers.CloseBody(updateSetDefaultResp)
 		if err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errDefaultQualityGate)
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(updateSetDefaultResp, err), errDefaultQualityGate)
 		}
 	}
 
-	associations := instance.GenerateQualityGateConditionsAssociation(cr.Spec.ForProvider.Conditions, cr.Status.AtProvider.Conditions)
+	// Set Quality Gate AI Code Assurance if it differs from the spec
+	if aiCodeSupported := cr.Spec.ForProvider.AiCodeSupported; aiCodeSupported != nil && *aiCodeSupported != cr.Status.AtProvider.IsAiCodeSupported {
+		if !cr.Status.AtProvider.Actions.ManageAiCodeAssurance {
+			return managed.ExternalUpdate{}, fmt.Errorf(errAiCodeForbiddenQualityGate, externalName)
+		}
+		if err := c.setQualityGateAiCodeAssurance(externalName, *aiCodeSupported); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
 
 	// Sync Quality Gate Conditions
 	if err := c.syncQualityGateConditions(cr, associations); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot sync Quality Gate Conditions")
 	}
 
+	// Sync Quality Gate project associations
+	if err := c.syncQualityGateProjects(externalName, cr.Spec.ForProvider.Projects, cr.Status.AtProvider.Projects); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSyncQualityGateProjects)
+	}
+
+	// Sync Quality Gate delegated users and groups
+	if err := c.syncQualityGateDelegates(externalName, &cr.Spec.ForProvider, &cr.Status.AtProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSyncQualityGateDelegates)
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
+// setQualityGateAiCodeAssurance sets whether the Quality Gate qualifies for AI Code Assurance
+func (c *external) setQualityGateAiCodeAssurance(externalName string, aiCodeSupported bool) error {
+	setAiCodeResp, err := c.qualityGatesClient.SetAiCodeAssurance(instance.GenerateQualityGateSetAiCodeAssuranceOption(externalName, aiCodeSupported)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(setAiCodeResp)
+	if err != nil {
+		return errors.Wrap(common.NewAPIError(setAiCodeResp, err), errAiCodeQualityGate)
+	}
+	return nil
+}
+
+// isBuiltInQualityGateUpdateAllowed checks that an update of a built-in Quality Gate only sets it as default or changes its associated projects
+func isBuiltInQualityGateUpdateAllowed(spec *v1alpha1.QualityGateParameters, observation *v1alpha1.QualityGateObservation, associations map[string]instance.QualityGateConditionAssociation) bool {
+	return instance.AreQualityGateConditionsUpToDate(associations) &&
+		helpers.IsComparablePtrEqualComparable(spec.AiCodeSupported, observation.IsAiCodeSupported) &&
+		instance.AreQualityGateDelegatesUpToDate(spec.DelegatedUsers, observation.DelegatedUsers) &&
+		instance.AreQualityGateDelegatesUpToDate(spec.DelegatedGroups, observation.DelegatedGroups)
+}
+
 // Delete deletes the external resource
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*v1alpha1.QualityGate)
@@ -388,11 +778,21 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, nil
 	}
 
+	// Built-in quality gates cannot be deleted, refuse with an explanation rather than the raw SonarQube error
+	if cr.Status.AtProvider.IsBuiltIn {
+		return managed.ExternalDelete{}, fmt.Errorf(errDeleteBuiltInQualityGate, externalName)
+	}
+
 	destroyResp, err := c.qualityGatesClient.Destroy(&sonargo.QualitygatesDestroyOption{ //nolint:bodyclose // closed via helpers.CloseBody
 		Name: externalName,
 	})
 	defer helpers.CloseBody(destroyResp)
 	if err != nil {
+		err = common.NewAPIError(destroyResp, err)
+		// The quality gate is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
 		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteQualityGate)
 	}
 
@@ -18,20 +18,26 @@ package qualitygate
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
 	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
 	"github.com/crossplane/provider-sonarqube/internal/fake"
 )
 
@@ -47,14 +53,66 @@ type notQualityGate struct {
 	resource.Managed
 }
 
+// roundTrip returns the QualityGate as stored by the API server, which drops the fields omitted from its JSON
+func roundTrip(cr *v1alpha1.QualityGate) *v1alpha1.QualityGate {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		panic(err)
+	}
+	stored := &v1alpha1.QualityGate{}
+	if err := json.Unmarshal(raw, stored); err != nil {
+		panic(err)
+	}
+	return stored
+}
+
 func TestObserve(t *testing.T) {
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
 	}
 	type want struct {
-		o   managed.ExternalObservation
-		err error
+		o                managed.ExternalObservation
+		conditions       []v1alpha1.QualityGateConditionParameters
+		conditionsCopied bool
+		err              error
+	}
+
+	// copiedGate is a Quality Gate copied from Sonar way, whose conditions are merged with the specified ones
+	copiedGate := &fake.MockQualityGatesClient{
+		ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+			return &sonargo.QualitygatesShowObject{
+				Name: "strict-way",
+				Conditions: []sonargo.QualitygatesShowObject_sub2{
+					{ID: "cond-1", Metric: "new_coverage", Error: "80", Op: "LT"},
+					{ID: "cond-2", Metric: "new_violations", Error: "0", Op: "GT"},
+					{ID: "cond-3", Metric: "new_security_rating", Error: "1", Op: "GT"},
+				},
+			}, nil, nil
+		},
+	}
+	newCopiedGate := func(conditionsCopied bool, conditions ...v1alpha1.QualityGateConditionParameters) *v1alpha1.QualityGate {
+		qg := &v1alpha1.QualityGate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "strict-way",
+				Annotations: map[string]string{},
+			},
+			Spec: v1alpha1.QualityGateSpec{
+				ForProvider: v1alpha1.QualityGateParameters{
+					Name:            "strict-way",
+					CopyFrom:        ptr.To("Sonar way"),
+					Default:         ptr.To(false),
+					AiCodeSupported: ptr.To(false),
+					Conditions:      conditions,
+				},
+			},
+			Status: v1alpha1.QualityGateStatus{
+				AtProvider: v1alpha1.QualityGateObservation{ConditionsCopied: conditionsCopied},
+			},
+		}
+		meta.SetExternalName(qg, "strict-way")
+		meta.SetExternalCreateSucceeded(qg, time.Now())
+		return qg
 	}
 
 	cases := map[string]struct {
@@ -89,10 +147,10 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
-		"ShowFailsReturnsNotExists": {
+		"ShowNotFoundReturnsNotExists": {
 			client: &fake.MockQualityGatesClient{
 				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
-					return nil, nil, errors.New("api error")
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
 				},
 			},
 			args: args{
@@ -113,6 +171,54 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"ShowUnauthorizedReturnsError": {
+			client: &fake.MockQualityGatesClient{
+				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusUnauthorized}, errors.New("unauthorized")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(errors.New("unauthorized"), errShowQualityGate),
+			},
+		},
+		"ShowTransportFailureReturnsError": {
+			client: &fake.MockQualityGatesClient{
+				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+					return nil, nil, errors.New("api error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(errors.New("api error"), errShowQualityGate),
+			},
+		},
 		"SuccessfulObserveResourceExists": {
 			client: &fake.MockQualityGatesClient{
 				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
@@ -136,8 +242,9 @@ func TestObserve(t *testing.T) {
 						},
 						Spec: v1alpha1.QualityGateSpec{
 							ForProvider: v1alpha1.QualityGateParameters{
-								Name:    "test-gate",
-								Default: ptr.To(false), // explicitly set to match observation and avoid late initialization
+								Name:            "test-gate",
+								Default:         ptr.To(false), // explicitly set to match observation and avoid late initialization
+								AiCodeSupported: ptr.To(false),
 							},
 						},
 					}
@@ -177,8 +284,151 @@ func TestObserve(t *testing.T) {
 						},
 						Spec: v1alpha1.QualityGateSpec{
 							ForProvider: v1alpha1.QualityGateParameters{
-								Name:    "test-gate",
-								Default: ptr.To(false), // explicitly set to match observation and avoid late initialization
+								Name:            "test-gate",
+								Default:         ptr.To(false), // explicitly set to match observation and avoid late initialization
+								AiCodeSupported: ptr.To(false),
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+				err: nil,
+			},
+		},
+		"SearchProjectsFails": {
+			client: &fake.MockQualityGatesClient{
+				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+					return &sonargo.QualitygatesShowObject{Name: "test-gate"}, nil, nil
+				},
+				SearchFn: func(opt *sonargo.QualitygatesSearchOption) (*sonargo.QualitygatesSearchObject, *http.Response, error) {
+					return nil, nil, errors.New("search error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(errors.New("search error"), errSearchQualityGateProjects),
+			},
+		},
+		"ResourceNotUpToDateWhenProjectsDiffer": {
+			client: &fake.MockQualityGatesClient{
+				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+					return &sonargo.QualitygatesShowObject{Name: "test-gate"}, nil, nil
+				},
+				SearchFn: func(opt *sonargo.QualitygatesSearchOption) (*sonargo.QualitygatesSearchObject, *http.Response, error) {
+					return &sonargo.QualitygatesSearchObject{
+						Paging:  sonargo.QualitygatesSearchObject_sub1{PageIndex: 1, PageSize: 500, Total: 1},
+						Results: []sonargo.QualitygatesSearchObject_sub2{{Key: "project-a", Selected: true}},
+					}, nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "test-gate",
+								Default:         ptr.To(false),
+								AiCodeSupported: ptr.To(false),
+								Projects:        []string{"project-a", "project-b"},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+				err: nil,
+			},
+		},
+		"SearchUsersFails": {
+			client: &fake.MockQualityGatesClient{
+				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+					return &sonargo.QualitygatesShowObject{Name: "test-gate"}, nil, nil
+				},
+				SearchUsersFn: func(opt *sonargo.QualitygatesSearchUsersOption) (*sonargo.QualitygatesSearchUsersObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(errors.New("forbidden"), errSearchQualityGateUsers),
+			},
+		},
+		"ResourceNotUpToDateWhenDelegatedGroupsDiffer": {
+			client: &fake.MockQualityGatesClient{
+				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+					return &sonargo.QualitygatesShowObject{Name: "test-gate"}, nil, nil
+				},
+				SearchUsersFn: func(opt *sonargo.QualitygatesSearchUsersOption) (*sonargo.QualitygatesSearchUsersObject, *http.Response, error) {
+					return &sonargo.QualitygatesSearchUsersObject{
+						Paging: sonargo.QualitygatesSearchUsersObject_sub1{PageIndex: 1, PageSize: 500, Total: 1},
+						Users:  []sonargo.QualitygatesSearchUsersObject_sub2{{Login: "alice", Selected: true}},
+					}, nil, nil
+				},
+				SearchGroupsFn: func(opt *sonargo.QualitygatesSearchGroupsOption) (*sonargo.QualitygatesSearchGroupsObject, *http.Response, error) {
+					return &sonargo.QualitygatesSearchGroupsObject{}, nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "test-gate",
+								Default:         ptr.To(false),
+								AiCodeSupported: ptr.To(false),
+								DelegatedUsers:  []string{"alice"},
+								DelegatedGroups: []string{"team-leads"},
 							},
 						},
 					}
@@ -236,6 +486,43 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"CopyMergesCopiedConditions": {
+			client: copiedGate,
+			args: args{
+				ctx: context.Background(),
+				mg:  newCopiedGate(false, v1alpha1.QualityGateConditionParameters{Metric: "new_coverage", Error: "90", Op: ptr.To("LT")}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+				conditions: []v1alpha1.QualityGateConditionParameters{
+					{Id: ptr.To("cond-1"), Metric: "new_coverage", Error: "90", Op: ptr.To("LT")},
+					{Id: ptr.To("cond-2"), Metric: "new_violations", Error: "0", Op: ptr.To("GT")},
+					{Id: ptr.To("cond-3"), Metric: "new_security_rating", Error: "1", Op: ptr.To("GT")},
+				},
+				conditionsCopied: true,
+			},
+		},
+		"CopiedConditionsAreMergedOnce": {
+			client: copiedGate,
+			args: args{
+				ctx: context.Background(),
+				mg:  newCopiedGate(true, v1alpha1.QualityGateConditionParameters{Id: ptr.To("cond-1"), Metric: "new_coverage", Error: "90", Op: ptr.To("LT")}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				conditions: []v1alpha1.QualityGateConditionParameters{
+					{Id: ptr.To("cond-1"), Metric: "new_coverage", Error: "90", Op: ptr.To("LT")},
+				},
+				conditionsCopied: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -249,6 +536,14 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
 			}
+			if cr, ok := tc.args.mg.(*v1alpha1.QualityGate); ok {
+				if diff := cmp.Diff(tc.want.conditions, cr.Spec.ForProvider.Conditions); diff != "" {
+					t.Errorf("Observe() conditions mismatch (-want +got):\n%s", diff)
+				}
+				if cr.Status.AtProvider.ConditionsCopied != tc.want.conditionsCopied {
+					t.Errorf("Observe() conditionsCopied = %v, want %v", cr.Status.AtProvider.ConditionsCopied, tc.want.conditionsCopied)
+				}
+			}
 		})
 	}
 }
@@ -384,7 +679,7 @@ func TestCreate(t *testing.T) {
 				err: nil,
 			},
 		},
-		"CreateWithDefaultTrueButSetDefaultFails": {
+		"CreateSelectsProjects": {
 			client: &fake.MockQualityGatesClient{
 				CreateFn: func(opt *sonargo.QualitygatesCreateOption) (*sonargo.QualitygatesCreateObject, *http.Response, error) {
 					return &sonargo.QualitygatesCreateObject{
@@ -392,8 +687,11 @@ func TestCreate(t *testing.T) {
 						Name: opt.Name,
 					}, nil, nil
 				},
-				SetAsDefaultFn: func(opt *sonargo.QualitygatesSetAsDefaultOption) (*http.Response, error) {
-					return nil, errors.New("set default error")
+				SelectFn: func(opt *sonargo.QualitygatesSelectOption) (*http.Response, error) {
+					if opt.GateName != "test-gate" || opt.ProjectKey != "project-a" {
+						return nil, errors.New("unexpected select option")
+					}
+					return nil, nil
 				},
 			},
 			args: args{
@@ -402,79 +700,783 @@ func TestCreate(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{Name: "test-gate"},
 					Spec: v1alpha1.QualityGateSpec{
 						ForProvider: v1alpha1.QualityGateParameters{
-							Name:    "test-gate",
-							Default: ptr.To(true),
+							Name:     "test-gate",
+							Projects: []string{"project-a"},
 						},
 					},
 				},
 			},
 			want: want{
 				o:   managed.ExternalCreation{},
-				err: errors.Wrap(errors.New("set default error"), errDefaultQualityGate),
+				err: nil,
 			},
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			e := &external{qualityGatesClient: tc.client}
-			got, err := e.Create(tc.args.ctx, tc.args.mg)
-
-			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
-				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
-			}
-			if diff := cmp.Diff(tc.want.o, got); diff != "" {
-				t.Errorf("Create() mismatch (-want +got):\n%s", diff)
-			}
-		})
-	}
-}
-
-func TestUpdate(t *testing.T) {
-	type args struct {
-		ctx context.Context
-		mg  resource.Managed
-	}
-	type want struct {
-		o   managed.ExternalUpdate
-		err error
-	}
-
-	cases := map[string]struct {
-		client *fake.MockQualityGatesClient
-		args   args
-		want   want
-	}{
-		"NotQualityGateError": {
-			client: &fake.MockQualityGatesClient{},
+		"CreateByCopy": {
+			client: &fake.MockQualityGatesClient{
+				CreateFn: func(opt *sonargo.QualitygatesCreateOption) (*sonargo.QualitygatesCreateObject, *http.Response, error) {
+					return nil, nil, errors.New("create should not be called")
+				},
+				CopyFn: func(opt *sonargo.QualitygatesCopyOption) (*http.Response, error) {
+					if opt.Name != "my-gate" || opt.SourceName != "Sonar way" {
+						return nil, errors.New("unexpected copy option")
+					}
+					return nil, nil
+				},
+			},
 			args: args{
 				ctx: context.Background(),
-				mg:  &notQualityGate{},
+				mg: &v1alpha1.QualityGate{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-gate"},
+					Spec: v1alpha1.QualityGateSpec{
+						ForProvider: v1alpha1.QualityGateParameters{
+							Name:     "my-gate",
+							CopyFrom: ptr.To("Sonar way"),
+						},
+					},
+				},
 			},
 			want: want{
-				o:   managed.ExternalUpdate{},
-				err: errors.New(errNotQualityGate),
+				o:   managed.ExternalCreation{},
+				err: nil,
+			},
+		},
+		"CopyFails": {
+			client: &fake.MockQualityGatesClient{
+				CopyFn: func(opt *sonargo.QualitygatesCopyOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("source not found")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.QualityGate{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-gate"},
+					Spec: v1alpha1.QualityGateSpec{
+						ForProvider: v1alpha1.QualityGateParameters{
+							Name:     "my-gate",
+							CopyFrom: ptr.To("Missing way"),
+						},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrapf(errors.New("source not found"), errCopyQualityGate, "Missing way"),
+			},
+		},
+		"CreateWithDefaultTrueButSetDefaultFails": {
+			client: &fake.MockQualityGatesClient{
+				CreateFn: func(opt *sonargo.QualitygatesCreateOption) (*sonargo.QualitygatesCreateObject, *http.Response, error) {
+					return &sonargo.QualitygatesCreateObject{
+						ID:   "gate-123",
+						Name: opt.Name,
+					}, nil, nil
+				},
+				SetAsDefaultFn: func(opt *sonargo.QualitygatesSetAsDefaultOption) (*http.Response, error) {
+					return nil, errors.New("set default error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.QualityGate{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-gate"},
+					Spec: v1alpha1.QualityGateSpec{
+						ForProvider: v1alpha1.QualityGateParameters{
+							Name:    "test-gate",
+							Default: ptr.To(true),
+						},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(errors.New("set default error"), errDefaultQualityGate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{qualityGatesClient: tc.client}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		o     managed.ExternalUpdate
+		calls []string
+		err   error
+	}
+
+	// calls records the changes made by the clients of the cases
+	var calls []string
+
+	cases := map[string]struct {
+		client *fake.MockQualityGatesClient
+		args   args
+		want   want
+	}{
+		"NotQualityGateError": {
+			client: &fake.MockQualityGatesClient{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &notQualityGate{},
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.New(errNotQualityGate),
+			},
+		},
+		"EmptyExternalNameReturnsError": {
+			client: &fake.MockQualityGatesClient{},
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.QualityGate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test-gate",
+						Annotations: map[string]string{},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: fmt.Errorf("external name is not set for Quality Gate %s", "test-gate"),
+			},
+		},
+		"DeselectLastProject": {
+			client: &fake.MockQualityGatesClient{
+				DeselectFn: func(opt *sonargo.QualitygatesDeselectOption) (*http.Response, error) {
+					calls = append(calls, "Deselect:"+opt.ProjectKey)
+					return nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:     "test-gate",
+								Projects: []string{},
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Projects: []string{"project-a"},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return roundTrip(qg)
+				}(),
+			},
+			want: want{
+				o:     managed.ExternalUpdate{},
+				calls: []string{"Deselect:project-a"},
+			},
+		},
+		"RevokeLastDelegates": {
+			client: &fake.MockQualityGatesClient{
+				RemoveUserFn: func(opt *sonargo.QualitygatesRemoveUserOption) (*http.Response, error) {
+					calls = append(calls, "RemoveUser:"+opt.Login)
+					return nil, nil
+				},
+				RemoveGroupFn: func(opt *sonargo.QualitygatesRemoveGroupOption) (*http.Response, error) {
+					calls = append(calls, "RemoveGroup:"+opt.GroupName)
+					return nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "test-gate",
+								DelegatedUsers:  []string{},
+								DelegatedGroups: []string{},
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								DelegatedUsers:  []string{"alice"},
+								DelegatedGroups: []string{"team-leads"},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return roundTrip(qg)
+				}(),
+			},
+			want: want{
+				o:     managed.ExternalUpdate{},
+				calls: []string{"RemoveUser:alice", "RemoveGroup:team-leads"},
+			},
+		},
+		"CopyKeepsUnspecifiedCopiedConditions": {
+			client: &fake.MockQualityGatesClient{
+				UpdateConditionFn: func(opt *sonargo.QualitygatesUpdateConditionOption) (*http.Response, error) {
+					calls = append(calls, "UpdateCondition:"+opt.Id+"="+opt.Error)
+					return nil, nil
+				},
+				DeleteConditionFn: func(opt *sonargo.QualitygatesDeleteConditionOption) (*http.Response, error) {
+					calls = append(calls, "DeleteCondition:"+opt.Id)
+					return nil, nil
+				},
+				CreateConditionFn: func(opt *sonargo.QualitygatesCreateConditionOption) (*sonargo.QualitygatesCreateConditionObject, *http.Response, error) {
+					calls = append(calls, "CreateCondition:"+opt.Metric)
+					return nil, nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					// The Quality Gate copies Sonar way and only tweaks its coverage threshold
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "strict-way",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "strict-way",
+								CopyFrom:        ptr.To("Sonar way"),
+								Default:         ptr.To(false),
+								AiCodeSupported: ptr.To(false),
+								Conditions: []v1alpha1.QualityGateConditionParameters{
+									{Id: ptr.To("cond-1"), Metric: "new_coverage", Error: "90", Op: ptr.To("LT")},
+									{Id: ptr.To("cond-2"), Metric: "new_violations", Error: "0", Op: ptr.To("GT")},
+									{Id: ptr.To("cond-3"), Metric: "new_security_rating", Error: "1", Op: ptr.To("GT")},
+								},
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name: "strict-way",
+								Conditions: []v1alpha1.QualityGateConditionObservation{
+									{ID: "cond-1", Metric: "new_coverage", Error: "80", Op: "LT"},
+									{ID: "cond-2", Metric: "new_violations", Error: "0", Op: "GT"},
+									{ID: "cond-3", Metric: "new_security_rating", Error: "1", Op: "GT"},
+								},
+								ConditionsCopied: true,
+							},
+						},
+					}
+					meta.SetExternalName(qg, "strict-way")
+					return qg
+				}(),
+			},
+			want: want{
+				o:     managed.ExternalUpdate{},
+				calls: []string{"UpdateCondition:cond-1=90"},
+			},
+		},
+		"SetAsDefaultWhenRequested": {
+			client: &fake.MockQualityGatesClient{
+				SetAsDefaultFn: func(opt *sonargo.QualitygatesSetAsDefaultOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:    "test-gate",
+								Default: ptr.To(true),
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"SetAiCodeAssuranceWhenRequested": {
+			client: &fake.MockQualityGatesClient{
+				SetAiCodeAssuranceFn: func(opt *instance.QualitygatesSetAiCodeAssuranceOption) (*http.Response, error) {
+					if opt.GateName != "test-gate" || opt.AiCodeAssurance != "true" {
+						return nil, errors.New("unexpected set ai code assurance option")
+					}
+					return nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "test-gate",
+								AiCodeSupported: ptr.To(true),
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Actions: v1alpha1.QualityGatesActions{ManageAiCodeAssurance: true},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"SetAiCodeAssuranceNotAllowed": {
+			client: &fake.MockQualityGatesClient{
+				SetAiCodeAssuranceFn: func(opt *instance.QualitygatesSetAiCodeAssuranceOption) (*http.Response, error) {
+					return nil, errors.New("set ai code assurance should not be called")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "test-gate",
+								AiCodeSupported: ptr.To(true),
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: fmt.Errorf(errAiCodeForbiddenQualityGate, "test-gate"),
+			},
+		},
+		"SetAiCodeAssuranceError": {
+			client: &fake.MockQualityGatesClient{
+				SetAiCodeAssuranceFn: func(opt *instance.QualitygatesSetAiCodeAssuranceOption) (*http.Response, error) {
+					return nil, errors.New("set ai code assurance error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "test-gate",
+								AiCodeSupported: ptr.To(false),
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								IsAiCodeSupported: true,
+								Actions:           v1alpha1.QualityGatesActions{ManageAiCodeAssurance: true},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errors.New("set ai code assurance error"), errAiCodeQualityGate),
+			},
+		},
+		"SyncProjects": {
+			client: &fake.MockQualityGatesClient{
+				SelectFn: func(opt *sonargo.QualitygatesSelectOption) (*http.Response, error) {
+					if opt.GateName != "test-gate" || opt.ProjectKey != "project-b" {
+						return nil, errors.New("unexpected select option")
+					}
+					return nil, nil
+				},
+				DeselectFn: func(opt *sonargo.QualitygatesDeselectOption) (*http.Response, error) {
+					if opt.ProjectKey != "project-c" {
+						return nil, errors.New("unexpected deselect option")
+					}
+					return nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:     "test-gate",
+								Projects: []string{"project-a", "project-b"},
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Projects: []string{"project-a", "project-c"},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"SelectProjectFails": {
+			client: &fake.MockQualityGatesClient{
+				SelectFn: func(opt *sonargo.QualitygatesSelectOption) (*http.Response, error) {
+					return nil, errors.New("select error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:     "test-gate",
+								Projects: []string{"project-a"},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errors.Wrapf(errors.New("select error"), "cannot associate project %s with SonarQube Quality Gate %s", "project-a", "test-gate"), errSyncQualityGateProjects),
+			},
+		},
+		"SyncDelegates": {
+			client: &fake.MockQualityGatesClient{
+				AddUserFn: func(opt *sonargo.QualitygatesAddUserOption) (*http.Response, error) {
+					if opt.GateName != "test-gate" || opt.Login != "bob" {
+						return nil, errors.New("unexpected add user option")
+					}
+					return nil, nil
+				},
+				RemoveUserFn: func(opt *sonargo.QualitygatesRemoveUserOption) (*http.Response, error) {
+					if opt.GateName != "test-gate" || opt.Login != "carol" {
+						return nil, errors.New("unexpected remove user option")
+					}
+					return nil, nil
+				},
+				AddGroupFn: func(opt *sonargo.QualitygatesAddGroupOption) (*http.Response, error) {
+					if opt.GateName != "test-gate" || opt.GroupName != "team-leads" {
+						return nil, errors.New("unexpected add group option")
+					}
+					return nil, nil
+				},
+				RemoveGroupFn: func(opt *sonargo.QualitygatesRemoveGroupOption) (*http.Response, error) {
+					return nil, errors.New("no group should be removed")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:            "test-gate",
+								DelegatedUsers:  []string{"alice", "bob"},
+								DelegatedGroups: []string{"team-leads"},
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								DelegatedUsers: []string{"alice", "carol"},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"AddUserFails": {
+			client: &fake.MockQualityGatesClient{
+				AddUserFn: func(opt *sonargo.QualitygatesAddUserOption) (*http.Response, error) {
+					return nil, errors.New("add user error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:           "test-gate",
+								DelegatedUsers: []string{"alice"},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errors.Wrapf(errors.New("add user error"), "cannot allow user %s to edit SonarQube Quality Gate %s", "alice", "test-gate"), errSyncQualityGateDelegates),
+			},
+		},
+		"RenameQualityGate": {
+			client: &fake.MockQualityGatesClient{
+				RenameFn: func(opt *sonargo.QualitygatesRenameOption) (*http.Response, error) {
+					if opt.CurrentName != "old-gate" || opt.Name != "new-gate" {
+						return nil, errors.New("unexpected rename option")
+					}
+					return nil, nil
+				},
+				SetAsDefaultFn: func(opt *sonargo.QualitygatesSetAsDefaultOption) (*http.Response, error) {
+					if opt.Name != "new-gate" {
+						return nil, errors.New("expected renamed gate name but got: " + opt.Name)
+					}
+					return nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:    "new-gate",
+								Default: ptr.To(true),
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name:    "old-gate",
+								Actions: v1alpha1.QualityGatesActions{Rename: true},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "old-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"RenameBuiltInQualityGateRefused": {
+			client: &fake.MockQualityGatesClient{
+				RenameFn: func(opt *sonargo.QualitygatesRenameOption) (*http.Response, error) {
+					return nil, errors.New("rename should not be called")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name: "My Way",
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name:      "Sonar way",
+								IsBuiltIn: true,
+							},
+						},
+					}
+					meta.SetExternalName(qg, "Sonar way")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: fmt.Errorf(errRenameBuiltInQualityGate, "Sonar way"),
+			},
+		},
+		"RenameNotAllowedRefused": {
+			client: &fake.MockQualityGatesClient{
+				RenameFn: func(opt *sonargo.QualitygatesRenameOption) (*http.Response, error) {
+					return nil, errors.New("rename should not be called")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name: "new-gate",
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name:    "old-gate",
+								Actions: v1alpha1.QualityGatesActions{Rename: false},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "old-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: fmt.Errorf(errRenameForbiddenQualityGate, "old-gate"),
+			},
+		},
+		"RenameFails": {
+			client: &fake.MockQualityGatesClient{
+				RenameFn: func(opt *sonargo.QualitygatesRenameOption) (*http.Response, error) {
+					return nil, errors.New("rename error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name: "new-gate",
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name:    "old-gate",
+								Actions: v1alpha1.QualityGatesActions{Rename: true},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "old-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errors.New("rename error"), errRenameQualityGate),
+			},
+		},
+		"UpdateBuiltInConditionsRefused": {
+			client: &fake.MockQualityGatesClient{
+				CreateConditionFn: func(opt *sonargo.QualitygatesCreateConditionOption) (*sonargo.QualitygatesCreateConditionObject, *http.Response, error) {
+					return nil, nil, errors.New("create condition should not be called")
+				},
 			},
-		},
-		"EmptyExternalNameReturnsError": {
-			client: &fake.MockQualityGatesClient{},
 			args: args{
 				ctx: context.Background(),
-				mg: &v1alpha1.QualityGate{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:        "test-gate",
-						Annotations: map[string]string{},
-					},
-				},
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name: "Sonar way",
+								Conditions: []v1alpha1.QualityGateConditionParameters{
+									{Metric: "new_coverage", Error: "90", Op: ptr.To("LT")},
+								},
+							},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name:      "Sonar way",
+								IsBuiltIn: true,
+							},
+						},
+					}
+					meta.SetExternalName(qg, "Sonar way")
+					return qg
+				}(),
 			},
 			want: want{
 				o:   managed.ExternalUpdate{},
-				err: fmt.Errorf("external name is not set for Quality Gate %s", "test-gate"),
+				err: fmt.Errorf(errUpdateBuiltInQualityGate, "Sonar way"),
 			},
 		},
-		"SetAsDefaultWhenRequested": {
+		"UpdateBuiltInDefaultAllowed": {
 			client: &fake.MockQualityGatesClient{
 				SetAsDefaultFn: func(opt *sonargo.QualitygatesSetAsDefaultOption) (*http.Response, error) {
+					if opt.Name != "Sonar way" {
+						return nil, errors.New("unexpected set as default option")
+					}
 					return nil, nil
 				},
 			},
@@ -488,12 +1490,18 @@ func TestUpdate(t *testing.T) {
 						},
 						Spec: v1alpha1.QualityGateSpec{
 							ForProvider: v1alpha1.QualityGateParameters{
-								Name:    "test-gate",
+								Name:    "Sonar way",
 								Default: ptr.To(true),
 							},
 						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name:      "Sonar way",
+								IsBuiltIn: true,
+							},
+						},
 					}
-					meta.SetExternalName(qg, "test-gate")
+					meta.SetExternalName(qg, "Sonar way")
 					return qg
 				}(),
 			},
@@ -536,7 +1544,8 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{qualityGatesClient: tc.client}
+			calls = nil
+			e := &external{qualityGatesClient: tc.client, metricsClient: newMetricsClient()}
 			got, err := e.Update(tc.args.ctx, tc.args.mg)
 
 			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
@@ -545,6 +1554,9 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("Update() mismatch (-want +got):\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update() calls mismatch (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
@@ -643,6 +1655,60 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errors.New("delete error"), errDeleteQualityGate),
 			},
 		},
+		"DeleteBuiltInRefused": {
+			client: &fake.MockQualityGatesClient{
+				DestroyFn: func(opt *sonargo.QualitygatesDestroyOption) (*http.Response, error) {
+					return nil, errors.New("destroy should not be called")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Status: v1alpha1.QualityGateStatus{
+							AtProvider: v1alpha1.QualityGateObservation{
+								Name:      "Sonar way",
+								IsBuiltIn: true,
+							},
+						},
+					}
+					meta.SetExternalName(qg, "Sonar way")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalDelete{},
+				err: fmt.Errorf(errDeleteBuiltInQualityGate, "Sonar way"),
+			},
+		},
+		"DeleteNotFoundSucceeds": {
+			client: &fake.MockQualityGatesClient{
+				DestroyFn: func(opt *sonargo.QualitygatesDestroyOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalDelete{},
+				err: nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -700,6 +1766,258 @@ func TestCreateSetsExternalNameToSonarQubeName(t *testing.T) {
 	}
 }
 
+func TestObserveAdoptsRenamedQualityGate(t *testing.T) {
+	client := &fake.MockQualityGatesClient{
+		ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+			if opt.Name != "new-gate" {
+				return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+			}
+			return &sonargo.QualitygatesShowObject{Name: "new-gate"}, nil, nil
+		},
+	}
+
+	qg := &v1alpha1.QualityGate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-gate",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.QualityGateSpec{
+			ForProvider: v1alpha1.QualityGateParameters{
+				Name:            "new-gate",
+				Default:         ptr.To(false),
+				AiCodeSupported: ptr.To(false),
+			},
+		},
+	}
+	// The external name still holds the name the Quality Gate had before being renamed
+	meta.SetExternalName(qg, "old-gate")
+
+	e := &external{qualityGatesClient: client}
+	obs, err := e.Observe(context.Background(), qg)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+
+	want := managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: true,
+	}
+	if diff := cmp.Diff(want, obs); diff != "" {
+		t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Verify the external name is updated to the new name so that it gets persisted
+	if externalName := meta.GetExternalName(qg); externalName != "new-gate" {
+		t.Errorf("Expected external name 'new-gate', got '%s'", externalName)
+	}
+}
+
+func TestObserveReportsCaycStatus(t *testing.T) {
+	cases := map[string]struct {
+		policy         *string
+		caycStatus     string
+		previous       string
+		wantCondition  bool
+		wantConditionT corev1.ConditionStatus
+		wantEvents     int
+	}{
+		"IgnorePolicy": {
+			policy:     nil,
+			caycStatus: v1alpha1.CaycStatusNonCompliant,
+		},
+		"WarnPolicyCompliant": {
+			policy:         ptr.To(v1alpha1.CaycPolicyWarn),
+			caycStatus:     v1alpha1.CaycStatusCompliant,
+			wantCondition:  true,
+			wantConditionT: corev1.ConditionTrue,
+		},
+		"WarnPolicyNonCompliant": {
+			policy:         ptr.To(v1alpha1.CaycPolicyWarn),
+			caycStatus:     v1alpha1.CaycStatusNonCompliant,
+			wantCondition:  true,
+			wantConditionT: corev1.ConditionFalse,
+			wantEvents:     1,
+		},
+		"WarnPolicyOverCompliant": {
+			policy:         ptr.To(v1alpha1.CaycPolicyWarn),
+			caycStatus:     v1alpha1.CaycStatusOverCompliant,
+			wantCondition:  true,
+			wantConditionT: corev1.ConditionTrue,
+			wantEvents:     1,
+		},
+		"WarnPolicyStillNonCompliant": {
+			policy:         ptr.To(v1alpha1.CaycPolicyWarn),
+			caycStatus:     v1alpha1.CaycStatusNonCompliant,
+			previous:       v1alpha1.CaycStatusNonCompliant,
+			wantCondition:  true,
+			wantConditionT: corev1.ConditionFalse,
+		},
+		"WarnPolicyBecomesNonCompliant": {
+			policy:         ptr.To(v1alpha1.CaycPolicyWarn),
+			caycStatus:     v1alpha1.CaycStatusNonCompliant,
+			previous:       v1alpha1.CaycStatusCompliant,
+			wantCondition:  true,
+			wantConditionT: corev1.ConditionFalse,
+			wantEvents:     1,
+		},
+		"IgnorePolicyRemovesStaleCondition": {
+			policy:     ptr.To(v1alpha1.CaycPolicyIgnore),
+			caycStatus: v1alpha1.CaycStatusNonCompliant,
+			previous:   v1alpha1.CaycStatusNonCompliant,
+		},
+		"EnforcePolicyNonCompliant": {
+			policy:         ptr.To(v1alpha1.CaycPolicyEnforce),
+			caycStatus:     v1alpha1.CaycStatusNonCompliant,
+			wantCondition:  true,
+			wantConditionT: corev1.ConditionFalse,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.MockQualityGatesClient{
+				ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+					return &sonargo.QualitygatesShowObject{Name: "test-gate", CaycStatus: tc.caycStatus}, nil, nil
+				},
+			}
+			qg := &v1alpha1.QualityGate{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-gate", Annotations: map[string]string{}},
+				Spec: v1alpha1.QualityGateSpec{
+					ForProvider: v1alpha1.QualityGateParameters{Name: "test-gate", CaycPolicy: tc.policy},
+				},
+			}
+			meta.SetExternalName(qg, "test-gate")
+			if tc.previous != "" {
+				qg.Status.SetConditions(instance.GenerateQualityGateCaycCondition(tc.previous))
+			}
+
+			recorder := &eventRecorder{}
+			e := &external{qualityGatesClient: client, recorder: recorder}
+			if _, err := e.Observe(context.Background(), qg); err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+
+			condition := qg.Status.GetCondition(v1alpha1.TypeCaycCompliant)
+			if tc.wantCondition && condition.Status != tc.wantConditionT {
+				t.Errorf("Expected CaycCompliant condition status %s, got %s", tc.wantConditionT, condition.Status)
+			}
+			if !tc.wantCondition && condition.Reason != "" {
+				t.Errorf("Expected no CaycCompliant condition, got %v", condition)
+			}
+			if len(recorder.events) != tc.wantEvents {
+				t.Errorf("Expected %d events, got %d", tc.wantEvents, len(recorder.events))
+			}
+		})
+	}
+}
+
+// eventRecorder records the events emitted by the controller
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestObserveImportAdoptsConditions(t *testing.T) {
+	client := &fake.MockQualityGatesClient{
+		ShowFn: func(opt *sonargo.QualitygatesShowOption) (*sonargo.QualitygatesShowObject, *http.Response, error) {
+			return &sonargo.QualitygatesShowObject{
+				Name:      "Sonar way",
+				IsBuiltIn: true,
+				IsDefault: true,
+				Conditions: []sonargo.QualitygatesShowObject_sub2{
+					{ID: "cond-1", Metric: "new_coverage", Error: "80", Op: "LT"},
+					{ID: "cond-2", Metric: "new_violations", Error: "0", Op: "GT"},
+				},
+			}, nil, nil
+		},
+	}
+
+	cases := map[string]struct {
+		createSucceeded bool
+		wantConditions  []v1alpha1.QualityGateConditionParameters
+		wantUpToDate    bool
+	}{
+		"ImportedQualityGateAdoptsConditions": {
+			createSucceeded: false,
+			wantConditions: []v1alpha1.QualityGateConditionParameters{
+				{Id: ptr.To("cond-1"), Metric: "new_coverage", Error: "80", Op: ptr.To("LT")},
+				{Id: ptr.To("cond-2"), Metric: "new_violations", Error: "0", Op: ptr.To("GT")},
+			},
+			wantUpToDate: true,
+		},
+		"CreatedQualityGateDoesNotAdoptConditions": {
+			createSucceeded: true,
+			wantConditions:  nil,
+			wantUpToDate:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			qg := &v1alpha1.QualityGate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "sonar-way",
+					Annotations: map[string]string{},
+				},
+				Spec: v1alpha1.QualityGateSpec{
+					ForProvider: v1alpha1.QualityGateParameters{
+						Name: "Sonar way",
+					},
+				},
+			}
+			meta.SetExternalName(qg, "Sonar way")
+			if tc.createSucceeded {
+				meta.SetExternalCreateSucceeded(qg, time.Now())
+			}
+
+			e := &external{qualityGatesClient: client}
+			obs, err := e.Observe(context.Background(), qg)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+
+			if !obs.ResourceLateInitialized {
+				t.Errorf("Expected ResourceLateInitialized = true, got false")
+			}
+			if obs.ResourceUpToDate != tc.wantUpToDate {
+				t.Errorf("Expected ResourceUpToDate = %v, got %v", tc.wantUpToDate, obs.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(ptr.To(true), qg.Spec.ForProvider.Default); diff != "" {
+				t.Errorf("Default mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantConditions, qg.Spec.ForProvider.Conditions); diff != "" {
+				t.Errorf("Conditions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// newMetricsClient returns a MockMetricsClient serving a small metrics catalogue
+func newMetricsClient() *fake.MockMetricsClient {
+	return &fake.MockMetricsClient{
+		SearchFn: func(opt *sonargo.MetricsSearchOption) (*sonargo.MetricsSearchObject, *http.Response, error) {
+			return &sonargo.MetricsSearchObject{
+				Metrics: []sonargo.MetricsSearchObject_sub1{
+					{Key: "bugs", Type: "INT"},
+					{Key: "coverage", Type: "PERCENT"},
+					{Key: "new_coverage", Type: "PERCENT"},
+					{Key: "new_security_rating", Type: "RATING"},
+					{Key: "new_violations", Type: "INT"},
+				},
+				Paging: sonargo.MetricsSearchObject_sub2{PageIndex: 1, PageSize: 500, Total: 5},
+			}, nil, nil
+		},
+	}
+}
+
 // errComparer compares errors by their message
 func errComparer(a, b error) bool {
 	if a == nil && b == nil {
@@ -735,8 +2053,9 @@ func TestObserveLateInitializesConditionIds(t *testing.T) {
 		},
 		Spec: v1alpha1.QualityGateSpec{
 			ForProvider: v1alpha1.QualityGateParameters{
-				Name:    "test-gate",
-				Default: ptr.To(false),
+				Name:            "test-gate",
+				Default:         ptr.To(false),
+				AiCodeSupported: ptr.To(false),
 				Conditions: []v1alpha1.QualityGateConditionParameters{
 					{Metric: "coverage", Error: "80", Op: ptr.To("LT")}, // no ID
 					{Metric: "bugs", Error: "0", Op: ptr.To("GT")},      // no ID
@@ -803,8 +2122,9 @@ func TestObserveWithExistingConditionIds(t *testing.T) {
 		},
 		Spec: v1alpha1.QualityGateSpec{
 			ForProvider: v1alpha1.QualityGateParameters{
-				Name:    "test-gate",
-				Default: ptr.To(false),
+				Name:            "test-gate",
+				Default:         ptr.To(false),
+				AiCodeSupported: ptr.To(false),
 				Conditions: []v1alpha1.QualityGateConditionParameters{
 					{Id: ptr.To("cond-id-123"), Metric: "coverage", Error: "80", Op: ptr.To("LT")}, // already has ID
 				},
@@ -860,8 +2180,9 @@ func TestObserveWithStaleConditionId(t *testing.T) {
 		},
 		Spec: v1alpha1.QualityGateSpec{
 			ForProvider: v1alpha1.QualityGateParameters{
-				Name:    "test-gate",
-				Default: ptr.To(false),
+				Name:            "test-gate",
+				Default:         ptr.To(false),
+				AiCodeSupported: ptr.To(false),
 				Conditions: []v1alpha1.QualityGateConditionParameters{
 					{Id: ptr.To("old-stale-id"), Metric: "coverage", Error: "80", Op: ptr.To("LT")}, // stale ID
 				},
@@ -946,6 +2267,72 @@ func TestUpdateWithConditions(t *testing.T) {
 				err: nil,
 			},
 		},
+		"UpdateWithUnknownConditionMetric": {
+			client: &fake.MockQualityGatesClient{
+				CreateConditionFn: func(opt *sonargo.QualitygatesCreateConditionOption) (*sonargo.QualitygatesCreateConditionObject, *http.Response, error) {
+					return nil, nil, errors.New("create condition should not be called")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name: "test-gate",
+								Conditions: []v1alpha1.QualityGateConditionParameters{
+									{Metric: "coverage", Error: "80", Op: ptr.To("LT")},
+									{Metric: "new_coverge", Error: "80", Op: ptr.To("LT")},
+								},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(fmt.Errorf("invalid Quality Gate condition at index 1: %w", fmt.Errorf("unknown metric %q", "new_coverge")), "cannot sync Quality Gate Conditions"),
+			},
+		},
+		"UpdateRefusesNonCaycCompliantConditions": {
+			client: &fake.MockQualityGatesClient{
+				CreateConditionFn: func(opt *sonargo.QualitygatesCreateConditionOption) (*sonargo.QualitygatesCreateConditionObject, *http.Response, error) {
+					return nil, nil, errors.New("create condition should not be called")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.QualityGate {
+					qg := &v1alpha1.QualityGate{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "test-gate",
+							Annotations: map[string]string{},
+						},
+						Spec: v1alpha1.QualityGateSpec{
+							ForProvider: v1alpha1.QualityGateParameters{
+								Name:       "test-gate",
+								CaycPolicy: ptr.To(v1alpha1.CaycPolicyEnforce),
+								Conditions: []v1alpha1.QualityGateConditionParameters{
+									{Metric: "coverage", Error: "80", Op: ptr.To("LT")},
+								},
+							},
+						},
+					}
+					meta.SetExternalName(qg, "test-gate")
+					return qg
+				}(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errors.Wrap(instance.ValidateQualityGateCaycCompliance([]v1alpha1.QualityGateConditionParameters{{Metric: "coverage", Error: "80"}}), errCaycQualityGate), "cannot sync Quality Gate Conditions"),
+			},
+		},
 		"UpdateDeletesOrphanedCondition": {
 			client: &fake.MockQualityGatesClient{
 				DeleteConditionFn: func(opt *sonargo.QualitygatesDeleteConditionOption) (*http.Response, error) {
@@ -1098,7 +2485,7 @@ func TestUpdateWithConditions(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{qualityGatesClient: tc.client}
+			e := &external{qualityGatesClient: tc.client, metricsClient: newMetricsClient()}
 			got, err := e.Update(tc.args.ctx, tc.args.mg)
 
 			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
@@ -1152,8 +2539,9 @@ func TestObserveWithConditions(t *testing.T) {
 						},
 						Spec: v1alpha1.QualityGateSpec{
 							ForProvider: v1alpha1.QualityGateParameters{
-								Name:    "test-gate",
-								Default: ptr.To(false),
+								Name:            "test-gate",
+								Default:         ptr.To(false),
+								AiCodeSupported: ptr.To(false),
 								Conditions: []v1alpha1.QualityGateConditionParameters{
 									{Id: ptr.To("1"), Metric: "coverage", Error: "80", Op: ptr.To("LT")},
 								},
@@ -1198,8 +2586,9 @@ func TestObserveWithConditions(t *testing.T) {
 						},
 						Spec: v1alpha1.QualityGateSpec{
 							ForProvider: v1alpha1.QualityGateParameters{
-								Name:    "test-gate",
-								Default: ptr.To(false),
+								Name:            "test-gate",
+								Default:         ptr.To(false),
+								AiCodeSupported: ptr.To(false),
 								Conditions: []v1alpha1.QualityGateConditionParameters{
 									{Id: ptr.To("1"), Metric: "coverage", Error: "90", Op: ptr.To("LT")},
 								},
@@ -1244,13 +2633,16 @@ func TestObserveWithConditions(t *testing.T) {
 						},
 						Spec: v1alpha1.QualityGateSpec{
 							ForProvider: v1alpha1.QualityGateParameters{
-								Name:       "test-gate",
-								Default:    ptr.To(false),
-								Conditions: []v1alpha1.QualityGateConditionParameters{},
+								Name:            "test-gate",
+								Default:         ptr.To(false),
+								AiCodeSupported: ptr.To(false),
+								Conditions:      []v1alpha1.QualityGateConditionParameters{},
 							},
 						},
 					}
 					meta.SetExternalName(qg, "test-gate")
+					// The Quality Gate was created by the provider, so its orphaned condition must not be adopted
+					meta.SetExternalCreateSucceeded(qg, time.Now())
 					return qg
 				}(),
 			},
@@ -1286,8 +2678,9 @@ func TestObserveWithConditions(t *testing.T) {
 						},
 						Spec: v1alpha1.QualityGateSpec{
 							ForProvider: v1alpha1.QualityGateParameters{
-								Name:    "test-gate",
-								Default: ptr.To(false),
+								Name:            "test-gate",
+								Default:         ptr.To(false),
+								AiCodeSupported: ptr.To(false),
 								Conditions: []v1alpha1.QualityGateConditionParameters{
 									{Metric: "coverage", Error: "80"},
 								},
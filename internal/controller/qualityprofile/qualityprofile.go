@@ -0,0 +1,459 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qualityprofile
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotQualityProfile = "managed resource is not a QualityProfile custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errGetPC             = "cannot get ProviderConfig"
+
+	errCreateQualityProfile  = "cannot create SonarQube Quality Profile"
+	errDefaultQualityProfile = "cannot set SonarQube Quality Profile as default"
+	errDeleteQualityProfile  = "cannot delete SonarQube Quality Profile"
+	errSearchQualityProfile  = "cannot get SonarQube Quality Profile"
+	errRenameQualityProfile  = "cannot rename SonarQube Quality Profile"
+	errParentQualityProfile  = "cannot change the parent of SonarQube Quality Profile"
+
+	errUpdateBuiltInQualityProfile = "cannot update SonarQube Quality Profile %s: built-in Quality Profiles are read-only, only their default status and associated projects can be managed"
+	errDeleteBuiltInQualityProfile = "cannot delete SonarQube Quality Profile %s: built-in Quality Profiles cannot be deleted, set the deletionPolicy to Orphan to remove the resource without deleting the Quality Profile"
+
+	errSearchQualityProfileProjects = "cannot list projects associated with SonarQube Quality Profile"
+	errSyncQualityProfileProjects   = "cannot sync Quality Profile projects"
+	errSearchQualityProfileRules    = "cannot list rules activated in SonarQube Quality Profile"
+	errSyncQualityProfileRules      = "cannot sync Quality Profile rules"
+)
+
+// SetupGated adds a controller that reconciles QualityProfile managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup QualityProfile controller"))
+		}
+	}, v1alpha1.QualityProfileGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.QualityProfileGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewQualityProfilesClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.QualityProfileList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.QualityProfileList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.QualityProfileGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.QualityProfile{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.QualityProfilesClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfile)
+	if !ok {
+		return nil, errors.New(errNotQualityProfile)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{qualityProfilesClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// qualityProfilesClient is used to interact with SonarQube Quality Profiles API
+	qualityProfilesClient instance.QualityProfilesClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotQualityProfile)
+	}
+
+	// The external name is the key of the Quality Profile, which does not change when it is renamed
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Quality Profiles can only be searched by language, find this one among them by its key
+	search, resp, err := c.qualityProfilesClient.Search(instance.GenerateQualityProfileSearchOption(cr.Spec.ForProvider.Language)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityProfile)
+	}
+	profile := instance.FindQualityProfile(search, externalName)
+	if profile == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Retrieve the projects explicitly associated with the Quality Profile
+	projects, err := c.observeQualityProfileProjects(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Retrieve the rules activated in the Quality Profile
+	rules, err := c.observeQualityProfileRules(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateQualityProfileObservation(profile)
+	cr.Status.AtProvider.Projects = projects
+	cr.Status.AtProvider.Rules = rules
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeQualityProfile(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	associations := instance.GenerateQualityProfileRulesAssociation(cr.Spec.ForProvider.Rules, cr.Status.AtProvider.Rules)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsQualityProfileUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, associations),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// observeQualityProfileProjects retrieves the keys of all the projects explicitly associated with the Quality Profile
+func (c *external) observeQualityProfileProjects(key string) ([]string, error) {
+	var projects []string
+	for page := 1; ; page++ {
+		search, resp, err := c.qualityProfilesClient.Projects(instance.GenerateQualityProfileProjectsSearchOption(key, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityProfileProjects)
+		}
+		projects = append(projects, instance.GenerateQualityProfileProjectsObservation(search)...)
+		if !instance.HasMoreQualityProfileProjects(search) {
+			return projects, nil
+		}
+	}
+}
+
+// observeQualityProfileRules retrieves all the rules activated in the Quality Profile, along with their activation
+func (c *external) observeQualityProfileRules(key string) ([]v1alpha1.QualityProfileRuleObservation, error) {
+	var rules []v1alpha1.QualityProfileRuleObservation
+	for page := 1; ; page++ {
+		search, resp, err := c.qualityProfilesClient.SearchActiveRules(instance.GenerateQualityProfileRulesSearchOption(key, page)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityProfileRules)
+		}
+		rules = append(rules, instance.GenerateQualityProfileRulesObservation(search, key)...)
+		if !instance.HasMoreQualityProfileRules(search) {
+			return rules, nil
+		}
+	}
+}
+
+// Create creates the external resource and sets the external name
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotQualityProfile)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	spec := &cr.Spec.ForProvider
+	qualityProfile, resp, err := c.qualityProfilesClient.Create(instance.GenerateQualityProfileCreateOptions(*spec)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateQualityProfile)
+	}
+
+	// Set the external name to the key of the created Quality Profile
+	meta.SetExternalName(cr, qualityProfile.Profile.Key)
+
+	// Inherit from the parent Quality Profile first, so that the desired rules are activated on top of the inherited ones
+	if spec.Parent != nil && *spec.Parent != "" {
+		if err := c.changeQualityProfileParent(spec.Language, spec.Name, *spec.Parent); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	// Set Quality Profile as default if specified in the spec
+	if spec.Default != nil && *spec.Default {
+		if err := c.setQualityProfileDefault(spec.Language, spec.Name); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	// Associate the desired projects with the newly created Quality Profile
+	if err := c.syncQualityProfileProjects(spec.Language, spec.Name, spec.Projects, nil); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSyncQualityProfileProjects)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update updates the external resource to match the desired state of the managed resource
+// The rules of a newly created Quality Profile are activated by the first Update, once the inherited ones are observed
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotQualityProfile)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for Quality Profile %s", cr.Name)
+	}
+
+	spec := &cr.Spec.ForProvider
+	observation := &cr.Status.AtProvider
+	associations := instance.GenerateQualityProfileRulesAssociation(spec.Rules, observation.Rules)
+
+	// Refuse to modify a built-in Quality Profile before calling SonarQube, which would reject it anyway
+	if observation.IsBuiltIn && !isBuiltInQualityProfileUpdateAllowed(spec, observation, associations) {
+		return managed.ExternalUpdate{}, fmt.Errorf(errUpdateBuiltInQualityProfile, observation.Name)
+	}
+
+	// Rename the Quality Profile first, so that every following call targets its new name
+	if spec.Name != observation.Name {
+		renameResp, err := c.qualityProfilesClient.Rename(instance.GenerateQualityProfileRenameOption(externalName, spec.Name)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(renameResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(renameResp, err), errRenameQualityProfile)
+		}
+	}
+
+	// Change the parent before syncing the rules, which depend on the inherited ones
+	if !instance.IsQualityProfileParentUpToDate(spec, observation) {
+		if err := c.changeQualityProfileParent(spec.Language, spec.Name, *spec.Parent); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	// Set Quality Profile as default if specified in the spec and not the default one yet
+	if spec.Default != nil && *spec.Default && !observation.IsDefault {
+		if err := c.setQualityProfileDefault(spec.Language, spec.Name); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	// Sync Quality Profile rules activation
+	if err := c.syncQualityProfileRules(externalName, associations); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSyncQualityProfileRules)
+	}
+
+	// Sync Quality Profile project associations
+	if err := c.syncQualityProfileProjects(spec.Language, spec.Name, spec.Projects, observation.Projects); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSyncQualityProfileProjects)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// isBuiltInQualityProfileUpdateAllowed checks that an update of a built-in Quality Profile only sets it as default or changes its associated projects
+func isBuiltInQualityProfileUpdateAllowed(spec *v1alpha1.QualityProfileParameters, observation *v1alpha1.QualityProfileObservation, associations map[string]instance.QualityProfileRuleAssociation) bool {
+	return spec.Name == observation.Name &&
+		instance.IsQualityProfileParentUpToDate(spec, observation) &&
+		instance.AreQualityProfileRulesUpToDate(associations)
+}
+
+// changeQualityProfileParent makes the Quality Profile inherit from the parent Quality Profile, an empty parent removes the inheritance
+func (c *external) changeQualityProfileParent(language, name, parent string) error {
+	changeParentResp, err := c.qualityProfilesClient.ChangeParent(instance.GenerateQualityProfileChangeParentOption(language, name, parent)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(changeParentResp)
+	if err != nil {
+		return errors.Wrap(common.NewAPIError(changeParentResp, err), errParentQualityProfile)
+	}
+	return nil
+}
+
+// setQualityProfileDefault sets the Quality Profile as the default one for its language
+func (c *external) setQualityProfileDefault(language, name string) error {
+	setDefaultResp, err := c.qualityProfilesClient.SetDefault(instance.GenerateQualityProfileSetDefaultOption(language, name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(setDefaultResp)
+	if err != nil {
+		return errors.Wrap(common.NewAPIError(setDefaultResp, err), errDefaultQualityProfile)
+	}
+	return nil
+}
+
+// syncQualityProfileRules deactivates the undesired rules and activates the missing or out-of-date ones with their overrides
+func (c *external) syncQualityProfileRules(key string, associations map[string]instance.QualityProfileRuleAssociation) error {
+	for _, ruleObservation := range instance.FindQualityProfileRulesToDeactivate(associations) {
+		deactivateResp, err := c.qualityProfilesClient.DeactivateRule(instance.GenerateQualityProfileDeactivateRuleOption(key, ruleObservation.Rule)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(deactivateResp)
+		// A rule that no longer exists is no longer activated
+		if err = common.NewAPIError(deactivateResp, err); err != nil && !common.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot deactivate rule %s in SonarQube Quality Profile %s", ruleObservation.Rule, key)
+		}
+	}
+
+	for _, ruleSpec := range instance.FindQualityProfileRulesToActivate(associations) {
+		activateResp, err := c.qualityProfilesClient.ActivateRule(instance.GenerateQualityProfileActivateRuleOption(key, *ruleSpec)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(activateResp)
+		if err != nil {
+			return errors.Wrapf(common.NewAPIError(activateResp, err), "cannot activate rule %s in SonarQube Quality Profile %s", ruleSpec.Rule, key)
+		}
+	}
+	return nil
+}
+
+// syncQualityProfileProjects associates the desired projects with the Quality Profile and dissociates the undesired ones
+func (c *external) syncQualityProfileProjects(language, name string, specProjects, observedProjects []string) error {
+	for _, projectKey := range instance.FindQualityProfileProjectsToAdd(specProjects, observedProjects) {
+		addResp, err := c.qualityProfilesClient.AddProject(instance.GenerateQualityProfileAddProjectOption(language, name, projectKey)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(addResp)
+		if err != nil {
+			return errors.Wrapf(common.NewAPIError(addResp, err), "cannot associate project %s with SonarQube Quality Profile %s", projectKey, name)
+		}
+	}
+
+	for _, projectKey := range instance.FindQualityProfileProjectsToRemove(specProjects, observedProjects) {
+		removeResp, err := c.qualityProfilesClient.RemoveProject(instance.GenerateQualityProfileRemoveProjectOption(language, name, projectKey)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(removeResp)
+		// A project that no longer exists is no longer associated with the quality profile
+		if err = common.NewAPIError(removeResp, err); err != nil && !common.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot dissociate project %s from SonarQube Quality Profile %s", projectKey, name)
+		}
+	}
+	return nil
+}
+
+// Delete deletes the external resource
+// SonarQube also deletes the Quality Profiles inheriting from the deleted one
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfile)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotQualityProfile)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	// Built-in quality profiles cannot be deleted, refuse with an explanation rather than the raw SonarQube error
+	if cr.Status.AtProvider.IsBuiltIn {
+		return managed.ExternalDelete{}, fmt.Errorf(errDeleteBuiltInQualityProfile, cr.Status.AtProvider.Name)
+	}
+
+	// The delete API identifies the Quality Profile by its name, prefer the observed one in case a rename is pending
+	name := cr.Status.AtProvider.Name
+	if name == "" {
+		name = cr.Spec.ForProvider.Name
+	}
+
+	deleteResp, err := c.qualityProfilesClient.Delete(instance.GenerateQualityProfileDeleteOption(cr.Spec.ForProvider.Language, name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(deleteResp)
+	if err != nil {
+		err = common.NewAPIError(deleteResp, err)
+		// The quality profile is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteQualityProfile)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,604 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qualityprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notQualityProfile struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newQualityProfile(externalName string, params v1alpha1.QualityProfileParameters) *v1alpha1.QualityProfile {
+	qp := &v1alpha1.QualityProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-qualityprofile",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.QualityProfileSpec{
+			ForProvider: params,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(qp, externalName)
+	}
+	return qp
+}
+
+// roundTrip returns the QualityProfile as stored by the API server, which drops the fields omitted from its JSON
+func roundTrip(cr *v1alpha1.QualityProfile) *v1alpha1.QualityProfile {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		panic(err)
+	}
+	stored := &v1alpha1.QualityProfile{}
+	if err := json.Unmarshal(raw, stored); err != nil {
+		panic(err)
+	}
+	return stored
+}
+
+func searchReturning(profiles ...sonargo.QualityprofilesSearchObject_sub3) func(opt *sonargo.QualityprofilesSearchOption) (*sonargo.QualityprofilesSearchObject, *http.Response, error) {
+	return func(opt *sonargo.QualityprofilesSearchOption) (*sonargo.QualityprofilesSearchObject, *http.Response, error) {
+		return &sonargo.QualityprofilesSearchObject{Profiles: profiles}, nil, nil
+	}
+}
+
+func activeRulesReturning(key string, actives map[string]instance.RulesSearchActiveObject) func(opt *sonargo.RulesSearchOption) (*instance.RulesSearchActivesObject, *http.Response, error) {
+	return func(opt *sonargo.RulesSearchOption) (*instance.RulesSearchActivesObject, *http.Response, error) {
+		search := &instance.RulesSearchActivesObject{Actives: map[string][]instance.RulesSearchActiveObject{}}
+		for rule, active := range actives {
+			active.QProfile = key
+			search.Rules = append(search.Rules, instance.RulesSearchActivesRuleObject{Key: rule})
+			search.Actives[rule] = []instance.RulesSearchActiveObject{active}
+		}
+		return search, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	myProfile := sonargo.QualityprofilesSearchObject_sub3{Key: "AU-key", Name: "My Profile", Language: "java", ParentName: "Sonar way"}
+	activeRules := activeRulesReturning("AU-key", map[string]instance.RulesSearchActiveObject{
+		"java:S1144": {Inherit: v1alpha1.QualityProfileRuleInheritanceNone, Severity: "MAJOR"},
+		"java:S107":  {Inherit: v1alpha1.QualityProfileRuleInheritanceInherited, Severity: "MAJOR", Params: []instance.RulesSearchActiveParamObject{{Key: "max", Value: "7"}}},
+	})
+
+	cases := map[string]struct {
+		client *fake.MockQualityProfilesClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotQualityProfileError": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     &notQualityProfile{},
+			want: want{
+				err: errors.New(errNotQualityProfile),
+			},
+		},
+		"EmptyExternalNameReturnsNotExists": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     newQualityProfile("", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchFailsReturnsError": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: func(opt *sonargo.QualityprofilesSearchOption) (*sonargo.QualityprofilesSearchObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusServiceUnavailable}, errors.New("unavailable")
+				},
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: want{
+				err: errors.Wrap(errors.New("unavailable"), errSearchQualityProfile),
+			},
+		},
+		"MissingProfileReturnsNotExists": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(sonargo.QualityprofilesSearchObject_sub3{Key: "AU-other", Name: "Other", Language: "java"}),
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchRulesFailsReturnsError": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(myProfile),
+				SearchActiveRulesFn: func(opt *sonargo.RulesSearchOption) (*instance.RulesSearchActivesObject, *http.Response, error) {
+					return nil, nil, errors.New("rules error")
+				},
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: want{
+				err: errors.Wrap(errors.New("rules error"), errSearchQualityProfileRules),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn:            searchReturning(myProfile),
+				SearchActiveRulesFn: activeRules,
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Parent:   ptr.To("Sonar way"),
+				Default:  ptr.To(false),
+				Rules:    []v1alpha1.QualityProfileRuleParameters{{Rule: "java:S1144", Severity: ptr.To("MAJOR")}},
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitializesDefaultAndParent": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn:            searchReturning(myProfile),
+				SearchActiveRulesFn: activeRules,
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"RuleSeverityDrift": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn:            searchReturning(myProfile),
+				SearchActiveRulesFn: activeRules,
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Parent:   ptr.To("Sonar way"),
+				Default:  ptr.To(false),
+				Rules:    []v1alpha1.QualityProfileRuleParameters{{Rule: "java:S1144", Severity: ptr.To("BLOCKER")}},
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"UndesiredRuleActivated": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn:            searchReturning(myProfile),
+				SearchActiveRulesFn: activeRules,
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Parent:   ptr.To("Sonar way"),
+				Default:  ptr.To(false),
+				Rules:    []v1alpha1.QualityProfileRuleParameters{},
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ParentDrift": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn:            searchReturning(myProfile),
+				SearchActiveRulesFn: activeRules,
+			},
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Parent:   ptr.To("Company way"),
+				Default:  ptr.To(false),
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{qualityProfilesClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		calls        []string
+		err          error
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		create error
+		want   want
+	}{
+		"NotQualityProfileError": {
+			mg: &notQualityProfile{},
+			want: want{
+				err: errors.New(errNotQualityProfile),
+			},
+		},
+		"CreateFails": {
+			mg:     newQualityProfile("", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			create: errors.New("create error"),
+			want: want{
+				calls: []string{"Create:java/My Profile"},
+				err:   errors.Wrap(errors.New("create error"), errCreateQualityProfile),
+			},
+		},
+		"SuccessfulCreateSetsExternalName": {
+			mg: newQualityProfile("", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: want{
+				externalName: "AU-key",
+				calls:        []string{"Create:java/My Profile"},
+			},
+		},
+		"CreateWithParentDefaultAndProjects": {
+			mg: newQualityProfile("", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Parent:   ptr.To("Sonar way"),
+				Default:  ptr.To(true),
+				Projects: []string{"my-project"},
+			}),
+			want: want{
+				externalName: "AU-key",
+				calls: []string{
+					"Create:java/My Profile",
+					"ChangeParent:My Profile->Sonar way",
+					"SetDefault:java/My Profile",
+					"AddProject:My Profile=my-project",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{qualityProfilesClient: &fake.MockQualityProfilesClient{
+				CreateFn: func(opt *sonargo.QualityprofilesCreateOption) (*sonargo.QualityprofilesCreateObject, *http.Response, error) {
+					calls = append(calls, "Create:"+opt.Language+"/"+opt.Name)
+					if tc.create != nil {
+						return nil, nil, tc.create
+					}
+					return &sonargo.QualityprofilesCreateObject{Profile: sonargo.QualityprofilesCreateObject_sub1{Key: "AU-key", Name: opt.Name, Language: opt.Language}}, nil, nil
+				},
+				ChangeParentFn: func(opt *sonargo.QualityprofilesChangeParentOption) (*http.Response, error) {
+					calls = append(calls, "ChangeParent:"+opt.QualityProfile+"->"+opt.ParentQualityProfile)
+					return nil, nil
+				},
+				SetDefaultFn: func(opt *sonargo.QualityprofilesSetDefaultOption) (*http.Response, error) {
+					calls = append(calls, "SetDefault:"+opt.Language+"/"+opt.QualityProfile)
+					return nil, nil
+				},
+				AddProjectFn: func(opt *sonargo.QualityprofilesAddProjectOption) (*http.Response, error) {
+					calls = append(calls, "AddProject:"+opt.QualityProfile+"="+opt.Project)
+					return nil, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Create() calls mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.QualityProfile); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Create() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	observation := v1alpha1.QualityProfileObservation{
+		Key:        "AU-key",
+		Name:       "My Profile",
+		Language:   "java",
+		ParentName: "Sonar way",
+		Projects:   []string{"old-project"},
+		Rules: []v1alpha1.QualityProfileRuleObservation{
+			{Rule: "java:S1144", Severity: "MAJOR", Inherit: v1alpha1.QualityProfileRuleInheritanceNone},
+			{Rule: "java:S107", Severity: "MAJOR", Inherit: v1alpha1.QualityProfileRuleInheritanceInherited, Params: map[string]string{"max": "7"}},
+		},
+	}
+	builtIn := v1alpha1.QualityProfileObservation{Key: "AU-builtin", Name: "Sonar way", Language: "java", IsBuiltIn: true}
+
+	cases := map[string]struct {
+		mg          *v1alpha1.QualityProfile
+		observation v1alpha1.QualityProfileObservation
+		activate    error
+		want        want
+	}{
+		"EmptyExternalNameReturnsError": {
+			mg: newQualityProfile("", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: want{
+				err: fmt.Errorf("external name is not set for Quality Profile %s", "test-qualityprofile"),
+			},
+		},
+		"NothingToUpdate": {
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Parent:   ptr.To("Sonar way"),
+				Projects: []string{"old-project"},
+			}),
+			observation: observation,
+		},
+		"RenameReparentAndSetDefault": {
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Renamed Profile",
+				Language: "java",
+				Parent:   ptr.To(""),
+				Default:  ptr.To(true),
+			}),
+			observation: observation,
+			want: want{
+				calls: []string{
+					"Rename:AU-key=My Renamed Profile",
+					"ChangeParent:My Renamed Profile->",
+					"SetDefault:java/My Renamed Profile",
+				},
+			},
+		},
+		"SyncRulesAndProjects": {
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Projects: []string{"new-project"},
+				Rules: []v1alpha1.QualityProfileRuleParameters{
+					{Rule: "java:S107", Params: map[string]string{"max": "5"}},
+					{Rule: "java:S2068", Severity: ptr.To("BLOCKER")},
+				},
+			}),
+			observation: observation,
+			want: want{
+				calls: []string{
+					"DeactivateRule:AU-key/java:S1144",
+					"ActivateRule:AU-key/java:S107//max=5",
+					"ActivateRule:AU-key/java:S2068/BLOCKER/",
+					"AddProject:My Profile=new-project",
+					"RemoveProject:My Profile=old-project",
+				},
+			},
+		},
+		"DeactivateLastRuleAndRemoveLastProject": {
+			mg: roundTrip(newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Projects: []string{},
+				Rules:    []v1alpha1.QualityProfileRuleParameters{},
+			})),
+			observation: observation,
+			want: want{
+				calls: []string{
+					"DeactivateRule:AU-key/java:S1144",
+					"RemoveProject:My Profile=old-project",
+				},
+			},
+		},
+		"ActivateRuleFails": {
+			mg: newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{
+				Name:     "My Profile",
+				Language: "java",
+				Rules: []v1alpha1.QualityProfileRuleParameters{
+					{Rule: "java:S1144", Severity: ptr.To("MAJOR")},
+					{Rule: "java:S2068", Severity: ptr.To("BLOCKER")},
+				},
+			}),
+			observation: observation,
+			activate:    errors.New("activate error"),
+			want: want{
+				calls: []string{"ActivateRule:AU-key/java:S2068/BLOCKER/"},
+				err:   errors.Wrap(errors.Wrapf(errors.New("activate error"), "cannot activate rule %s in SonarQube Quality Profile %s", "java:S2068", "AU-key"), errSyncQualityProfileRules),
+			},
+		},
+		"BuiltInDefaultAndProjectsAllowed": {
+			mg: newQualityProfile("AU-builtin", v1alpha1.QualityProfileParameters{
+				Name:     "Sonar way",
+				Language: "java",
+				Default:  ptr.To(true),
+				Projects: []string{"my-project"},
+			}),
+			observation: builtIn,
+			want: want{
+				calls: []string{
+					"SetDefault:java/Sonar way",
+					"AddProject:Sonar way=my-project",
+				},
+			},
+		},
+		"BuiltInRulesRefused": {
+			mg: newQualityProfile("AU-builtin", v1alpha1.QualityProfileParameters{
+				Name:     "Sonar way",
+				Language: "java",
+				Rules:    []v1alpha1.QualityProfileRuleParameters{{Rule: "java:S1144"}},
+			}),
+			observation: builtIn,
+			want: want{
+				err: fmt.Errorf(errUpdateBuiltInQualityProfile, "Sonar way"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			record := func(call string) (*http.Response, error) {
+				calls = append(calls, call)
+				return nil, nil
+			}
+			e := &external{qualityProfilesClient: &fake.MockQualityProfilesClient{
+				RenameFn: func(opt *sonargo.QualityprofilesRenameOption) (*http.Response, error) {
+					return record("Rename:" + opt.Key + "=" + opt.Name)
+				},
+				ChangeParentFn: func(opt *sonargo.QualityprofilesChangeParentOption) (*http.Response, error) {
+					return record("ChangeParent:" + opt.QualityProfile + "->" + opt.ParentQualityProfile)
+				},
+				SetDefaultFn: func(opt *sonargo.QualityprofilesSetDefaultOption) (*http.Response, error) {
+					return record("SetDefault:" + opt.Language + "/" + opt.QualityProfile)
+				},
+				ActivateRuleFn: func(opt *sonargo.QualityprofilesActivateRuleOption) (*http.Response, error) {
+					calls = append(calls, "ActivateRule:"+opt.Key+"/"+opt.Rule+"/"+opt.Severity+"/"+opt.Params)
+					return nil, tc.activate
+				},
+				DeactivateRuleFn: func(opt *sonargo.QualityprofilesDeactivateRuleOption) (*http.Response, error) {
+					return record("DeactivateRule:" + opt.Key + "/" + opt.Rule)
+				},
+				AddProjectFn: func(opt *sonargo.QualityprofilesAddProjectOption) (*http.Response, error) {
+					return record("AddProject:" + opt.QualityProfile + "=" + opt.Project)
+				},
+				RemoveProjectFn: func(opt *sonargo.QualityprofilesRemoveProjectOption) (*http.Response, error) {
+					return record("RemoveProject:" + opt.QualityProfile + "=" + opt.Project)
+				},
+			}}
+			tc.mg.Status.AtProvider = tc.observation
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Update() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockQualityProfilesClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotQualityProfileError": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     &notQualityProfile{},
+			want:   errors.New(errNotQualityProfile),
+		},
+		"EmptyExternalNameDoesNothing": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     newQualityProfile("", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want:   nil,
+		},
+		"SuccessfulDelete": {
+			client: &fake.MockQualityProfilesClient{
+				DeleteFn: func(opt *sonargo.QualityprofilesDeleteOption) (*http.Response, error) {
+					if opt.Language != "java" || opt.QualityProfile != "My Profile" {
+						return nil, errors.Errorf("unexpected delete option: %+v", opt)
+					}
+					return nil, nil
+				},
+			},
+			mg:   newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: nil,
+		},
+		"NotFoundIsIgnored": {
+			client: &fake.MockQualityProfilesClient{
+				DeleteFn: func(opt *sonargo.QualityprofilesDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg:   newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: nil,
+		},
+		"DeleteFails": {
+			client: &fake.MockQualityProfilesClient{
+				DeleteFn: func(opt *sonargo.QualityprofilesDeleteOption) (*http.Response, error) {
+					return nil, errors.New("delete error")
+				},
+			},
+			mg:   newQualityProfile("AU-key", v1alpha1.QualityProfileParameters{Name: "My Profile", Language: "java"}),
+			want: errors.Wrap(errors.New("delete error"), errDeleteQualityProfile),
+		},
+		"BuiltInRefused": {
+			client: &fake.MockQualityProfilesClient{},
+			mg: func() resource.Managed {
+				qp := newQualityProfile("AU-builtin", v1alpha1.QualityProfileParameters{Name: "Sonar way", Language: "java"})
+				qp.Status.AtProvider = v1alpha1.QualityProfileObservation{Name: "Sonar way", IsBuiltIn: true}
+				return qp
+			}(),
+			want: fmt.Errorf(errDeleteBuiltInQualityProfile, "Sonar way"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{qualityProfilesClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
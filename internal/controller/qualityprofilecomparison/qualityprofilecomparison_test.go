@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qualityprofilecomparison
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notQualityProfileComparison struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newQualityProfileComparison(params v1alpha1.QualityProfileComparisonParameters) *v1alpha1.QualityProfileComparison {
+	return &v1alpha1.QualityProfileComparison{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-qualityprofilecomparison",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.QualityProfileComparisonSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func compareReturning(comparison *instance.QualityprofilesCompareObject) func(opt *sonargo.QualityprofilesCompareOption) (*instance.QualityprofilesCompareObject, *http.Response, error) {
+	return func(opt *sonargo.QualityprofilesCompareOption) (*instance.QualityprofilesCompareObject, *http.Response, error) {
+		if opt.LeftKey != "AU-left" || opt.RightKey != "AU-right" {
+			return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("unexpected keys")
+		}
+		return comparison, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.QualityProfileComparisonObservation
+		drifted     corev1.ConditionStatus
+		err         error
+	}
+
+	params := v1alpha1.QualityProfileComparisonParameters{LeftKey: ptr.To("AU-left"), RightKey: ptr.To("AU-right")}
+	left := sonargo.QualityprofilesCompareObject_sub3{Key: "AU-left", Name: "Sonar way"}
+	right := sonargo.QualityprofilesCompareObject_sub3{Key: "AU-right", Name: "Company way"}
+
+	cases := map[string]struct {
+		client *fake.MockQualityProfilesClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotQualityProfileComparisonError": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     &notQualityProfileComparison{},
+			want: want{
+				err: errors.New(errNotQualityProfileComparison),
+			},
+		},
+		"CompareFailsReturnsError": {
+			client: &fake.MockQualityProfilesClient{
+				CompareFn: func(opt *sonargo.QualityprofilesCompareOption) (*instance.QualityprofilesCompareObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("profile not found")
+				},
+			},
+			mg: newQualityProfileComparison(params),
+			want: want{
+				err: errors.Wrap(errors.New("profile not found"), errCompareQualityProfiles),
+			},
+		},
+		"IdenticalProfilesAreNotDrifted": {
+			client: &fake.MockQualityProfilesClient{
+				CompareFn: compareReturning(&instance.QualityprofilesCompareObject{
+					Left:  left,
+					Right: right,
+					Same:  []sonargo.QualityprofilesCompareObject_sub2{{Key: "java:S1144"}},
+				}),
+			},
+			mg: newQualityProfileComparison(params),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.QualityProfileComparisonObservation{
+					Left:      v1alpha1.QualityProfileComparisonProfile{Key: "AU-left", Name: "Sonar way"},
+					Right:     v1alpha1.QualityProfileComparisonProfile{Key: "AU-right", Name: "Company way"},
+					SameCount: 1,
+				},
+				drifted: corev1.ConditionFalse,
+			},
+		},
+		"DifferentProfilesAreDrifted": {
+			client: &fake.MockQualityProfilesClient{
+				CompareFn: compareReturning(&instance.QualityprofilesCompareObject{
+					Left:    left,
+					Right:   right,
+					InRight: []sonargo.QualityprofilesCompareObject_sub2{{Key: "java:S100"}},
+					Modified: []instance.QualityprofilesCompareModifiedObject{{
+						Key:   "java:S107",
+						Left:  instance.QualityprofilesCompareActivationObject{Severity: "MAJOR"},
+						Right: instance.QualityprofilesCompareActivationObject{Severity: "CRITICAL"},
+					}},
+				}),
+			},
+			mg: newQualityProfileComparison(params),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.QualityProfileComparisonObservation{
+					Left:         v1alpha1.QualityProfileComparisonProfile{Key: "AU-left", Name: "Sonar way"},
+					Right:        v1alpha1.QualityProfileComparisonProfile{Key: "AU-right", Name: "Company way"},
+					InRight:      []v1alpha1.QualityProfileComparisonRule{{Key: "java:S100"}},
+					InRightCount: 1,
+					Modified: []v1alpha1.QualityProfileComparisonModifiedRule{{
+						Key:   "java:S107",
+						Left:  v1alpha1.QualityProfileComparisonActivation{Severity: "MAJOR"},
+						Right: v1alpha1.QualityProfileComparisonActivation{Severity: "CRITICAL"},
+					}},
+					ModifiedCount: 1,
+				},
+				drifted: corev1.ConditionTrue,
+			},
+		},
+		"DeletedReturnsNotExists": {
+			client: &fake.MockQualityProfilesClient{},
+			mg: func() resource.Managed {
+				qpc := newQualityProfileComparison(params)
+				qpc.SetDeletionTimestamp(ptr.To(metav1.Now()))
+				return qpc
+			}(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{qualityProfilesClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+
+			qpc, ok := tc.mg.(*v1alpha1.QualityProfileComparison)
+			if !ok || tc.want.drifted == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.observation, qpc.Status.AtProvider); diff != "" {
+				t.Errorf("Observe() status mismatch (-want +got):\n%s", diff)
+			}
+			if condition := qpc.Status.GetCondition(v1alpha1.TypeDrifted); condition.Status != tc.want.drifted {
+				t.Errorf("Expected Drifted condition status %s, got %s", tc.want.drifted, condition.Status)
+			}
+		})
+	}
+}
+
+func TestCreateUpdateDeleteDoNothing(t *testing.T) {
+	// Any call to SonarQube would panic, as there is no client
+	e := &external{qualityProfilesClient: nil}
+	mg := newQualityProfileComparison(v1alpha1.QualityProfileComparisonParameters{LeftKey: ptr.To("AU-left"), RightKey: ptr.To("AU-right")})
+
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Errorf("Create() error = %v", err)
+	}
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Errorf("Update() error = %v", err)
+	}
+	if _, err := e.Delete(context.Background(), mg); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+
+	for name, call := range map[string]func() error{
+		"Create": func() error { _, err := e.Create(context.Background(), &notQualityProfileComparison{}); return err },
+		"Update": func() error { _, err := e.Update(context.Background(), &notQualityProfileComparison{}); return err },
+		"Delete": func() error { _, err := e.Delete(context.Background(), &notQualityProfileComparison{}); return err },
+	} {
+		if diff := cmp.Diff(errors.New(errNotQualityProfileComparison), call(), cmp.Comparer(errComparer)); diff != "" {
+			t.Errorf("%s() error mismatch (-want +got):\n%s", name, diff)
+		}
+	}
+}
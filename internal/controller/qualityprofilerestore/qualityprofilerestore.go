@@ -0,0 +1,383 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qualityprofilerestore
+
+import (
+	"context"
+	"fmt"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/backup"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotQualityProfileRestore = "managed resource is not a QualityProfileRestore custom resource"
+	errTrackPCUsage             = "cannot track ProviderConfig usage"
+	errGetPC                    = "cannot get ProviderConfig"
+
+	errGetBackup             = "cannot get Quality Profile backup"
+	errParseBackup           = "cannot parse Quality Profile backup"
+	errParseObservedBackup   = "cannot parse SonarQube Quality Profile backup"
+	errBackupQualityProfile  = "cannot back up SonarQube Quality Profile"
+	errRestoreQualityProfile = "cannot restore SonarQube Quality Profile"
+	errSearchQualityProfile  = "cannot get SonarQube Quality Profile"
+	errRenameQualityProfile  = "cannot rename SonarQube Quality Profile"
+	errDeleteQualityProfile  = "cannot delete SonarQube Quality Profile"
+
+	errQualityProfileExists = "SonarQube Quality Profile %s already exists for language %s, set overwrite to restore the backup over it"
+
+	errDeleteBuiltInQualityProfile = "cannot delete SonarQube Quality Profile %s: built-in Quality Profiles cannot be deleted, set the deletionPolicy to Orphan to remove the resource without deleting the Quality Profile"
+)
+
+// SetupGated adds a controller that reconciles QualityProfileRestore managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup QualityProfileRestore controller"))
+		}
+	}, v1alpha1.QualityProfileRestoreGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.QualityProfileRestoreGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewQualityProfilesClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.QualityProfileRestoreList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.QualityProfileRestoreList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.QualityProfileRestoreGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.QualityProfileRestore{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.QualityProfilesClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfileRestore)
+	if !ok {
+		return nil, errors.New(errNotQualityProfileRestore)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{kube: c.kube, qualityProfilesClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// kube is used to read the backups referenced from a ConfigMap
+	kube client.Client
+	// qualityProfilesClient is used to interact with SonarQube Quality Profiles API
+	qualityProfilesClient instance.QualityProfilesClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfileRestore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotQualityProfileRestore)
+	}
+
+	_, desired, err := c.getBackup(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The external name is the key of the restored Quality Profile
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	profile, err := c.findQualityProfile(desired.Language, externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if profile == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Export the Quality Profile, and compare its canonical form with the desired backup
+	data, backupResp, err := c.qualityProfilesClient.Backup(instance.GenerateQualityProfileBackupOption(profile.Language, profile.Name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(backupResp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(backupResp, err), errBackupQualityProfile)
+	}
+	observed, err := backup.Parse(ptr.Deref(data, ""))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errParseObservedBackup)
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateQualityProfileRestoreObservation(profile, backup.Diff(desired, observed))
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: instance.IsQualityProfileRestoreUpToDate(desired, &cr.Status.AtProvider),
+	}, nil
+}
+
+// findQualityProfile finds the Quality Profile with the given key among the Quality Profiles of a language, or of all languages if empty
+// It returns nil if there is no such Quality Profile
+func (c *external) findQualityProfile(language, key string) (*sonargo.QualityprofilesSearchObject_sub3, error) {
+	// Quality Profiles can only be searched by language, find this one among them by its key
+	search, resp, err := c.qualityProfilesClient.Search(instance.GenerateQualityProfileSearchOption(language)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityProfile)
+	}
+	return instance.FindQualityProfile(search, key), nil
+}
+
+// getBackup retrieves the desired backup, inline or from the referenced ConfigMap, and parses it
+// The raw backup is returned along with the parsed one, so that the elements ignored by the parser are still restored
+func (c *external) getBackup(ctx context.Context, cr *v1alpha1.QualityProfileRestore) (string, *backup.Profile, error) {
+	var data string
+	switch {
+	case cr.Spec.ForProvider.Backup != nil:
+		data = *cr.Spec.ForProvider.Backup
+	case cr.Spec.ForProvider.BackupConfigMapRef != nil:
+		ref := cr.Spec.ForProvider.BackupConfigMapRef
+		value, err := common.GetValueFromLocalConfigMap(ctx, c.kube, cr, ref.Name, ref.Key)
+		if err != nil {
+			return "", nil, errors.Wrap(err, errGetBackup)
+		}
+		data = *value
+	default:
+		return "", nil, errors.New(errGetBackup)
+	}
+
+	desired, err := backup.Parse(data)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errParseBackup)
+	}
+	return data, desired, nil
+}
+
+// Create restores the backup and sets the external name
+// An existing Quality Profile with the name and language of the backup is only overwritten when Overwrite is true
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfileRestore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotQualityProfileRestore)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	data, desired, err := c.getBackup(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// Restoring a backup overwrites the Quality Profile with the same name and language, which may not belong to this resource
+	if !ptr.Deref(cr.Spec.ForProvider.Overwrite, false) {
+		search, resp, err := c.qualityProfilesClient.Search(instance.GenerateQualityProfileSearchOption(desired.Language)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(resp)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errSearchQualityProfile)
+		}
+		if instance.FindQualityProfileByName(search, desired.Language, desired.Name) != nil {
+			return managed.ExternalCreation{}, fmt.Errorf(errQualityProfileExists, desired.Name, desired.Language)
+		}
+	}
+
+	restored, err := c.restoreQualityProfile(data)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// Set the external name to the key of the restored Quality Profile
+	meta.SetExternalName(cr, restored.Profile.Key)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update restores the backup again, which resets the rules of the Quality Profile to those of the backup
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfileRestore)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotQualityProfileRestore)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for Quality Profile restore %s", cr.Name)
+	}
+
+	data, desired, err := c.getBackup(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The restore identifies the Quality Profile by its name, rename it first so that it is overwritten instead of a new one being created
+	if desired.Name != cr.Status.AtProvider.Name {
+		renameResp, err := c.qualityProfilesClient.Rename(instance.GenerateQualityProfileRenameOption(externalName, desired.Name)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(renameResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(renameResp, err), errRenameQualityProfile)
+		}
+	}
+
+	if _, err := c.restoreQualityProfile(data); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// restoreQualityProfile restores a Quality Profile backup
+func (c *external) restoreQualityProfile(data string) (*instance.QualityprofilesRestoreObject, error) {
+	restored, resp, err := c.qualityProfilesClient.Restore(instance.GenerateQualityProfileRestoreOption(data)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errRestoreQualityProfile)
+	}
+	return restored, nil
+}
+
+// Delete deletes the restored Quality Profile
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.QualityProfileRestore)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotQualityProfileRestore)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	// The Quality Profile may not have been observed yet, find its name and language by its key
+	observation := cr.Status.AtProvider
+	if observation.Name == "" {
+		// The backup only narrows the search down to its language, an unreadable backup must not prevent the deletion
+		language := ""
+		if _, desired, err := c.getBackup(ctx, cr); err == nil {
+			language = desired.Language
+		}
+		profile, err := c.findQualityProfile(language, externalName)
+		if err != nil {
+			return managed.ExternalDelete{}, err
+		}
+		if profile == nil {
+			return managed.ExternalDelete{}, nil
+		}
+		observation = instance.GenerateQualityProfileRestoreObservation(profile, backup.Drift{})
+	}
+
+	// Built-in quality profiles cannot be deleted, refuse with an explanation rather than the raw SonarQube error
+	if observation.IsBuiltIn {
+		return managed.ExternalDelete{}, fmt.Errorf(errDeleteBuiltInQualityProfile, observation.Name)
+	}
+
+	deleteResp, err := c.qualityProfilesClient.Delete(instance.GenerateQualityProfileDeleteOption(observation.Language, observation.Name)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(deleteResp)
+	if err != nil {
+		err = common.NewAPIError(deleteResp, err)
+		// The quality profile is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteQualityProfile)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,548 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qualityprofilerestore
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const curatedBackup = `<?xml version='1.0' encoding='UTF-8'?>
+<profile>
+  <name>Company way</name>
+  <language>java</language>
+  <rules>
+    <rule>
+      <repositoryKey>java</repositoryKey>
+      <key>S1144</key>
+      <priority>MAJOR</priority>
+    </rule>
+    <rule>
+      <repositoryKey>java</repositoryKey>
+      <key>S107</key>
+      <priority>CRITICAL</priority>
+      <parameters>
+        <parameter><key>max</key><value>5</value></parameter>
+      </parameters>
+    </rule>
+  </rules>
+</profile>`
+
+// exportedBackup is the backup of curatedBackup as exported by SonarQube, with its rules ordered differently and the default parameters
+const exportedBackup = `<?xml version='1.0' encoding='UTF-8'?><profile><name>Company way</name><language>java</language><rules><rule><repositoryKey>java</repositoryKey><key>S107</key><type>CODE_SMELL</type><priority>CRITICAL</priority><parameters><parameter><key>constructorMax</key><value>7</value></parameter><parameter><key>max</key><value>5</value></parameter></parameters></rule><rule><repositoryKey>java</repositoryKey><key>S1144</key><type>CODE_SMELL</type><priority>MAJOR</priority><parameters/></rule></rules></profile>`
+
+// driftedBackup is the backup of a Quality Profile whose rules were changed outside of the QualityProfileRestore
+const driftedBackup = `<profile><name>Company way</name><language>java</language><rules><rule><repositoryKey>java</repositoryKey><key>S107</key><priority>CRITICAL</priority><parameters><parameter><key>max</key><value>9</value></parameter></parameters></rule><rule><repositoryKey>java</repositoryKey><key>S100</key><priority>MINOR</priority></rule></rules></profile>`
+
+type notQualityProfileRestore struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newQualityProfileRestore(externalName string, params v1alpha1.QualityProfileRestoreParameters) *v1alpha1.QualityProfileRestore {
+	qpr := &v1alpha1.QualityProfileRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-qualityprofilerestore",
+			Namespace:   "default",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.QualityProfileRestoreSpec{
+			ForProvider: params,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(qpr, externalName)
+	}
+	return qpr
+}
+
+func newKubeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	return fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func searchReturning(profiles ...sonargo.QualityprofilesSearchObject_sub3) func(opt *sonargo.QualityprofilesSearchOption) (*sonargo.QualityprofilesSearchObject, *http.Response, error) {
+	return func(opt *sonargo.QualityprofilesSearchOption) (*sonargo.QualityprofilesSearchObject, *http.Response, error) {
+		return &sonargo.QualityprofilesSearchObject{Profiles: profiles}, nil, nil
+	}
+}
+
+func backupReturning(data string) func(opt *sonargo.QualityprofilesBackupOption) (*string, *http.Response, error) {
+	return func(opt *sonargo.QualityprofilesBackupOption) (*string, *http.Response, error) {
+		return &data, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.QualityProfileRestoreObservation
+		err         error
+	}
+
+	companyWay := sonargo.QualityprofilesSearchObject_sub3{Key: "AU-key", Name: "Company way", Language: "java", ActiveRuleCount: 2}
+	inline := v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}
+	fromConfigMap := v1alpha1.QualityProfileRestoreParameters{BackupConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "backups", Key: "company-way.xml"}}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "backups", Namespace: "default"},
+		Data:       map[string]string{"company-way.xml": curatedBackup},
+	}
+
+	cases := map[string]struct {
+		client *fake.MockQualityProfilesClient
+		kube   client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"NotQualityProfileRestoreError": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     &notQualityProfileRestore{},
+			want: want{
+				err: errors.New(errNotQualityProfileRestore),
+			},
+		},
+		"InvalidBackupReturnsError": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To("<profile><language>java</language></profile>")}),
+			want: want{
+				err: errors.Wrap(errors.New("Quality Profile backup XML has no profile name"), errParseBackup),
+			},
+		},
+		"MissingConfigMapReturnsError": {
+			client: &fake.MockQualityProfilesClient{},
+			kube:   newKubeClient(),
+			mg:     newQualityProfileRestore("AU-key", fromConfigMap),
+			want: want{
+				err: errors.Wrap(errors.Wrap(kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "backups"), common.ErrConfigMapNotFound), errGetBackup),
+			},
+		},
+		"EmptyExternalNameReturnsNotExists": {
+			client: &fake.MockQualityProfilesClient{},
+			mg:     newQualityProfileRestore("", inline),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MissingProfileReturnsNotExists": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(sonargo.QualityprofilesSearchObject_sub3{Key: "AU-other", Name: "Other", Language: "java"}),
+			},
+			mg: newQualityProfileRestore("AU-key", inline),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"BackupFailsReturnsError": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(companyWay),
+				BackupFn: func(opt *sonargo.QualityprofilesBackupOption) (*string, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newQualityProfileRestore("AU-key", inline),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errBackupQualityProfile),
+			},
+		},
+		"ReorderedExportIsUpToDate": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(companyWay),
+				BackupFn: backupReturning(exportedBackup),
+			},
+			mg: newQualityProfileRestore("AU-key", inline),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.QualityProfileRestoreObservation{ActiveRuleCount: 2, Key: "AU-key", Language: "java", Name: "Company way"},
+			},
+		},
+		"ConfigMapBackupIsUpToDate": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(companyWay),
+				BackupFn: backupReturning(exportedBackup),
+			},
+			kube: newKubeClient(configMap),
+			mg:   newQualityProfileRestore("AU-key", fromConfigMap),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.QualityProfileRestoreObservation{ActiveRuleCount: 2, Key: "AU-key", Language: "java", Name: "Company way"},
+			},
+		},
+		"DriftedRulesAreNotUpToDate": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(companyWay),
+				BackupFn: backupReturning(driftedBackup),
+			},
+			mg: newQualityProfileRestore("AU-key", inline),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.QualityProfileRestoreObservation{
+					ActiveRuleCount: 2,
+					Key:             "AU-key",
+					Language:        "java",
+					Name:            "Company way",
+					MissingRules:    []string{"java:S1144"},
+					UnexpectedRules: []string{"java:S100"},
+					ModifiedRules:   []string{"java:S107"},
+				},
+			},
+		},
+		"RenamedProfileIsNotUpToDate": {
+			client: &fake.MockQualityProfilesClient{
+				SearchFn: searchReturning(sonargo.QualityprofilesSearchObject_sub3{Key: "AU-key", Name: "Old way", Language: "java", ActiveRuleCount: 2}),
+				BackupFn: backupReturning(exportedBackup),
+			},
+			mg: newQualityProfileRestore("AU-key", inline),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.QualityProfileRestoreObservation{ActiveRuleCount: 2, Key: "AU-key", Language: "java", Name: "Old way"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, qualityProfilesClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.QualityProfileRestore); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("Observe() status mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		calls        []string
+		err          error
+	}
+
+	existing := sonargo.QualityprofilesSearchObject_sub3{Key: "AU-existing", Name: "Company way", Language: "java"}
+
+	cases := map[string]struct {
+		mg       resource.Managed
+		profiles []sonargo.QualityprofilesSearchObject_sub3
+		restore  error
+		want     want
+	}{
+		"NotQualityProfileRestoreError": {
+			mg: &notQualityProfileRestore{},
+			want: want{
+				err: errors.New(errNotQualityProfileRestore),
+			},
+		},
+		"RestoreFails": {
+			mg:      newQualityProfileRestore("", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			restore: errors.New("restore error"),
+			want: want{
+				calls: []string{"Search:java", "Restore"},
+				err:   errors.Wrap(errors.New("restore error"), errRestoreQualityProfile),
+			},
+		},
+		"SuccessfulRestoreSetsExternalName": {
+			mg:       newQualityProfileRestore("", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			profiles: []sonargo.QualityprofilesSearchObject_sub3{{Key: "AU-other", Name: "Company way", Language: "py"}},
+			want: want{
+				externalName: "AU-key",
+				calls:        []string{"Search:java", "Restore"},
+			},
+		},
+		"ExistingProfileIsNotOverwritten": {
+			mg:       newQualityProfileRestore("", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			profiles: []sonargo.QualityprofilesSearchObject_sub3{existing},
+			want: want{
+				calls: []string{"Search:java"},
+				err:   fmt.Errorf(errQualityProfileExists, "Company way", "java"),
+			},
+		},
+		"ExistingProfileIsOverwrittenWhenAllowed": {
+			mg:       newQualityProfileRestore("", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup), Overwrite: ptr.To(true)}),
+			profiles: []sonargo.QualityprofilesSearchObject_sub3{existing},
+			want: want{
+				externalName: "AU-key",
+				calls:        []string{"Restore"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{qualityProfilesClient: &fake.MockQualityProfilesClient{
+				SearchFn: func(opt *sonargo.QualityprofilesSearchOption) (*sonargo.QualityprofilesSearchObject, *http.Response, error) {
+					calls = append(calls, "Search:"+opt.Language)
+					return &sonargo.QualityprofilesSearchObject{Profiles: tc.profiles}, nil, nil
+				},
+				RestoreFn: func(opt *sonargo.QualityprofilesRestoreOption) (*instance.QualityprofilesRestoreObject, *http.Response, error) {
+					calls = append(calls, "Restore")
+					if opt.Backup != curatedBackup {
+						t.Errorf("Restore() should send the backup as written, got %q", opt.Backup)
+					}
+					if tc.restore != nil {
+						return nil, nil, tc.restore
+					}
+					return &instance.QualityprofilesRestoreObject{Profile: instance.QualityprofilesRestoreProfileObject{Key: "AU-key", Name: "Company way", Language: "java"}}, nil, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Create() calls mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.QualityProfileRestore); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Create() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg          resource.Managed
+		observation v1alpha1.QualityProfileRestoreObservation
+		rename      error
+		want        want
+	}{
+		"NotQualityProfileRestoreError": {
+			mg: &notQualityProfileRestore{},
+			want: want{
+				err: errors.New(errNotQualityProfileRestore),
+			},
+		},
+		"MissingExternalNameError": {
+			mg: newQualityProfileRestore("", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			want: want{
+				err: fmt.Errorf("external name is not set for Quality Profile restore test-qualityprofilerestore"),
+			},
+		},
+		"DriftedRulesAreRestored": {
+			mg:          newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			observation: v1alpha1.QualityProfileRestoreObservation{Key: "AU-key", Name: "Company way", Language: "java", MissingRules: []string{"java:S1144"}},
+			want: want{
+				calls: []string{"Restore"},
+			},
+		},
+		"RenamedProfileIsRenamedBeforeRestore": {
+			mg:          newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			observation: v1alpha1.QualityProfileRestoreObservation{Key: "AU-key", Name: "Old way", Language: "java"},
+			want: want{
+				calls: []string{"Rename:AU-key->Company way", "Restore"},
+			},
+		},
+		"RenameFails": {
+			mg:          newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			observation: v1alpha1.QualityProfileRestoreObservation{Key: "AU-key", Name: "Old way", Language: "java"},
+			rename:      errors.New("rename error"),
+			want: want{
+				calls: []string{"Rename:AU-key->Company way"},
+				err:   errors.Wrap(errors.New("rename error"), errRenameQualityProfile),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{qualityProfilesClient: &fake.MockQualityProfilesClient{
+				RenameFn: func(opt *sonargo.QualityprofilesRenameOption) (*http.Response, error) {
+					calls = append(calls, "Rename:"+opt.Key+"->"+opt.Name)
+					return nil, tc.rename
+				},
+				RestoreFn: func(opt *sonargo.QualityprofilesRestoreOption) (*instance.QualityprofilesRestoreObject, *http.Response, error) {
+					calls = append(calls, "Restore")
+					return &instance.QualityprofilesRestoreObject{Profile: instance.QualityprofilesRestoreProfileObject{Key: "AU-key"}}, nil, nil
+				},
+			}}
+			if cr, ok := tc.mg.(*v1alpha1.QualityProfileRestore); ok {
+				cr.Status.AtProvider = tc.observation
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Update() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg          resource.Managed
+		observation v1alpha1.QualityProfileRestoreObservation
+		profiles    []sonargo.QualityprofilesSearchObject_sub3
+		delete      error
+		want        want
+	}{
+		"NotQualityProfileRestoreError": {
+			mg: &notQualityProfileRestore{},
+			want: want{
+				err: errors.New(errNotQualityProfileRestore),
+			},
+		},
+		"NeverRestoredDoesNothing": {
+			mg: newQualityProfileRestore("", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+		},
+		"BuiltInProfileIsRefused": {
+			mg:          newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			observation: v1alpha1.QualityProfileRestoreObservation{Key: "AU-key", Name: "Sonar way", Language: "java", IsBuiltIn: true},
+			want: want{
+				err: fmt.Errorf(errDeleteBuiltInQualityProfile, "Sonar way"),
+			},
+		},
+		"SuccessfulDelete": {
+			mg:          newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			observation: v1alpha1.QualityProfileRestoreObservation{Key: "AU-key", Name: "Company way", Language: "java"},
+			want: want{
+				calls: []string{"Delete:java/Company way"},
+			},
+		},
+		"UnobservedProfileIsFoundByKey": {
+			mg:       newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			profiles: []sonargo.QualityprofilesSearchObject_sub3{{Key: "AU-key", Name: "Company way", Language: "java"}},
+			want: want{
+				calls: []string{"Search:java", "Delete:java/Company way"},
+			},
+		},
+		"UnobservedProfileWithoutBackupIsFoundAmongAllLanguages": {
+			mg:       newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{BackupConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "backups", Key: "backup.xml"}}),
+			profiles: []sonargo.QualityprofilesSearchObject_sub3{{Key: "AU-key", Name: "Company way", Language: "java"}},
+			want: want{
+				calls: []string{"Search:", "Delete:java/Company way"},
+			},
+		},
+		"UnobservedBuiltInProfileIsRefused": {
+			mg:       newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			profiles: []sonargo.QualityprofilesSearchObject_sub3{{Key: "AU-key", Name: "Sonar way", Language: "java", IsBuiltIn: true}},
+			want: want{
+				calls: []string{"Search:java"},
+				err:   fmt.Errorf(errDeleteBuiltInQualityProfile, "Sonar way"),
+			},
+		},
+		"UnobservedMissingProfileDoesNothing": {
+			mg: newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			want: want{
+				calls: []string{"Search:java"},
+			},
+		},
+		"AlreadyDeletedIsIgnored": {
+			mg:          newQualityProfileRestore("AU-key", v1alpha1.QualityProfileRestoreParameters{Backup: ptr.To(curatedBackup)}),
+			observation: v1alpha1.QualityProfileRestoreObservation{Key: "AU-key", Name: "Company way", Language: "java"},
+			delete:      errors.New("not found"),
+			want: want{
+				calls: []string{"Delete:java/Company way"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{kube: newKubeClient(), qualityProfilesClient: &fake.MockQualityProfilesClient{
+				SearchFn: func(opt *sonargo.QualityprofilesSearchOption) (*sonargo.QualityprofilesSearchObject, *http.Response, error) {
+					calls = append(calls, "Search:"+opt.Language)
+					return &sonargo.QualityprofilesSearchObject{Profiles: tc.profiles}, nil, nil
+				},
+				DeleteFn: func(opt *sonargo.QualityprofilesDeleteOption) (*http.Response, error) {
+					calls = append(calls, "Delete:"+opt.Language+"/"+opt.QualityProfile)
+					if tc.delete != nil {
+						return &http.Response{StatusCode: http.StatusNotFound}, tc.delete
+					}
+					return nil, nil
+				},
+			}}
+			if cr, ok := tc.mg.(*v1alpha1.QualityProfileRestore); ok {
+				cr.Status.AtProvider = tc.observation
+			}
+			_, err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Delete() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,8 +20,26 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane/provider-sonarqube/internal/controller/almsetting"
+	"github.com/crossplane/provider-sonarqube/internal/controller/application"
 	"github.com/crossplane/provider-sonarqube/internal/controller/config"
+	"github.com/crossplane/provider-sonarqube/internal/controller/group"
+	"github.com/crossplane/provider-sonarqube/internal/controller/groupmembership"
+	"github.com/crossplane/provider-sonarqube/internal/controller/newcodeperiod"
+	"github.com/crossplane/provider-sonarqube/internal/controller/permission"
+	"github.com/crossplane/provider-sonarqube/internal/controller/permissiontemplate"
+	"github.com/crossplane/provider-sonarqube/internal/controller/portfolio"
+	"github.com/crossplane/provider-sonarqube/internal/controller/project"
+	"github.com/crossplane/provider-sonarqube/internal/controller/projectalmbinding"
+	"github.com/crossplane/provider-sonarqube/internal/controller/projectbranch"
 	"github.com/crossplane/provider-sonarqube/internal/controller/qualitygate"
+	"github.com/crossplane/provider-sonarqube/internal/controller/qualityprofile"
+	"github.com/crossplane/provider-sonarqube/internal/controller/qualityprofilecomparison"
+	"github.com/crossplane/provider-sonarqube/internal/controller/qualityprofilerestore"
+	"github.com/crossplane/provider-sonarqube/internal/controller/setting"
+	"github.com/crossplane/provider-sonarqube/internal/controller/user"
+	"github.com/crossplane/provider-sonarqube/internal/controller/usertoken"
+	"github.com/crossplane/provider-sonarqube/internal/controller/webhook"
 )
 
 // SetupGated creates all SonarQube controllers with safe-start support and adds them to
@@ -30,6 +48,24 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		config.Setup,
 		qualitygate.SetupGated,
+		project.SetupGated,
+		qualityprofile.SetupGated,
+		qualityprofilecomparison.SetupGated,
+		qualityprofilerestore.SetupGated,
+		user.SetupGated,
+		group.SetupGated,
+		groupmembership.SetupGated,
+		usertoken.SetupGated,
+		permission.SetupGated,
+		permissiontemplate.SetupGated,
+		webhook.SetupGated,
+		setting.SetupGated,
+		newcodeperiod.SetupGated,
+		projectbranch.SetupGated,
+		almsetting.SetupGated,
+		projectalmbinding.SetupGated,
+		application.SetupGated,
+		portfolio.SetupGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notSetting struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newSetting(params v1alpha1.SettingParameters) *v1alpha1.Setting {
+	return &v1alpha1.Setting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-setting",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.SettingSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func valuesReturning(settings ...instance.SettingValueObject) func(opt *sonargo.SettingsValuesOption) (*instance.SettingsValuesObject, *http.Response, error) {
+	return func(opt *sonargo.SettingsValuesOption) (*instance.SettingsValuesObject, *http.Response, error) {
+		return &instance.SettingsValuesObject{Settings: settings}, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.SettingObservation
+		spec        v1alpha1.SettingParameters
+		err         error
+	}
+
+	exclusions := instance.SettingValueObject{Key: "sonar.exclusions", Values: []string{"**/generated/**"}, ParentValues: []string{"**/vendor/**"}}
+	inherited := instance.SettingValueObject{Key: "sonar.exclusions", Values: []string{"**/vendor/**"}, Inherited: true}
+	deleted := newSetting(v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions", Values: []string{"**/generated/**"}})
+	deleted.SetDeletionTimestamp(ptr.To(metav1.Now()))
+
+	cases := map[string]struct {
+		client *fake.MockSettingsClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSettingError": {
+			client: &fake.MockSettingsClient{},
+			mg:     &notSetting{},
+			want: want{
+				err: errors.New(errNotSetting),
+			},
+		},
+		"ValuesFailsReturnsError": {
+			client: &fake.MockSettingsClient{
+				ValuesFn: func(opt *sonargo.SettingsValuesOption) (*instance.SettingsValuesObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newSetting(v1alpha1.SettingParameters{Key: "sonar.exclusions"}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errGetSetting),
+			},
+		},
+		"MissingProjectReturnsNotExists": {
+			client: &fake.MockSettingsClient{
+				ValuesFn: func(opt *sonargo.SettingsValuesOption) (*instance.SettingsValuesObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newSetting(v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoValueReturnsNotExists": {
+			client: &fake.MockSettingsClient{
+				ValuesFn: valuesReturning(),
+			},
+			mg: newSetting(v1alpha1.SettingParameters{Key: "sonar.links.scm", Value: ptr.To("https://git.example.com")}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"OverriddenUpToDate": {
+			client: &fake.MockSettingsClient{
+				ValuesFn: valuesReturning(exclusions),
+			},
+			mg: newSetting(v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions", Values: []string{"**/generated/**"}}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.SettingObservation{Values: []string{"**/generated/**"}, ParentValues: []string{"**/vendor/**"}},
+				spec:        v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions", Values: []string{"**/generated/**"}},
+			},
+		},
+		"InheritedIsLateInitializedAndOverridden": {
+			client: &fake.MockSettingsClient{
+				ValuesFn: valuesReturning(inherited),
+			},
+			mg: newSetting(v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions"}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+				observation: v1alpha1.SettingObservation{Values: []string{"**/vendor/**"}, Inherited: true},
+				spec:        v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions", Values: []string{"**/vendor/**"}},
+			},
+		},
+		"ResetWhileDeletedReturnsNotExists": {
+			client: &fake.MockSettingsClient{
+				ValuesFn: valuesReturning(inherited),
+			},
+			mg: deleted,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{settingsClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Setting); ok && tc.want.err == nil && tc.want.o.ResourceExists {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.spec, cr.Spec.ForProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want spec, +got spec:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		option *instance.SettingsSetOption
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		fail bool
+		want want
+	}{
+		"NotSettingError": {
+			mg: &notSetting{},
+			want: want{
+				err: errors.New(errNotSetting),
+			},
+		},
+		"NoValueReturnsError": {
+			mg: newSetting(v1alpha1.SettingParameters{Key: "sonar.links.scm"}),
+			want: want{
+				err: fmt.Errorf(errNoValue, "sonar.links.scm"),
+			},
+		},
+		"SecuredReturnsError": {
+			mg: newSetting(v1alpha1.SettingParameters{Key: "sonar.auth.github.clientSecret.secured", Value: ptr.To("secret")}),
+			want: want{
+				err: fmt.Errorf(errSecured, "sonar.auth.github.clientSecret.secured"),
+			},
+		},
+		"SetsValue": {
+			mg: newSetting(v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.links.scm", Value: ptr.To("https://git.example.com")}),
+			want: want{
+				option: &instance.SettingsSetOption{Component: "my-project", Key: "sonar.links.scm", Value: "https://git.example.com"},
+			},
+		},
+		"SetFailsReturnsError": {
+			mg:   newSetting(v1alpha1.SettingParameters{Key: "sonar.exclusions", Values: []string{"**/generated/**"}}),
+			fail: true,
+			want: want{
+				option: &instance.SettingsSetOption{Key: "sonar.exclusions", Values: []string{"**/generated/**"}},
+				err:    errors.Wrap(errors.New("unknown setting"), errSetSetting),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var option *instance.SettingsSetOption
+			client := &fake.MockSettingsClient{
+				SetFn: func(opt *instance.SettingsSetOption) (*http.Response, error) {
+					option = opt
+					if tc.fail {
+						return &http.Response{StatusCode: http.StatusBadRequest}, errors.New("unknown setting")
+					}
+					return nil, nil
+				},
+			}
+			e := external{settingsClient: client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.option, option); diff != "" {
+				t.Errorf("\ne.Create(...): -want option, +got option:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var option *instance.SettingsSetOption
+	client := &fake.MockSettingsClient{
+		SetFn: func(opt *instance.SettingsSetOption) (*http.Response, error) {
+			option = opt
+			return nil, nil
+		},
+	}
+	e := external{settingsClient: client}
+
+	if _, err := e.Update(context.Background(), &notSetting{}); !errComparer(err, errors.New(errNotSetting)) {
+		t.Errorf("e.Update(...) error = %v, want %s", err, errNotSetting)
+	}
+
+	mg := newSetting(v1alpha1.SettingParameters{Key: "sonar.exclusions", Values: []string{"**/generated/**", "**/vendor/**"}})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("e.Update(...) error = %v", err)
+	}
+	if diff := cmp.Diff(&instance.SettingsSetOption{Key: "sonar.exclusions", Values: []string{"**/generated/**", "**/vendor/**"}}, option); diff != "" {
+		t.Errorf("\ne.Update(...): -want option, +got option:\n%s\n", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockSettingsClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotSettingError": {
+			client: &fake.MockSettingsClient{},
+			mg:     &notSetting{},
+			want:   errors.New(errNotSetting),
+		},
+		"Reset": {
+			client: &fake.MockSettingsClient{
+				ResetFn: func(opt *sonargo.SettingsResetOption) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			mg: newSetting(v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions"}),
+		},
+		"MissingProject": {
+			client: &fake.MockSettingsClient{
+				ResetFn: func(opt *sonargo.SettingsResetOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newSetting(v1alpha1.SettingParameters{Component: ptr.To("my-project"), Key: "sonar.exclusions"}),
+		},
+		"ResetFailsReturnsError": {
+			client: &fake.MockSettingsClient{
+				ResetFn: func(opt *sonargo.SettingsResetOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg:   newSetting(v1alpha1.SettingParameters{Key: "sonar.exclusions"}),
+			want: errors.Wrap(errors.New("forbidden"), errResetSetting),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{settingsClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
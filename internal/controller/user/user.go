@@ -0,0 +1,394 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotUser      = "managed resource is not a User custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errCreateUser       = "cannot create SonarQube User"
+	errSearchUser       = "cannot get SonarQube User"
+	errUpdateUser       = "cannot update SonarQube User"
+	errUpdateUserLogin  = "cannot update the login of SonarQube User"
+	errChangePassword   = "cannot change the password of SonarQube User"
+	errValidatePassword = "cannot validate the password of SonarQube User"
+	errDeactivateUser   = "cannot deactivate SonarQube User"
+	errGetPassword      = "cannot get the password of User from its Secret"
+	errGeneratePassword = "cannot generate a password for User"
+)
+
+// SetupGated adds a controller that reconciles User managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup User controller"))
+		}
+	}, v1alpha1.UserGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.UserGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewUsersClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.UserList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.UserList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.UserGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.User{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.UsersClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return nil, errors.New(errNotUser)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{kube: c.kube, usersClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// kube is used to read the passwords of the Users from their Secret
+	kube client.Client
+	// usersClient is used to interact with SonarQube Users API
+	usersClient instance.UsersClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	// The external name is the login of the User
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Deactivated Users are not returned, so that they are reactivated by Create
+	user, err := c.searchUser(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The login may have been updated by a previous Update, whose new external name is only persisted from Observe
+	renamed := false
+	if user == nil && cr.Spec.ForProvider.Login != "" && cr.Spec.ForProvider.Login != externalName {
+		user, err = c.searchUser(cr.Spec.ForProvider.Login)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if user != nil {
+			externalName = user.Login
+			meta.SetExternalName(cr, externalName)
+			renamed = true
+		}
+	}
+	if user == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateUserObservation(user)
+
+	// Check the password of local Users against the one of their Secret, which is only published once SonarQube accepts it
+	var password *string
+	if instance.IsUserLocal(cr.Spec.ForProvider) && cr.Spec.ForProvider.PasswordSecretRef != nil {
+		desired, err := c.getPassword(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		valid, err := c.validatePassword(externalName, *desired)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.PasswordUpToDate = &valid
+		if valid {
+			password = desired
+		}
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeUser(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsUserUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider) || renamed,
+		ConnectionDetails:       instance.GenerateUserConnectionDetails(externalName, password),
+	}, nil
+}
+
+// searchUser retrieves the active User with the given login, or nil if there is none
+func (c *external) searchUser(login string) (*sonargo.UsersSearchObject_sub2, error) {
+	users, resp, err := c.usersClient.Search(instance.GenerateUserSearchOption(login)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errSearchUser)
+	}
+	return instance.FindUser(users, login), nil
+}
+
+// getPassword retrieves the desired password of the User from its Secret
+func (c *external) getPassword(ctx context.Context, cr *v1alpha1.User) (*string, error) {
+	password, err := common.GetTokenValueFromLocalSecret(ctx, c.kube, cr, cr.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPassword)
+	}
+	return password, nil
+}
+
+// validatePassword checks whether SonarQube accepts the password of the User
+func (c *external) validatePassword(login, password string) (bool, error) {
+	validation, resp, err := c.usersClient.ValidateCredentials(instance.GenerateUserValidateCredentialsOption(login, password)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// SonarQube may reject the request itself rather than report the credentials as invalid
+		if common.IsUnauthorized(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, errValidatePassword)
+	}
+	return validation != nil && validation.Valid, nil
+}
+
+// Create creates the external resource and sets the external name
+// A deactivated User with the same login is reactivated instead
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	spec := &cr.Spec.ForProvider
+
+	// Local Users require a password, taken from their Secret or generated
+	var password *string
+	if instance.IsUserLocal(*spec) {
+		var err error
+		if password, err = c.getOrGeneratePassword(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	user, resp, err := c.usersClient.Create(instance.GenerateUserCreateOption(*spec, ptr.Deref(password, ""))) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateUser)
+	}
+
+	// Set the external name to the login of the created User
+	meta.SetExternalName(cr, user.User.Login)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: instance.GenerateUserConnectionDetails(user.User.Login, password),
+	}, nil
+}
+
+// getOrGeneratePassword retrieves the password of the User from its Secret, or generates one if it has none
+func (c *external) getOrGeneratePassword(ctx context.Context, cr *v1alpha1.User) (*string, error) {
+	if cr.Spec.ForProvider.PasswordSecretRef != nil {
+		return c.getPassword(ctx, cr)
+	}
+	password, err := instance.GenerateUserPassword()
+	if err != nil {
+		return nil, errors.Wrap(err, errGeneratePassword)
+	}
+	return &password, nil
+}
+
+// Update updates the external resource to match the desired state of the managed resource
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUser)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for User %s", cr.Name)
+	}
+
+	spec := &cr.Spec.ForProvider
+	observation := &cr.Status.AtProvider
+
+	// Update the login first so that the following updates target the desired login
+	if spec.Login != externalName {
+		updateLoginResp, err := c.usersClient.UpdateLogin(instance.GenerateUserUpdateLoginOption(externalName, spec.Login)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(updateLoginResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(updateLoginResp, err), errUpdateUserLogin)
+		}
+		externalName = spec.Login
+		meta.SetExternalName(cr, externalName)
+	}
+
+	if !instance.IsUserDetailsUpToDate(spec, observation) {
+		_, updateResp, err := c.usersClient.Update(instance.GenerateUserUpdateOption(*spec)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(updateResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(updateResp, err), errUpdateUser)
+		}
+	}
+
+	// Set the password of the Secret, which SonarQube no longer accepts
+	if observation.PasswordUpToDate != nil && !*observation.PasswordUpToDate {
+		password, err := c.getPassword(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		changePasswordResp, err := c.usersClient.ChangePassword(instance.GenerateUserChangePasswordOption(externalName, *password)) //nolint:bodyclose // closed via helpers.CloseBody
+		defer helpers.CloseBody(changePasswordResp)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(changePasswordResp, err), errChangePassword)
+		}
+		return managed.ExternalUpdate{
+			ConnectionDetails: instance.GenerateUserConnectionDetails(externalName, password),
+		}, nil
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deactivates the User, as SonarQube does not allow deleting Users
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotUser)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	_, deactivateResp, err := c.usersClient.Deactivate(instance.GenerateUserDeactivateOption(externalName)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(deactivateResp)
+	if err != nil {
+		err = common.NewAPIError(deactivateResp, err)
+		// The user is already gone, nothing left to deactivate
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeactivateUser)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
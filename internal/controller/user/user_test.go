@@ -0,0 +1,608 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notUser struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newUser(externalName string, params v1alpha1.UserParameters) *v1alpha1.User {
+	u := &v1alpha1.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-user",
+			Namespace:   "default",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.UserSpec{
+			ForProvider: params,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(u, externalName)
+	}
+	return u
+}
+
+func newKubeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	return fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// roundTrip returns the User as stored by the API server, which drops the fields omitted from its JSON
+func roundTrip(cr *v1alpha1.User) *v1alpha1.User {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		panic(err)
+	}
+	stored := &v1alpha1.User{}
+	if err := json.Unmarshal(raw, stored); err != nil {
+		panic(err)
+	}
+	return stored
+}
+
+func searchReturning(users ...sonargo.UsersSearchObject_sub2) func(opt *sonargo.UsersSearchOption) (*sonargo.UsersSearchObject, *http.Response, error) {
+	return func(opt *sonargo.UsersSearchOption) (*sonargo.UsersSearchObject, *http.Response, error) {
+		return &sonargo.UsersSearchObject{Users: users}, nil, nil
+	}
+}
+
+func validateReturning(valid bool) func(opt *instance.AuthenticationValidateCredentialsOption) (*sonargo.AuthenticationValidateObject, *http.Response, error) {
+	return func(opt *instance.AuthenticationValidateCredentialsOption) (*sonargo.AuthenticationValidateObject, *http.Response, error) {
+		if !valid {
+			return nil, &http.Response{StatusCode: http.StatusUnauthorized}, errors.New("unauthorized")
+		}
+		return &sonargo.AuthenticationValidateObject{Valid: true}, nil, nil
+	}
+}
+
+var (
+	passwordSecret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jdoe-password", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t")},
+	}
+	passwordSecretRef = &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "jdoe-password"}, Key: "password"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		observation  v1alpha1.UserObservation
+		externalName string
+		err          error
+	}
+
+	jdoe := sonargo.UsersSearchObject_sub2{Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", Local: true, Active: true}
+	params := v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Email: ptr.To("jdoe@example.com"), Local: ptr.To(true)}
+	withPassword := *params.DeepCopy()
+	withoutScmAccounts := *params.DeepCopy()
+	withoutScmAccounts.ScmAccounts = []string{}
+	jdoeWithScmAccounts := jdoe
+	jdoeWithScmAccounts.ScmAccounts = []string{"jdoe-gh"}
+	withPassword.PasswordSecretRef = passwordSecretRef
+
+	cases := map[string]struct {
+		client *fake.MockUsersClient
+		kube   client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"NotUserError": {
+			client: &fake.MockUsersClient{},
+			mg:     &notUser{},
+			want: want{
+				err: errors.New(errNotUser),
+			},
+		},
+		"EmptyExternalNameReturnsNotExists": {
+			client: &fake.MockUsersClient{},
+			mg:     newUser("", params),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchFailsReturnsError": {
+			client: &fake.MockUsersClient{
+				SearchFn: func(opt *sonargo.UsersSearchOption) (*sonargo.UsersSearchObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newUser("jdoe", params),
+			want: want{
+				err:          errors.Wrap(errors.New("forbidden"), errSearchUser),
+				externalName: "jdoe",
+			},
+		},
+		"MissingUserReturnsNotExists": {
+			client: &fake.MockUsersClient{
+				SearchFn: searchReturning(sonargo.UsersSearchObject_sub2{Login: "jdoe-admin"}),
+			},
+			mg: newUser("jdoe", params),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: false},
+				externalName: "jdoe",
+			},
+		},
+		"UpToDateUserPublishesLogin": {
+			client: &fake.MockUsersClient{
+				SearchFn: searchReturning(jdoe),
+			},
+			mg: newUser("jdoe", params),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"login": []byte("jdoe")},
+				},
+				observation:  v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", Local: true, Active: true},
+				externalName: "jdoe",
+			},
+		},
+		"ClearedScmAccountsNeedUpdate": {
+			client: &fake.MockUsersClient{
+				SearchFn: searchReturning(jdoeWithScmAccounts),
+			},
+			mg: roundTrip(newUser("jdoe", withoutScmAccounts)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"login": []byte("jdoe")},
+				},
+				observation:  v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", Local: true, Active: true, ScmAccounts: []string{"jdoe-gh"}},
+				externalName: "jdoe",
+			},
+		},
+		"RenamedUserIsFoundByLogin": {
+			client: &fake.MockUsersClient{
+				SearchFn: func(opt *sonargo.UsersSearchOption) (*sonargo.UsersSearchObject, *http.Response, error) {
+					if opt.Q == "jdoe" {
+						return &sonargo.UsersSearchObject{Users: []sonargo.UsersSearchObject_sub2{jdoe}}, nil, nil
+					}
+					return &sonargo.UsersSearchObject{}, nil, nil
+				},
+			},
+			mg: newUser("john", params),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"login": []byte("jdoe")},
+				},
+				observation:  v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", Local: true, Active: true},
+				externalName: "jdoe",
+			},
+		},
+		"MissingPasswordSecretReturnsError": {
+			client: &fake.MockUsersClient{
+				SearchFn: searchReturning(jdoe),
+			},
+			kube: newKubeClient(),
+			mg:   newUser("jdoe", withPassword),
+			want: want{
+				err:          errors.Wrap(errors.Wrap(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "jdoe-password"), common.ErrSecretNotFound), errGetPassword),
+				externalName: "jdoe",
+			},
+		},
+		"ValidPasswordIsPublished": {
+			client: &fake.MockUsersClient{
+				SearchFn:              searchReturning(jdoe),
+				ValidateCredentialsFn: validateReturning(true),
+			},
+			kube: newKubeClient(passwordSecret),
+			mg:   newUser("jdoe", withPassword),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"login": []byte("jdoe"), "password": []byte("s3cr3t")},
+				},
+				observation:  v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", Local: true, Active: true, PasswordUpToDate: ptr.To(true)},
+				externalName: "jdoe",
+			},
+		},
+		"RejectedPasswordIsNotUpToDate": {
+			client: &fake.MockUsersClient{
+				SearchFn:              searchReturning(jdoe),
+				ValidateCredentialsFn: validateReturning(false),
+			},
+			kube: newKubeClient(passwordSecret),
+			mg:   newUser("jdoe", withPassword),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"login": []byte("jdoe")},
+				},
+				observation:  v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Email: "jdoe@example.com", Local: true, Active: true, PasswordUpToDate: ptr.To(false)},
+				externalName: "jdoe",
+			},
+		},
+		"NameDriftIsNotUpToDate": {
+			client: &fake.MockUsersClient{
+				SearchFn: searchReturning(sonargo.UsersSearchObject_sub2{Login: "jdoe", Name: "Johnny", Email: "jdoe@example.com", Local: true, Active: true}),
+			},
+			mg: newUser("jdoe", params),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"login": []byte("jdoe")},
+				},
+				observation:  v1alpha1.UserObservation{Login: "jdoe", Name: "Johnny", Email: "jdoe@example.com", Local: true, Active: true},
+				externalName: "jdoe",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, usersClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.User); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Observe() external name mismatch (-want +got):\n%s", diff)
+				}
+				if got.ResourceExists {
+					if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+						t.Errorf("Observe() status mismatch (-want +got):\n%s", diff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		password     string
+		generated    bool
+		err          error
+	}
+
+	cases := map[string]struct {
+		kube   client.Client
+		mg     resource.Managed
+		create error
+		want   want
+	}{
+		"NotUserError": {
+			mg: &notUser{},
+			want: want{
+				err: errors.New(errNotUser),
+			},
+		},
+		"CreateFails": {
+			mg:     newUser("", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Local: ptr.To(false)}),
+			create: errors.New("create error"),
+			want: want{
+				err: errors.Wrap(errors.New("create error"), errCreateUser),
+			},
+		},
+		"ExternalUserHasNoPassword": {
+			mg: newUser("", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", Local: ptr.To(false)}),
+			want: want{
+				externalName: "jdoe",
+			},
+		},
+		"LocalUserPasswordFromSecret": {
+			kube: newKubeClient(passwordSecret),
+			mg:   newUser("", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", PasswordSecretRef: passwordSecretRef}),
+			want: want{
+				externalName: "jdoe",
+				password:     "s3cr3t",
+			},
+		},
+		"LocalUserPasswordIsGenerated": {
+			mg: newUser("", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"}),
+			want: want{
+				externalName: "jdoe",
+				generated:    true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent string
+			e := &external{kube: tc.kube, usersClient: &fake.MockUsersClient{
+				CreateFn: func(opt *instance.UsersCreateOption) (*sonargo.UsersCreateObject, *http.Response, error) {
+					sent = opt.Password
+					if tc.create != nil {
+						return nil, nil, tc.create
+					}
+					return &sonargo.UsersCreateObject{User: sonargo.UsersCreateObject_sub1{Login: opt.Login, Name: opt.Name}}, nil, nil
+				},
+			}}
+			got, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			cr, ok := tc.mg.(*v1alpha1.User)
+			if !ok || err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("Create() external name mismatch (-want +got):\n%s", diff)
+			}
+			if tc.want.generated {
+				if sent == "" {
+					t.Errorf("Create() should generate a password for local Users without a password Secret")
+				}
+			} else if sent != tc.want.password {
+				t.Errorf("Create() password = %q, want %q", sent, tc.want.password)
+			}
+			if published := string(got.ConnectionDetails[v1alpha1.UserConnectionSecretPasswordKey]); published != sent {
+				t.Errorf("Create() published password = %q, want the password sent to SonarQube %q", published, sent)
+			}
+			if login := string(got.ConnectionDetails[v1alpha1.UserConnectionSecretLoginKey]); login != "jdoe" {
+				t.Errorf("Create() published login = %q, want %q", login, "jdoe")
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		externalName string
+		calls        []string
+		scmAccounts  []string
+		o            managed.ExternalUpdate
+		err          error
+	}
+
+	upToDate := v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Local: true}
+
+	cases := map[string]struct {
+		mg          resource.Managed
+		observation v1alpha1.UserObservation
+		updateLogin error
+		want        want
+	}{
+		"NotUserError": {
+			mg: &notUser{},
+			want: want{
+				err: errors.New(errNotUser),
+			},
+		},
+		"MissingExternalNameError": {
+			mg: newUser("", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"}),
+			want: want{
+				err: fmt.Errorf("external name is not set for User test-user"),
+			},
+		},
+		"DetailsAreUpdated": {
+			mg:          newUser("jdoe", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", ScmAccounts: []string{"jdoe"}}),
+			observation: upToDate,
+			want: want{
+				externalName: "jdoe",
+				calls:        []string{"Update:jdoe"},
+				scmAccounts:  []string{"jdoe"},
+			},
+		},
+		"ScmAccountsAreCleared": {
+			mg:          roundTrip(newUser("jdoe", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", ScmAccounts: []string{}})),
+			observation: v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Local: true, ScmAccounts: []string{"jdoe-gh"}},
+			want: want{
+				externalName: "jdoe",
+				calls:        []string{"Update:jdoe"},
+				scmAccounts:  []string{""},
+			},
+		},
+		"LoginIsUpdatedFirst": {
+			mg:          newUser("john", v1alpha1.UserParameters{Login: "jdoe", Name: "Johnny Doe"}),
+			observation: v1alpha1.UserObservation{Login: "john", Name: "John Doe", Local: true},
+			want: want{
+				externalName: "jdoe",
+				calls:        []string{"UpdateLogin:john->jdoe", "Update:jdoe"},
+			},
+		},
+		"UpdateLoginFails": {
+			mg:          newUser("john", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"}),
+			observation: v1alpha1.UserObservation{Login: "john", Name: "John Doe", Local: true},
+			updateLogin: errors.New("update login error"),
+			want: want{
+				externalName: "john",
+				calls:        []string{"UpdateLogin:john->jdoe"},
+				err:          errors.Wrap(errors.New("update login error"), errUpdateUserLogin),
+			},
+		},
+		"RejectedPasswordIsChanged": {
+			mg:          newUser("jdoe", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe", PasswordSecretRef: passwordSecretRef}),
+			observation: v1alpha1.UserObservation{Login: "jdoe", Name: "John Doe", Local: true, PasswordUpToDate: ptr.To(false)},
+			want: want{
+				externalName: "jdoe",
+				calls:        []string{"ChangePassword:jdoe"},
+				o: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"login": []byte("jdoe"), "password": []byte("s3cr3t")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls, scmAccounts []string
+			e := &external{kube: newKubeClient(passwordSecret), usersClient: &fake.MockUsersClient{
+				UpdateLoginFn: func(opt *sonargo.UsersUpdateLoginOption) (*http.Response, error) {
+					calls = append(calls, "UpdateLogin:"+opt.Login+"->"+opt.NewLogin)
+					return nil, tc.updateLogin
+				},
+				UpdateFn: func(opt *instance.UsersUpdateOption) (*sonargo.UsersUpdateObject, *http.Response, error) {
+					calls = append(calls, "Update:"+opt.Login)
+					scmAccounts = opt.ScmAccount
+					return &sonargo.UsersUpdateObject{}, nil, nil
+				},
+				ChangePasswordFn: func(opt *sonargo.UsersChangePasswordOption) (*http.Response, error) {
+					calls = append(calls, "ChangePassword:"+opt.Login)
+					if opt.Password != "s3cr3t" {
+						t.Errorf("ChangePassword() should set the password of the Secret, got %q", opt.Password)
+					}
+					return nil, nil
+				},
+			}}
+			if cr, ok := tc.mg.(*v1alpha1.User); ok {
+				cr.Status.AtProvider = tc.observation
+			}
+			got, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Update() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Update() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update() calls mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.scmAccounts, scmAccounts); diff != "" {
+				t.Errorf("Update() SCM accounts mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.User); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Update() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg         resource.Managed
+		deactivate *http.Response
+		err        error
+		want       want
+	}{
+		"NotUserError": {
+			mg: &notUser{},
+			want: want{
+				err: errors.New(errNotUser),
+			},
+		},
+		"NeverCreatedDoesNothing": {
+			mg: newUser("", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"}),
+		},
+		"SuccessfulDeactivate": {
+			mg: newUser("jdoe", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"}),
+			want: want{
+				calls: []string{"Deactivate:jdoe"},
+			},
+		},
+		"AlreadyDeactivatedIsIgnored": {
+			mg:         newUser("jdoe", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"}),
+			deactivate: &http.Response{StatusCode: http.StatusNotFound},
+			err:        errors.New("not found"),
+			want: want{
+				calls: []string{"Deactivate:jdoe"},
+			},
+		},
+		"DeactivateFails": {
+			mg:         newUser("jdoe", v1alpha1.UserParameters{Login: "jdoe", Name: "John Doe"}),
+			deactivate: &http.Response{StatusCode: http.StatusBadRequest},
+			err:        errors.New("bad request"),
+			want: want{
+				calls: []string{"Deactivate:jdoe"},
+				err:   errors.Wrap(errors.New("bad request"), errDeactivateUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{usersClient: &fake.MockUsersClient{
+				DeactivateFn: func(opt *sonargo.UsersDeactivateOption) (*sonargo.UsersDeactivateObject, *http.Response, error) {
+					calls = append(calls, "Deactivate:"+opt.Login)
+					return nil, tc.deactivate, tc.err
+				},
+			}}
+			_, err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Delete() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
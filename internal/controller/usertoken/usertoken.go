@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usertoken
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotUserToken = "managed resource is not a UserToken custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errGenerateUserToken = "cannot generate SonarQube User token"
+	errSearchUserToken   = "cannot get SonarQube User token"
+	errRevokeUserToken   = "cannot revoke SonarQube User token"
+)
+
+// SetupGated adds a controller that reconciles UserToken managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup UserToken controller"))
+		}
+	}, v1alpha1.UserTokenGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.UserTokenGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewUserTokensClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.UserTokenList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.UserTokenList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.UserTokenGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.UserToken{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.UserTokensClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.UserToken)
+	if !ok {
+		return nil, errors.New(errNotUserToken)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{userTokensClient: c.newServiceFn(*config), now: time.Now}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// userTokensClient is used to interact with SonarQube User Tokens API
+	userTokensClient instance.UserTokensClient
+	// now returns the current time, to decide whether the token is due for rotation
+	now func() time.Time
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UserToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUserToken)
+	}
+
+	// The external name is the name of the token
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	tokens, resp, err := c.userTokensClient.Search(instance.GenerateUserTokenSearchOption(cr.Spec.ForProvider.Login)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The User does not exist, so neither does its token
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errSearchUserToken)
+	}
+	token := instance.FindUserToken(tokens, externalName)
+	if token == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update status with observed state
+	cr.Status.AtProvider = instance.GenerateUserTokenObservation(tokens.Login, token)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: instance.IsUserTokenUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, c.now()),
+	}, nil
+}
+
+// Create generates the token, sets the external name and publishes the token
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UserToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUserToken)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	token, err := c.generate(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// Set the external name to the name of the generated token
+	meta.SetExternalName(cr, token.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: instance.GenerateUserTokenConnectionDetails(token),
+	}, nil
+}
+
+// generate generates a token from the spec of the UserToken
+func (c *external) generate(cr *v1alpha1.UserToken) (*sonargo.UserTokensGenerateObject, error) {
+	token, resp, err := c.userTokensClient.Generate(instance.GenerateUserTokenGenerateOption(cr.Spec.ForProvider, c.now())) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(common.NewAPIError(resp, err), errGenerateUserToken)
+	}
+	return token, nil
+}
+
+// revoke revokes the token of the UserToken, if it still exists
+func (c *external) revoke(cr *v1alpha1.UserToken) error {
+	resp, err := c.userTokensClient.Revoke(instance.GenerateUserTokenRevokeOption(cr.Spec.ForProvider.Login, meta.GetExternalName(cr))) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The token is already gone, nothing left to revoke
+		if common.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errRevokeUserToken)
+	}
+	return nil
+}
+
+// Update replaces the token by a new one, as SonarQube does not allow updating tokens
+// It happens when the token has expired, is due for rotation or its expiration date was changed
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.UserToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUserToken)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for User token %s", cr.Name)
+	}
+
+	// The name of a token is unique for its User, so the token must be revoked before generating its replacement
+	if err := c.revoke(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	token, err := c.generate(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: instance.GenerateUserTokenConnectionDetails(token),
+	}, nil
+}
+
+// Delete revokes the token
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.UserToken)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotUserToken)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	return managed.ExternalDelete{}, c.revoke(cr)
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
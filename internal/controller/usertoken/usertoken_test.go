@@ -0,0 +1,398 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usertoken
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notUserToken struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+// now is the fixed current time of the tests
+var now = time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+func fixedNow() time.Time {
+	return now
+}
+
+func newUserToken(externalName string, params v1alpha1.UserTokenParameters) *v1alpha1.UserToken {
+	ut := &v1alpha1.UserToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-usertoken",
+			Namespace:   "default",
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.UserTokenSpec{
+			ForProvider: params,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(ut, externalName)
+	}
+	return ut
+}
+
+func searchReturning(tokens ...sonargo.UserTokensSearchObject_sub2) func(opt *sonargo.UserTokensSearchOption) (*sonargo.UserTokensSearchObject, *http.Response, error) {
+	return func(opt *sonargo.UserTokensSearchOption) (*sonargo.UserTokensSearchObject, *http.Response, error) {
+		return &sonargo.UserTokensSearchObject{Login: "ci-bot", UserTokens: tokens}, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.UserTokenObservation
+		err         error
+	}
+
+	ci := sonargo.UserTokensSearchObject_sub2{Name: "ci", Type: "USER_TOKEN", CreatedAt: "2026-03-01T10:00:00+0000", ExpirationDate: "2026-04-10T00:00:00+0000"}
+	ciObservation := v1alpha1.UserTokenObservation{Login: "ci-bot", Name: "ci", Type: "USER_TOKEN", CreatedAt: "2026-03-01T10:00:00+0000", ExpirationDate: "2026-04-10T00:00:00+0000"}
+	rotated := v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci", ExpiresAfter: &metav1.Duration{Duration: 40 * 24 * time.Hour}, RotateBefore: &metav1.Duration{Duration: 35 * 24 * time.Hour}}
+
+	cases := map[string]struct {
+		client *fake.MockUserTokensClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotUserTokenError": {
+			client: &fake.MockUserTokensClient{},
+			mg:     &notUserToken{},
+			want: want{
+				err: errors.New(errNotUserToken),
+			},
+		},
+		"EmptyExternalNameReturnsNotExists": {
+			client: &fake.MockUserTokensClient{},
+			mg:     newUserToken("", v1alpha1.UserTokenParameters{Name: "ci"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MissingUserReturnsNotExists": {
+			client: &fake.MockUserTokensClient{
+				SearchFn: func(opt *sonargo.UserTokensSearchOption) (*sonargo.UserTokensSearchObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SearchFailsReturnsError": {
+			client: &fake.MockUserTokensClient{
+				SearchFn: func(opt *sonargo.UserTokensSearchOption) (*sonargo.UserTokensSearchObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci"}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errSearchUserToken),
+			},
+		},
+		"MissingTokenReturnsNotExists": {
+			client: &fake.MockUserTokensClient{
+				SearchFn: searchReturning(sonargo.UserTokensSearchObject_sub2{Name: "other"}),
+			},
+			mg: newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ExistingTokenIsUpToDate": {
+			client: &fake.MockUserTokensClient{
+				SearchFn: searchReturning(ci),
+			},
+			mg: newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci", ExpirationDate: ptr.To("2026-04-10")}),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: ciObservation,
+			},
+		},
+		"TokenDueForRotationIsNotUpToDate": {
+			client: &fake.MockUserTokensClient{
+				SearchFn: searchReturning(ci),
+			},
+			mg: newUserToken("ci", *rotated.DeepCopy()),
+			want: want{
+				o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: ciObservation,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{userTokensClient: tc.client, now: fixedNow}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Observe() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe() mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.UserToken); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("Observe() status mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		o            managed.ExternalCreation
+		err          error
+	}
+
+	cases := map[string]struct {
+		mg       resource.Managed
+		generate error
+		want     want
+	}{
+		"NotUserTokenError": {
+			mg: &notUserToken{},
+			want: want{
+				err: errors.New(errNotUserToken),
+			},
+		},
+		"GenerateFails": {
+			mg:       newUserToken("", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci"}),
+			generate: errors.New("generate error"),
+			want: want{
+				err: errors.Wrap(errors.New("generate error"), errGenerateUserToken),
+			},
+		},
+		"SuccessfulGeneratePublishesToken": {
+			mg: newUserToken("", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci", ExpiresAfter: &metav1.Duration{Duration: 24 * time.Hour}}),
+			want: want{
+				externalName: "ci",
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte("squ_2026-03-12"), "login": []byte("ci-bot")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{now: fixedNow, userTokensClient: &fake.MockUserTokensClient{
+				GenerateFn: func(opt *sonargo.UserTokensGenerateOption) (*sonargo.UserTokensGenerateObject, *http.Response, error) {
+					if tc.generate != nil {
+						return nil, nil, tc.generate
+					}
+					return &sonargo.UserTokensGenerateObject{Login: opt.Login, Name: opt.Name, Token: "squ_" + opt.ExpirationDate}, nil, nil
+				},
+			}}
+			got, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Create() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.UserToken); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("Create() external name mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		o     managed.ExternalUpdate
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		revoke *http.Response
+		err    error
+		want   want
+	}{
+		"NotUserTokenError": {
+			mg: &notUserToken{},
+			want: want{
+				err: errors.New(errNotUserToken),
+			},
+		},
+		"MissingExternalNameError": {
+			mg: newUserToken("", v1alpha1.UserTokenParameters{Name: "ci"}),
+			want: want{
+				err: fmt.Errorf("external name is not set for User token test-usertoken"),
+			},
+		},
+		"TokenIsReplaced": {
+			mg: newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci", ExpirationDate: ptr.To("2026-12-31")}),
+			want: want{
+				calls: []string{"Revoke:ci-bot/ci", "Generate:ci-bot/ci"},
+				o: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte("squ_2026-12-31"), "login": []byte("ci-bot")},
+				},
+			},
+		},
+		"AlreadyRevokedTokenIsGenerated": {
+			mg:     newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci", ExpirationDate: ptr.To("2026-12-31")}),
+			revoke: &http.Response{StatusCode: http.StatusNotFound},
+			err:    errors.New("not found"),
+			want: want{
+				calls: []string{"Revoke:ci-bot/ci", "Generate:ci-bot/ci"},
+				o: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte("squ_2026-12-31"), "login": []byte("ci-bot")},
+				},
+			},
+		},
+		"RevokeFails": {
+			mg:     newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci"}),
+			revoke: &http.Response{StatusCode: http.StatusForbidden},
+			err:    errors.New("forbidden"),
+			want: want{
+				calls: []string{"Revoke:ci-bot/ci"},
+				err:   errors.Wrap(errors.New("forbidden"), errRevokeUserToken),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{now: fixedNow, userTokensClient: &fake.MockUserTokensClient{
+				RevokeFn: func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error) {
+					calls = append(calls, "Revoke:"+opt.Login+"/"+opt.Name)
+					return tc.revoke, tc.err
+				},
+				GenerateFn: func(opt *sonargo.UserTokensGenerateOption) (*sonargo.UserTokensGenerateObject, *http.Response, error) {
+					calls = append(calls, "Generate:"+opt.Login+"/"+opt.Name)
+					return &sonargo.UserTokensGenerateObject{Login: opt.Login, Name: opt.Name, Token: "squ_" + opt.ExpirationDate}, nil, nil
+				},
+			}}
+			got, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Update() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Update() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg     resource.Managed
+		revoke *http.Response
+		err    error
+		want   want
+	}{
+		"NotUserTokenError": {
+			mg: &notUserToken{},
+			want: want{
+				err: errors.New(errNotUserToken),
+			},
+		},
+		"NeverGeneratedDoesNothing": {
+			mg: newUserToken("", v1alpha1.UserTokenParameters{Name: "ci"}),
+		},
+		"SuccessfulRevoke": {
+			mg: newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci"}),
+			want: want{
+				calls: []string{"Revoke:ci-bot/ci"},
+			},
+		},
+		"AlreadyRevokedIsIgnored": {
+			mg:     newUserToken("ci", v1alpha1.UserTokenParameters{Login: ptr.To("ci-bot"), Name: "ci"}),
+			revoke: &http.Response{StatusCode: http.StatusNotFound},
+			err:    errors.New("not found"),
+			want: want{
+				calls: []string{"Revoke:ci-bot/ci"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{now: fixedNow, userTokensClient: &fake.MockUserTokensClient{
+				RevokeFn: func(opt *sonargo.UserTokensRevokeOption) (*http.Response, error) {
+					calls = append(calls, "Revoke:"+opt.Login+"/"+opt.Name)
+					return tc.revoke, tc.err
+				},
+			}}
+			_, err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Delete() calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
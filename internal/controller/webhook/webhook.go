@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-sonarqube/apis/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/helpers"
+)
+
+const (
+	errNotWebhook   = "managed resource is not a Webhook custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errListWebhooks     = "cannot list SonarQube Webhooks"
+	errListDeliveries   = "cannot list SonarQube Webhook deliveries"
+	errCreateWebhook    = "cannot create SonarQube Webhook"
+	errUpdateWebhook    = "cannot update SonarQube Webhook"
+	errDeleteWebhook    = "cannot delete SonarQube Webhook"
+	errGetWebhookSecret = "cannot get Webhook secret"
+)
+
+// SetupGated adds a controller that reconciles Webhook managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup Webhook controller"))
+		}
+	}, v1alpha1.WebhookGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.WebhookGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: instance.NewWebhooksClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.WebhookList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.WebhookList")
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.WebhookGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Webhook{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        *resource.ProviderConfigUsageTracker
+	newServiceFn func(config common.Config) instance.WebhooksClient
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Webhook)
+	if !ok {
+		return nil, errors.New(errNotWebhook)
+	}
+
+	if err := c.usage.Track(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	// Switch to ModernManaged resource to get ProviderConfigRef
+	m := mg.(resource.ModernManaged)
+
+	config, err := common.GetConfig(ctx, c.kube, m)
+	if err != nil || config == nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return &external{kube: c.kube, webhooksClient: c.newServiceFn(*config)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// kube is used to read the secrets of the Webhooks from their Secret
+	kube client.Client
+	// webhooksClient is used to interact with SonarQube Webhooks API
+	webhooksClient instance.WebhooksClient
+}
+
+// Observe checks if the external resource exists and if it matches the
+// desired state of the managed resource.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Webhook)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWebhook)
+	}
+
+	// The external name is the key of the Webhook, generated by SonarQube
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Webhooks cannot be shown individually, find this one among the global ones or the ones of its Project
+	list, resp, err := c.webhooksClient.List(instance.GenerateWebhookListOption(cr.Spec.ForProvider)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Project owning the Webhook is gone, and so is the Webhook
+		if common.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errListWebhooks)
+	}
+	webhook := instance.FindWebhook(list, externalName)
+	if webhook == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	deliveries, deliveriesResp, err := c.webhooksClient.Deliveries(instance.GenerateWebhookDeliveriesOption(externalName)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(deliveriesResp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(common.NewAPIError(deliveriesResp, err), errListDeliveries)
+	}
+
+	secret, secretVersion, err := c.getSecret(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Update status with observed state, keeping the version of the Secret last set by the provider
+	lastSecretVersion := cr.Status.AtProvider.SecretVersion
+	cr.Status.AtProvider = instance.GenerateWebhookObservation(webhook, ptr.Deref(cr.Spec.ForProvider.ProjectKey, ""))
+	cr.Status.AtProvider.LastDelivery = instance.GenerateWebhookLastDeliveryObservation(deliveries)
+	if cr.Status.AtProvider.HasSecret {
+		cr.Status.AtProvider.SecretVersion = lastSecretVersion
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: instance.IsWebhookUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, secret, secretVersion),
+	}, nil
+}
+
+// getSecret retrieves the desired secret of the Webhook from its Secret, or nil if it has none
+// It also returns the version of its Secret, made of its UID and resource version
+func (c *external) getSecret(ctx context.Context, cr *v1alpha1.Webhook) (*string, string, error) {
+	if cr.Spec.ForProvider.SecretRef == nil {
+		return nil, "", nil
+	}
+	secret, version, err := common.GetTokenValueAndVersionFromLocalSecret(ctx, c.kube, cr, cr.Spec.ForProvider.SecretRef)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errGetWebhookSecret)
+	}
+	return secret, version, nil
+}
+
+// Create creates the external resource and sets the external name
+// The version of the Secret is only recorded by the first Update, since the status set on creation is not persisted
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Webhook)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWebhook)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	secret, _, err := c.getSecret(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	webhook, resp, err := c.webhooksClient.Create(instance.GenerateWebhookCreateOption(cr.Spec.ForProvider, secret)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(common.NewAPIError(resp, err), errCreateWebhook)
+	}
+
+	// Set the external name to the key of the created Webhook
+	meta.SetExternalName(cr, webhook.Webhook.Key)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update updates the name, the URL and the secret of the Webhook, and records the version of its Secret
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Webhook)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWebhook)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalUpdate{}, fmt.Errorf("external name is not set for Webhook %s", cr.Name)
+	}
+
+	secret, secretVersion, err := c.getSecret(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	resp, err := c.webhooksClient.Update(instance.GenerateWebhookUpdateOption(externalName, cr.Spec.ForProvider, secret)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(common.NewAPIError(resp, err), errUpdateWebhook)
+	}
+
+	cr.Status.AtProvider.SecretVersion = secretVersion
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the external resource
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Webhook)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotWebhook)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	resp, err := c.webhooksClient.Delete(instance.GenerateWebhookDeleteOption(externalName)) //nolint:bodyclose // closed via helpers.CloseBody
+	defer helpers.CloseBody(resp)
+	if err != nil {
+		err = common.NewAPIError(resp, err)
+		// The Webhook is already gone, nothing left to delete
+		if common.IsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteWebhook)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
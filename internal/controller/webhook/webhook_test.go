@@ -0,0 +1,426 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha1 "github.com/crossplane/provider-sonarqube/apis/instance/v1alpha1"
+	"github.com/crossplane/provider-sonarqube/internal/clients/common"
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+	"github.com/crossplane/provider-sonarqube/internal/fake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type notWebhook struct {
+	resource.Managed
+}
+
+// errComparer compares errors by their message
+func errComparer(a, b error) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Error() == b.Error()
+}
+
+func newWebhook(externalName string, params v1alpha1.WebhookParameters, observation v1alpha1.WebhookObservation) *v1alpha1.Webhook {
+	w := &v1alpha1.Webhook{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-webhook",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.WebhookSpec{
+			ForProvider: params,
+		},
+		Status: v1alpha1.WebhookStatus{
+			AtProvider: observation,
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(w, externalName)
+	}
+	return w
+}
+
+func newKubeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	return fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func listReturning(webhooks ...sonargo.WebhooksListObject_sub1) func(opt *sonargo.WebhooksListOption) (*sonargo.WebhooksListObject, *http.Response, error) {
+	return func(opt *sonargo.WebhooksListOption) (*sonargo.WebhooksListObject, *http.Response, error) {
+		return &sonargo.WebhooksListObject{Webhooks: webhooks}, nil, nil
+	}
+}
+
+func deliveriesReturning(deliveries ...sonargo.WebhooksDeliveriesObject_sub1) func(opt *sonargo.WebhooksDeliveriesOption) (*sonargo.WebhooksDeliveriesObject, *http.Response, error) {
+	return func(opt *sonargo.WebhooksDeliveriesOption) (*sonargo.WebhooksDeliveriesObject, *http.Response, error) {
+		return &sonargo.WebhooksDeliveriesObject{Deliveries: deliveries}, nil, nil
+	}
+}
+
+var (
+	hookSecret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gate-webhook", Namespace: "default", UID: "gate-webhook-uid", ResourceVersion: "7"},
+		Data:       map[string][]byte{"secret": []byte("s3cr3t")},
+	}
+	hookSecretRef     = &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "gate-webhook"}, Key: "secret"}
+	hookSecretVersion = "gate-webhook-uid/7"
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o           managed.ExternalObservation
+		observation v1alpha1.WebhookObservation
+		err         error
+	}
+
+	params := v1alpha1.WebhookParameters{Name: "gate", URL: "https://gate.example.com/sonar", ProjectKey: ptr.To("my-project"), SecretRef: hookSecretRef}
+	webhook := sonargo.WebhooksListObject_sub1{Key: "AU-Tpxb", Name: "gate", URL: "https://gate.example.com/sonar", HasSecret: true}
+
+	cases := map[string]struct {
+		client *fake.MockWebhooksClient
+		kube   client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"NotWebhookError": {
+			client: &fake.MockWebhooksClient{},
+			mg:     &notWebhook{},
+			want: want{
+				err: errors.New(errNotWebhook),
+			},
+		},
+		"NoExternalNameReturnsNotExists": {
+			client: &fake.MockWebhooksClient{},
+			mg:     newWebhook("", params, v1alpha1.WebhookObservation{}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MissingProjectReturnsNotExists": {
+			client: &fake.MockWebhooksClient{
+				ListFn: func(opt *sonargo.WebhooksListOption) (*sonargo.WebhooksListObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newWebhook("AU-Tpxb", params, v1alpha1.WebhookObservation{}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListFailsReturnsError": {
+			client: &fake.MockWebhooksClient{
+				ListFn: func(opt *sonargo.WebhooksListOption) (*sonargo.WebhooksListObject, *http.Response, error) {
+					return nil, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg: newWebhook("AU-Tpxb", params, v1alpha1.WebhookObservation{}),
+			want: want{
+				err: errors.Wrap(errors.New("forbidden"), errListWebhooks),
+			},
+		},
+		"UnknownKeyReturnsNotExists": {
+			client: &fake.MockWebhooksClient{
+				ListFn: listReturning(webhook),
+			},
+			mg: newWebhook("other", params, v1alpha1.WebhookObservation{}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDateKeepsSecretVersion": {
+			client: &fake.MockWebhooksClient{
+				ListFn:       listReturning(webhook),
+				DeliveriesFn: deliveriesReturning(sonargo.WebhooksDeliveriesObject_sub1{ID: "d1", Success: true, HTTPStatus: 200}),
+			},
+			kube: newKubeClient(hookSecret),
+			mg:   newWebhook("AU-Tpxb", params, v1alpha1.WebhookObservation{SecretVersion: hookSecretVersion}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observation: v1alpha1.WebhookObservation{
+					Key:           "AU-Tpxb",
+					Name:          "gate",
+					URL:           "https://gate.example.com/sonar",
+					ProjectKey:    "my-project",
+					HasSecret:     true,
+					SecretVersion: hookSecretVersion,
+					LastDelivery:  &v1alpha1.WebhookDeliveryObservation{ID: "d1", Success: true, HTTPStatus: 200},
+				},
+			},
+		},
+		"SecretChangedIsOutdated": {
+			client: &fake.MockWebhooksClient{
+				ListFn:       listReturning(webhook),
+				DeliveriesFn: deliveriesReturning(),
+			},
+			kube: newKubeClient(hookSecret),
+			mg:   newWebhook("AU-Tpxb", params, v1alpha1.WebhookObservation{SecretVersion: "gate-webhook-uid/6"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				observation: v1alpha1.WebhookObservation{
+					Key:           "AU-Tpxb",
+					Name:          "gate",
+					URL:           "https://gate.example.com/sonar",
+					ProjectKey:    "my-project",
+					HasSecret:     true,
+					SecretVersion: "gate-webhook-uid/6",
+				},
+			},
+		},
+		"MissingSecretReturnsError": {
+			client: &fake.MockWebhooksClient{
+				ListFn:       listReturning(webhook),
+				DeliveriesFn: deliveriesReturning(),
+			},
+			kube: newKubeClient(),
+			mg:   newWebhook("AU-Tpxb", params, v1alpha1.WebhookObservation{}),
+			want: want{
+				err: errors.Wrap(errors.Wrap(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "gate-webhook"), common.ErrSecretNotFound), errGetWebhookSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{kube: tc.kube, webhooksClient: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Webhook); ok && tc.want.err == nil && tc.want.o.ResourceExists {
+				if diff := cmp.Diff(tc.want.observation, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\ne.Observe(...): -want observation, +got observation:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		option       *sonargo.WebhooksCreateOption
+		err          error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		fail bool
+		want want
+	}{
+		"NotWebhookError": {
+			mg: &notWebhook{},
+			want: want{
+				err: errors.New(errNotWebhook),
+			},
+		},
+		"CreateWithSecret": {
+			kube: newKubeClient(hookSecret),
+			mg:   newWebhook("test-webhook", v1alpha1.WebhookParameters{Name: "gate", URL: "https://gate.example.com/sonar", ProjectKey: ptr.To("my-project"), SecretRef: hookSecretRef}, v1alpha1.WebhookObservation{}),
+			want: want{
+				externalName: "AU-Tpxb",
+				option:       &sonargo.WebhooksCreateOption{Name: "gate", Project: "my-project", Secret: "s3cr3t", Url: "https://gate.example.com/sonar"},
+			},
+		},
+		"CreateFailsReturnsError": {
+			mg:   newWebhook("test-webhook", v1alpha1.WebhookParameters{Name: "gate", URL: "ftp://gate"}, v1alpha1.WebhookObservation{}),
+			fail: true,
+			want: want{
+				externalName: "test-webhook",
+				option:       &sonargo.WebhooksCreateOption{Name: "gate", Url: "ftp://gate"},
+				err:          errors.Wrap(errors.New("invalid url"), errCreateWebhook),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var option *sonargo.WebhooksCreateOption
+			client := &fake.MockWebhooksClient{
+				CreateFn: func(opt *sonargo.WebhooksCreateOption) (*sonargo.WebhooksCreateObject, *http.Response, error) {
+					option = opt
+					if tc.fail {
+						return nil, &http.Response{StatusCode: http.StatusBadRequest}, errors.New("invalid url")
+					}
+					return &sonargo.WebhooksCreateObject{Webhook: sonargo.WebhooksCreateObject_sub1{Key: "AU-Tpxb", Name: opt.Name, URL: opt.Url}}, nil, nil
+				},
+			}
+			e := external{kube: tc.kube, webhooksClient: client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.option, option); diff != "" {
+				t.Errorf("\ne.Create(...): -want option, +got option:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Webhook); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\ne.Create(...): -want external name, +got external name:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		option        *instance.WebhooksUpdateOption
+		secretVersion string
+		err           error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		fail bool
+		want want
+	}{
+		"NotWebhookError": {
+			mg: &notWebhook{},
+			want: want{
+				err: errors.New(errNotWebhook),
+			},
+		},
+		"UpdateRecordsSecretVersion": {
+			kube: newKubeClient(hookSecret),
+			mg:   newWebhook("AU-Tpxb", v1alpha1.WebhookParameters{Name: "gate", URL: "https://gate.example.com/v2", SecretRef: hookSecretRef}, v1alpha1.WebhookObservation{HasSecret: true}),
+			want: want{
+				option:        &instance.WebhooksUpdateOption{Name: "gate", Secret: ptr.To("s3cr3t"), Url: "https://gate.example.com/v2", Webhook: "AU-Tpxb"},
+				secretVersion: hookSecretVersion,
+			},
+		},
+		"UpdateRemovesSecret": {
+			mg: newWebhook("AU-Tpxb", v1alpha1.WebhookParameters{Name: "gate", URL: "https://gate.example.com/sonar"}, v1alpha1.WebhookObservation{HasSecret: true, SecretVersion: hookSecretVersion}),
+			want: want{
+				option: &instance.WebhooksUpdateOption{Name: "gate", Secret: ptr.To(""), Url: "https://gate.example.com/sonar", Webhook: "AU-Tpxb"},
+			},
+		},
+		"UpdateFailsReturnsError": {
+			mg:   newWebhook("AU-Tpxb", v1alpha1.WebhookParameters{Name: "gate", URL: "https://gate.example.com/sonar"}, v1alpha1.WebhookObservation{SecretVersion: hookSecretVersion}),
+			fail: true,
+			want: want{
+				option:        &instance.WebhooksUpdateOption{Name: "gate", Secret: ptr.To(""), Url: "https://gate.example.com/sonar", Webhook: "AU-Tpxb"},
+				secretVersion: hookSecretVersion,
+				err:           errors.Wrap(errors.New("forbidden"), errUpdateWebhook),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var option *instance.WebhooksUpdateOption
+			client := &fake.MockWebhooksClient{
+				UpdateFn: func(opt *instance.WebhooksUpdateOption) (*http.Response, error) {
+					option = opt
+					if tc.fail {
+						return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+					}
+					return nil, nil
+				},
+			}
+			e := external{kube: tc.kube, webhooksClient: client}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.option, option); diff != "" {
+				t.Errorf("\ne.Update(...): -want option, +got option:\n%s\n", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Webhook); ok {
+				if diff := cmp.Diff(tc.want.secretVersion, cr.Status.AtProvider.SecretVersion); diff != "" {
+					t.Errorf("\ne.Update(...): -want secret version, +got secret version:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockWebhooksClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotWebhookError": {
+			client: &fake.MockWebhooksClient{},
+			mg:     &notWebhook{},
+			want:   errors.New(errNotWebhook),
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockWebhooksClient{
+				DeleteFn: func(opt *sonargo.WebhooksDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+				},
+			},
+			mg: newWebhook("AU-Tpxb", v1alpha1.WebhookParameters{Name: "gate"}, v1alpha1.WebhookObservation{}),
+		},
+		"DeleteFailsReturnsError": {
+			client: &fake.MockWebhooksClient{
+				DeleteFn: func(opt *sonargo.WebhooksDeleteOption) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+				},
+			},
+			mg:   newWebhook("AU-Tpxb", v1alpha1.WebhookParameters{Name: "gate"}, v1alpha1.WebhookObservation{}),
+			want: errors.Wrap(errors.New("forbidden"), errDeleteWebhook),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{webhooksClient: tc.client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmp.Comparer(errComparer)); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockAlmSettingsClient is a mock implementation of the AlmSettingsClient interface.
+type MockAlmSettingsClient struct {
+	CreateAzureFn              func(opt *sonargo.AlmSettingsCreateAzureOption) (resp *http.Response, err error)
+	CreateBitbucketFn          func(opt *sonargo.AlmSettingsCreateBitbucketOption) (resp *http.Response, err error)
+	CreateBitbucketcloudFn     func(opt *sonargo.AlmSettingsCreateBitbucketcloudOption) (resp *http.Response, err error)
+	CreateGithubFn             func(opt *sonargo.AlmSettingsCreateGithubOption) (resp *http.Response, err error)
+	CreateGitlabFn             func(opt *sonargo.AlmSettingsCreateGitlabOption) (resp *http.Response, err error)
+	DeleteFn                   func(opt *sonargo.AlmSettingsDeleteOption) (resp *http.Response, err error)
+	DeleteBindingFn            func(opt *instance.AlmSettingsDeleteBindingOption) (resp *http.Response, err error)
+	GetBindingFn               func(opt *sonargo.AlmSettingsGetBindingOption) (v *instance.AlmSettingsGetBindingObject, resp *http.Response, err error)
+	ListFn                     func(opt *sonargo.AlmSettingsListOption) (v *sonargo.AlmSettingsListObject, resp *http.Response, err error)
+	ListDefinitionsFn          func() (v *instance.AlmSettingsListDefinitionsObject, resp *http.Response, err error)
+	SetAzureBindingFn          func(opt *instance.AlmSettingsSetAzureBindingOption) (resp *http.Response, err error)
+	SetBitbucketBindingFn      func(opt *instance.AlmSettingsSetBitbucketBindingOption) (resp *http.Response, err error)
+	SetBitbucketcloudBindingFn func(opt *instance.AlmSettingsSetBitbucketcloudBindingOption) (resp *http.Response, err error)
+	SetGithubBindingFn         func(opt *instance.AlmSettingsSetGithubBindingOption) (resp *http.Response, err error)
+	SetGitlabBindingFn         func(opt *instance.AlmSettingsSetGitlabBindingOption) (resp *http.Response, err error)
+	UpdateAzureFn              func(opt *sonargo.AlmSettingsUpdateAzureOption) (resp *http.Response, err error)
+	UpdateBitbucketFn          func(opt *sonargo.AlmSettingsUpdateBitbucketOption) (resp *http.Response, err error)
+	UpdateBitbucketcloudFn     func(opt *sonargo.AlmSettingsUpdateBitbucketcloudOption) (resp *http.Response, err error)
+	UpdateGithubFn             func(opt *sonargo.AlmSettingsUpdateGithubOption) (resp *http.Response, err error)
+	UpdateGitlabFn             func(opt *sonargo.AlmSettingsUpdateGitlabOption) (resp *http.Response, err error)
+}
+
+// Ensure MockAlmSettingsClient implements AlmSettingsClient
+var _ instance.AlmSettingsClient = &MockAlmSettingsClient{}
+
+// CreateAzure implements AlmSettingsClient.CreateAzure
+func (m *MockAlmSettingsClient) CreateAzure(opt *sonargo.AlmSettingsCreateAzureOption) (resp *http.Response, err error) {
+	if m.CreateAzureFn != nil {
+		return m.CreateAzureFn(opt)
+	}
+	return nil, nil
+}
+
+// CreateBitbucket implements AlmSettingsClient.CreateBitbucket
+func (m *MockAlmSettingsClient) CreateBitbucket(opt *sonargo.AlmSettingsCreateBitbucketOption) (resp *http.Response, err error) {
+	if m.CreateBitbucketFn != nil {
+		return m.CreateBitbucketFn(opt)
+	}
+	return nil, nil
+}
+
+// CreateBitbucketcloud implements AlmSettingsClient.CreateBitbucketcloud
+func (m *MockAlmSettingsClient) CreateBitbucketcloud(opt *sonargo.AlmSettingsCreateBitbucketcloudOption) (resp *http.Response, err error) {
+	if m.CreateBitbucketcloudFn != nil {
+		return m.CreateBitbucketcloudFn(opt)
+	}
+	return nil, nil
+}
+
+// CreateGithub implements AlmSettingsClient.CreateGithub
+func (m *MockAlmSettingsClient) CreateGithub(opt *sonargo.AlmSettingsCreateGithubOption) (resp *http.Response, err error) {
+	if m.CreateGithubFn != nil {
+		return m.CreateGithubFn(opt)
+	}
+	return nil, nil
+}
+
+// CreateGitlab implements AlmSettingsClient.CreateGitlab
+func (m *MockAlmSettingsClient) CreateGitlab(opt *sonargo.AlmSettingsCreateGitlabOption) (resp *http.Response, err error) {
+	if m.CreateGitlabFn != nil {
+		return m.CreateGitlabFn(opt)
+	}
+	return nil, nil
+}
+
+// Delete implements AlmSettingsClient.Delete
+func (m *MockAlmSettingsClient) Delete(opt *sonargo.AlmSettingsDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// DeleteBinding implements AlmSettingsClient.DeleteBinding
+func (m *MockAlmSettingsClient) DeleteBinding(opt *instance.AlmSettingsDeleteBindingOption) (resp *http.Response, err error) {
+	if m.DeleteBindingFn != nil {
+		return m.DeleteBindingFn(opt)
+	}
+	return nil, nil
+}
+
+// GetBinding implements AlmSettingsClient.GetBinding
+func (m *MockAlmSettingsClient) GetBinding(opt *sonargo.AlmSettingsGetBindingOption) (v *instance.AlmSettingsGetBindingObject, resp *http.Response, err error) {
+	if m.GetBindingFn != nil {
+		return m.GetBindingFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// List implements AlmSettingsClient.List
+func (m *MockAlmSettingsClient) List(opt *sonargo.AlmSettingsListOption) (v *sonargo.AlmSettingsListObject, resp *http.Response, err error) {
+	if m.ListFn != nil {
+		return m.ListFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// ListDefinitions implements AlmSettingsClient.ListDefinitions
+func (m *MockAlmSettingsClient) ListDefinitions() (v *instance.AlmSettingsListDefinitionsObject, resp *http.Response, err error) {
+	if m.ListDefinitionsFn != nil {
+		return m.ListDefinitionsFn()
+	}
+	return nil, nil, nil
+}
+
+// SetAzureBinding implements AlmSettingsClient.SetAzureBinding
+func (m *MockAlmSettingsClient) SetAzureBinding(opt *instance.AlmSettingsSetAzureBindingOption) (resp *http.Response, err error) {
+	if m.SetAzureBindingFn != nil {
+		return m.SetAzureBindingFn(opt)
+	}
+	return nil, nil
+}
+
+// SetBitbucketBinding implements AlmSettingsClient.SetBitbucketBinding
+func (m *MockAlmSettingsClient) SetBitbucketBinding(opt *instance.AlmSettingsSetBitbucketBindingOption) (resp *http.Response, err error) {
+	if m.SetBitbucketBindingFn != nil {
+		return m.SetBitbucketBindingFn(opt)
+	}
+	return nil, nil
+}
+
+// SetBitbucketcloudBinding implements AlmSettingsClient.SetBitbucketcloudBinding
+func (m *MockAlmSettingsClient) SetBitbucketcloudBinding(opt *instance.AlmSettingsSetBitbucketcloudBindingOption) (resp *http.Response, err error) {
+	if m.SetBitbucketcloudBindingFn != nil {
+		return m.SetBitbucketcloudBindingFn(opt)
+	}
+	return nil, nil
+}
+
+// SetGithubBinding implements AlmSettingsClient.SetGithubBinding
+func (m *MockAlmSettingsClient) SetGithubBinding(opt *instance.AlmSettingsSetGithubBindingOption) (resp *http.Response, err error) {
+	if m.SetGithubBindingFn != nil {
+		return m.SetGithubBindingFn(opt)
+	}
+	return nil, nil
+}
+
+// SetGitlabBinding implements AlmSettingsClient.SetGitlabBinding
+func (m *MockAlmSettingsClient) SetGitlabBinding(opt *instance.AlmSettingsSetGitlabBindingOption) (resp *http.Response, err error) {
+	if m.SetGitlabBindingFn != nil {
+		return m.SetGitlabBindingFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateAzure implements AlmSettingsClient.UpdateAzure
+func (m *MockAlmSettingsClient) UpdateAzure(opt *sonargo.AlmSettingsUpdateAzureOption) (resp *http.Response, err error) {
+	if m.UpdateAzureFn != nil {
+		return m.UpdateAzureFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateBitbucket implements AlmSettingsClient.UpdateBitbucket
+func (m *MockAlmSettingsClient) UpdateBitbucket(opt *sonargo.AlmSettingsUpdateBitbucketOption) (resp *http.Response, err error) {
+	if m.UpdateBitbucketFn != nil {
+		return m.UpdateBitbucketFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateBitbucketcloud implements AlmSettingsClient.UpdateBitbucketcloud
+func (m *MockAlmSettingsClient) UpdateBitbucketcloud(opt *sonargo.AlmSettingsUpdateBitbucketcloudOption) (resp *http.Response, err error) {
+	if m.UpdateBitbucketcloudFn != nil {
+		return m.UpdateBitbucketcloudFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateGithub implements AlmSettingsClient.UpdateGithub
+func (m *MockAlmSettingsClient) UpdateGithub(opt *sonargo.AlmSettingsUpdateGithubOption) (resp *http.Response, err error) {
+	if m.UpdateGithubFn != nil {
+		return m.UpdateGithubFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateGitlab implements AlmSettingsClient.UpdateGitlab
+func (m *MockAlmSettingsClient) UpdateGitlab(opt *sonargo.AlmSettingsUpdateGitlabOption) (resp *http.Response, err error) {
+	if m.UpdateGitlabFn != nil {
+		return m.UpdateGitlabFn(opt)
+	}
+	return nil, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockApplicationsClient is a mock implementation of the ApplicationsClient interface.
+type MockApplicationsClient struct {
+	AddProjectFn    func(opt *instance.ApplicationsAddProjectOption) (resp *http.Response, err error)
+	CreateFn        func(opt *instance.ApplicationsCreateOption) (resp *http.Response, err error)
+	CreateBranchFn  func(opt *instance.ApplicationsCreateBranchOption) (resp *http.Response, err error)
+	DeleteFn        func(opt *instance.ApplicationsDeleteOption) (resp *http.Response, err error)
+	DeleteBranchFn  func(opt *instance.ApplicationsDeleteBranchOption) (resp *http.Response, err error)
+	RemoveProjectFn func(opt *instance.ApplicationsRemoveProjectOption) (resp *http.Response, err error)
+	ShowFn          func(opt *instance.ApplicationsShowOption) (v *instance.ApplicationsShowObject, resp *http.Response, err error)
+	UpdateFn        func(opt *instance.ApplicationsUpdateOption) (resp *http.Response, err error)
+	UpdateBranchFn  func(opt *instance.ApplicationsUpdateBranchOption) (resp *http.Response, err error)
+}
+
+// Ensure MockApplicationsClient implements ApplicationsClient
+var _ instance.ApplicationsClient = &MockApplicationsClient{}
+
+// AddProject implements ApplicationsClient.AddProject
+func (m *MockApplicationsClient) AddProject(opt *instance.ApplicationsAddProjectOption) (resp *http.Response, err error) {
+	if m.AddProjectFn != nil {
+		return m.AddProjectFn(opt)
+	}
+	return nil, nil
+}
+
+// Create implements ApplicationsClient.Create
+func (m *MockApplicationsClient) Create(opt *instance.ApplicationsCreateOption) (resp *http.Response, err error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(opt)
+	}
+	return nil, nil
+}
+
+// CreateBranch implements ApplicationsClient.CreateBranch
+func (m *MockApplicationsClient) CreateBranch(opt *instance.ApplicationsCreateBranchOption) (resp *http.Response, err error) {
+	if m.CreateBranchFn != nil {
+		return m.CreateBranchFn(opt)
+	}
+	return nil, nil
+}
+
+// Delete implements ApplicationsClient.Delete
+func (m *MockApplicationsClient) Delete(opt *instance.ApplicationsDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// DeleteBranch implements ApplicationsClient.DeleteBranch
+func (m *MockApplicationsClient) DeleteBranch(opt *instance.ApplicationsDeleteBranchOption) (resp *http.Response, err error) {
+	if m.DeleteBranchFn != nil {
+		return m.DeleteBranchFn(opt)
+	}
+	return nil, nil
+}
+
+// RemoveProject implements ApplicationsClient.RemoveProject
+func (m *MockApplicationsClient) RemoveProject(opt *instance.ApplicationsRemoveProjectOption) (resp *http.Response, err error) {
+	if m.RemoveProjectFn != nil {
+		return m.RemoveProjectFn(opt)
+	}
+	return nil, nil
+}
+
+// Show implements ApplicationsClient.Show
+func (m *MockApplicationsClient) Show(opt *instance.ApplicationsShowOption) (v *instance.ApplicationsShowObject, resp *http.Response, err error) {
+	if m.ShowFn != nil {
+		return m.ShowFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Update implements ApplicationsClient.Update
+func (m *MockApplicationsClient) Update(opt *instance.ApplicationsUpdateOption) (resp *http.Response, err error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateBranch implements ApplicationsClient.UpdateBranch
+func (m *MockApplicationsClient) UpdateBranch(opt *instance.ApplicationsUpdateBranchOption) (resp *http.Response, err error) {
+	if m.UpdateBranchFn != nil {
+		return m.UpdateBranchFn(opt)
+	}
+	return nil, nil
+}
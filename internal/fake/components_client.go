@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockComponentsClient is a mock implementation of the ComponentsClient interface.
+type MockComponentsClient struct {
+	AppFn            func(opt *sonargo.ComponentsAppOption) (v *sonargo.ComponentsAppObject, resp *http.Response, err error)
+	SearchFn         func(opt *sonargo.ComponentsSearchOption) (v *sonargo.ComponentsSearchObject, resp *http.Response, err error)
+	SearchProjectsFn func(opt *sonargo.ComponentsSearchProjectsOption) (v *sonargo.ComponentsSearchProjectsObject, resp *http.Response, err error)
+	ShowFn           func(opt *sonargo.ComponentsShowOption) (v *sonargo.ComponentsShowObject, resp *http.Response, err error)
+	SuggestionsFn    func(opt *sonargo.ComponentsSuggestionsOption) (v *sonargo.ComponentsSuggestionsObject, resp *http.Response, err error)
+	TreeFn           func(opt *sonargo.ComponentsTreeOption) (v *sonargo.ComponentsTreeObject, resp *http.Response, err error)
+}
+
+// Ensure MockComponentsClient implements ComponentsClient
+var _ instance.ComponentsClient = &MockComponentsClient{}
+
+// App implements ComponentsClient.App
+func (m *MockComponentsClient) App(opt *sonargo.ComponentsAppOption) (v *sonargo.ComponentsAppObject, resp *http.Response, err error) {
+	if m.AppFn != nil {
+		return m.AppFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Search implements ComponentsClient.Search
+func (m *MockComponentsClient) Search(opt *sonargo.ComponentsSearchOption) (v *sonargo.ComponentsSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// SearchProjects implements ComponentsClient.SearchProjects
+func (m *MockComponentsClient) SearchProjects(opt *sonargo.ComponentsSearchProjectsOption) (v *sonargo.ComponentsSearchProjectsObject, resp *http.Response, err error) {
+	if m.SearchProjectsFn != nil {
+		return m.SearchProjectsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Show implements ComponentsClient.Show
+func (m *MockComponentsClient) Show(opt *sonargo.ComponentsShowOption) (v *sonargo.ComponentsShowObject, resp *http.Response, err error) {
+	if m.ShowFn != nil {
+		return m.ShowFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Suggestions implements ComponentsClient.Suggestions
+func (m *MockComponentsClient) Suggestions(opt *sonargo.ComponentsSuggestionsOption) (v *sonargo.ComponentsSuggestionsObject, resp *http.Response, err error) {
+	if m.SuggestionsFn != nil {
+		return m.SuggestionsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Tree implements ComponentsClient.Tree
+func (m *MockComponentsClient) Tree(opt *sonargo.ComponentsTreeOption) (v *sonargo.ComponentsTreeObject, resp *http.Response, err error) {
+	if m.TreeFn != nil {
+		return m.TreeFn(opt)
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockGroupMembershipsClient is a mock implementation of the GroupMembershipsClient interface.
+type MockGroupMembershipsClient struct {
+	CreateFn       func(opt *instance.GroupMembershipsCreateOption) (v *instance.GroupMembershipObject, resp *http.Response, err error)
+	DeleteFn       func(opt *instance.GroupMembershipsDeleteOption) (resp *http.Response, err error)
+	SearchFn       func(opt *instance.GroupMembershipsSearchOption) (v *instance.GroupMembershipsSearchObject, resp *http.Response, err error)
+	SearchGroupsFn func(opt *instance.AuthorizationsGroupsSearchOption) (v *instance.AuthorizationsGroupsSearchObject, resp *http.Response, err error)
+	SearchUsersFn  func(opt *instance.UsersManagementSearchOption) (v *instance.UsersManagementSearchObject, resp *http.Response, err error)
+}
+
+// Ensure MockGroupMembershipsClient implements GroupMembershipsClient
+var _ instance.GroupMembershipsClient = &MockGroupMembershipsClient{}
+
+// Create implements GroupMembershipsClient.Create
+func (m *MockGroupMembershipsClient) Create(opt *instance.GroupMembershipsCreateOption) (v *instance.GroupMembershipObject, resp *http.Response, err error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Delete implements GroupMembershipsClient.Delete
+func (m *MockGroupMembershipsClient) Delete(opt *instance.GroupMembershipsDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// Search implements GroupMembershipsClient.Search
+func (m *MockGroupMembershipsClient) Search(opt *instance.GroupMembershipsSearchOption) (v *instance.GroupMembershipsSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// SearchGroups implements GroupMembershipsClient.SearchGroups
+func (m *MockGroupMembershipsClient) SearchGroups(opt *instance.AuthorizationsGroupsSearchOption) (v *instance.AuthorizationsGroupsSearchObject, resp *http.Response, err error) {
+	if m.SearchGroupsFn != nil {
+		return m.SearchGroupsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// SearchUsers implements GroupMembershipsClient.SearchUsers
+func (m *MockGroupMembershipsClient) SearchUsers(opt *instance.UsersManagementSearchOption) (v *instance.UsersManagementSearchObject, resp *http.Response, err error) {
+	if m.SearchUsersFn != nil {
+		return m.SearchUsersFn(opt)
+	}
+	return nil, nil, nil
+}
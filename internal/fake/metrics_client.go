@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockMetricsClient is a mock implementation of the MetricsClient interface.
+type MockMetricsClient struct {
+	SearchFn func(opt *sonargo.MetricsSearchOption) (v *sonargo.MetricsSearchObject, resp *http.Response, err error)
+	TypesFn  func() (v *sonargo.MetricsTypesObject, resp *http.Response, err error)
+}
+
+// Ensure MockMetricsClient implements MetricsClient
+var _ instance.MetricsClient = &MockMetricsClient{}
+
+// Search implements MetricsClient.Search
+func (m *MockMetricsClient) Search(opt *sonargo.MetricsSearchOption) (v *sonargo.MetricsSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Types implements MetricsClient.Types
+func (m *MockMetricsClient) Types() (v *sonargo.MetricsTypesObject, resp *http.Response, err error) {
+	if m.TypesFn != nil {
+		return m.TypesFn()
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockNewCodePeriodsClient is a mock implementation of the NewCodePeriodsClient interface.
+type MockNewCodePeriodsClient struct {
+	SetFn   func(opt *sonargo.NewCodePeriodsSetOption) (resp *http.Response, err error)
+	ShowFn  func(opt *sonargo.NewCodePeriodsShowOption) (v *instance.NewCodePeriodsShowObject, resp *http.Response, err error)
+	UnsetFn func(opt *sonargo.NewCodePeriodsUnsetOption) (resp *http.Response, err error)
+}
+
+// Ensure MockNewCodePeriodsClient implements NewCodePeriodsClient
+var _ instance.NewCodePeriodsClient = &MockNewCodePeriodsClient{}
+
+// Set implements NewCodePeriodsClient.Set
+func (m *MockNewCodePeriodsClient) Set(opt *sonargo.NewCodePeriodsSetOption) (resp *http.Response, err error) {
+	if m.SetFn != nil {
+		return m.SetFn(opt)
+	}
+	return nil, nil
+}
+
+// Show implements NewCodePeriodsClient.Show
+func (m *MockNewCodePeriodsClient) Show(opt *sonargo.NewCodePeriodsShowOption) (v *instance.NewCodePeriodsShowObject, resp *http.Response, err error) {
+	if m.ShowFn != nil {
+		return m.ShowFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Unset implements NewCodePeriodsClient.Unset
+func (m *MockNewCodePeriodsClient) Unset(opt *sonargo.NewCodePeriodsUnsetOption) (resp *http.Response, err error) {
+	if m.UnsetFn != nil {
+		return m.UnsetFn(opt)
+	}
+	return nil, nil
+}
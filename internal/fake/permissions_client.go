@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockPermissionsClient is a mock implementation of the PermissionsClient interface.
+type MockPermissionsClient struct {
+	AddGroupFn                         func(opt *sonargo.PermissionsAddGroupOption) (resp *http.Response, err error)
+	AddGroupToTemplateFn               func(opt *sonargo.PermissionsAddGroupToTemplateOption) (resp *http.Response, err error)
+	AddProjectCreatorToTemplateFn      func(opt *sonargo.PermissionsAddProjectCreatorToTemplateOption) (resp *http.Response, err error)
+	AddUserFn                          func(opt *sonargo.PermissionsAddUserOption) (resp *http.Response, err error)
+	AddUserToTemplateFn                func(opt *sonargo.PermissionsAddUserToTemplateOption) (resp *http.Response, err error)
+	CreateTemplateFn                   func(opt *sonargo.PermissionsCreateTemplateOption) (v *instance.PermissionsCreateTemplateObject, resp *http.Response, err error)
+	DeleteTemplateFn                   func(opt *sonargo.PermissionsDeleteTemplateOption) (resp *http.Response, err error)
+	GroupsFn                           func(opt *sonargo.PermissionsGroupsOption) (v *instance.PermissionsGroupsObject, resp *http.Response, err error)
+	RemoveGroupFn                      func(opt *sonargo.PermissionsRemoveGroupOption) (resp *http.Response, err error)
+	RemoveGroupFromTemplateFn          func(opt *sonargo.PermissionsRemoveGroupFromTemplateOption) (resp *http.Response, err error)
+	RemoveProjectCreatorFromTemplateFn func(opt *sonargo.PermissionsRemoveProjectCreatorFromTemplateOption) (resp *http.Response, err error)
+	RemoveUserFn                       func(opt *sonargo.PermissionsRemoveUserOption) (resp *http.Response, err error)
+	RemoveUserFromTemplateFn           func(opt *sonargo.PermissionsRemoveUserFromTemplateOption) (resp *http.Response, err error)
+	SearchTemplatesFn                  func(opt *sonargo.PermissionsSearchTemplatesOption) (v *instance.PermissionsSearchTemplatesObject, resp *http.Response, err error)
+	SetDefaultTemplateFn               func(opt *sonargo.PermissionsSetDefaultTemplateOption) (resp *http.Response, err error)
+	TemplateGroupsFn                   func(opt *sonargo.PermissionsTemplateGroupsOption) (v *sonargo.PermissionsTemplateGroupsObject, resp *http.Response, err error)
+	TemplateUsersFn                    func(opt *sonargo.PermissionsTemplateUsersOption) (v *sonargo.PermissionsTemplateUsersObject, resp *http.Response, err error)
+	UpdateTemplateFn                   func(opt *instance.PermissionsUpdateTemplateOption) (resp *http.Response, err error)
+	UsersFn                            func(opt *sonargo.PermissionsUsersOption) (v *sonargo.PermissionsUsersObject, resp *http.Response, err error)
+}
+
+// Ensure MockPermissionsClient implements PermissionsClient
+var _ instance.PermissionsClient = &MockPermissionsClient{}
+
+// AddGroup implements PermissionsClient.AddGroup
+func (m *MockPermissionsClient) AddGroup(opt *sonargo.PermissionsAddGroupOption) (resp *http.Response, err error) {
+	if m.AddGroupFn != nil {
+		return m.AddGroupFn(opt)
+	}
+	return nil, nil
+}
+
+// AddGroupToTemplate implements PermissionsClient.AddGroupToTemplate
+func (m *MockPermissionsClient) AddGroupToTemplate(opt *sonargo.PermissionsAddGroupToTemplateOption) (resp *http.Response, err error) {
+	if m.AddGroupToTemplateFn != nil {
+		return m.AddGroupToTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// AddProjectCreatorToTemplate implements PermissionsClient.AddProjectCreatorToTemplate
+func (m *MockPermissionsClient) AddProjectCreatorToTemplate(opt *sonargo.PermissionsAddProjectCreatorToTemplateOption) (resp *http.Response, err error) {
+	if m.AddProjectCreatorToTemplateFn != nil {
+		return m.AddProjectCreatorToTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// AddUser implements PermissionsClient.AddUser
+func (m *MockPermissionsClient) AddUser(opt *sonargo.PermissionsAddUserOption) (resp *http.Response, err error) {
+	if m.AddUserFn != nil {
+		return m.AddUserFn(opt)
+	}
+	return nil, nil
+}
+
+// AddUserToTemplate implements PermissionsClient.AddUserToTemplate
+func (m *MockPermissionsClient) AddUserToTemplate(opt *sonargo.PermissionsAddUserToTemplateOption) (resp *http.Response, err error) {
+	if m.AddUserToTemplateFn != nil {
+		return m.AddUserToTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// CreateTemplate implements PermissionsClient.CreateTemplate
+func (m *MockPermissionsClient) CreateTemplate(opt *sonargo.PermissionsCreateTemplateOption) (v *instance.PermissionsCreateTemplateObject, resp *http.Response, err error) {
+	if m.CreateTemplateFn != nil {
+		return m.CreateTemplateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// DeleteTemplate implements PermissionsClient.DeleteTemplate
+func (m *MockPermissionsClient) DeleteTemplate(opt *sonargo.PermissionsDeleteTemplateOption) (resp *http.Response, err error) {
+	if m.DeleteTemplateFn != nil {
+		return m.DeleteTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// Groups implements PermissionsClient.Groups
+func (m *MockPermissionsClient) Groups(opt *sonargo.PermissionsGroupsOption) (v *instance.PermissionsGroupsObject, resp *http.Response, err error) {
+	if m.GroupsFn != nil {
+		return m.GroupsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// RemoveGroup implements PermissionsClient.RemoveGroup
+func (m *MockPermissionsClient) RemoveGroup(opt *sonargo.PermissionsRemoveGroupOption) (resp *http.Response, err error) {
+	if m.RemoveGroupFn != nil {
+		return m.RemoveGroupFn(opt)
+	}
+	return nil, nil
+}
+
+// RemoveGroupFromTemplate implements PermissionsClient.RemoveGroupFromTemplate
+func (m *MockPermissionsClient) RemoveGroupFromTemplate(opt *sonargo.PermissionsRemoveGroupFromTemplateOption) (resp *http.Response, err error) {
+	if m.RemoveGroupFromTemplateFn != nil {
+		return m.RemoveGroupFromTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// RemoveProjectCreatorFromTemplate implements PermissionsClient.RemoveProjectCreatorFromTemplate
+func (m *MockPermissionsClient) RemoveProjectCreatorFromTemplate(opt *sonargo.PermissionsRemoveProjectCreatorFromTemplateOption) (resp *http.Response, err error) {
+	if m.RemoveProjectCreatorFromTemplateFn != nil {
+		return m.RemoveProjectCreatorFromTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// RemoveUser implements PermissionsClient.RemoveUser
+func (m *MockPermissionsClient) RemoveUser(opt *sonargo.PermissionsRemoveUserOption) (resp *http.Response, err error) {
+	if m.RemoveUserFn != nil {
+		return m.RemoveUserFn(opt)
+	}
+	return nil, nil
+}
+
+// RemoveUserFromTemplate implements PermissionsClient.RemoveUserFromTemplate
+func (m *MockPermissionsClient) RemoveUserFromTemplate(opt *sonargo.PermissionsRemoveUserFromTemplateOption) (resp *http.Response, err error) {
+	if m.RemoveUserFromTemplateFn != nil {
+		return m.RemoveUserFromTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// SearchTemplates implements PermissionsClient.SearchTemplates
+func (m *MockPermissionsClient) SearchTemplates(opt *sonargo.PermissionsSearchTemplatesOption) (v *instance.PermissionsSearchTemplatesObject, resp *http.Response, err error) {
+	if m.SearchTemplatesFn != nil {
+		return m.SearchTemplatesFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// SetDefaultTemplate implements PermissionsClient.SetDefaultTemplate
+func (m *MockPermissionsClient) SetDefaultTemplate(opt *sonargo.PermissionsSetDefaultTemplateOption) (resp *http.Response, err error) {
+	if m.SetDefaultTemplateFn != nil {
+		return m.SetDefaultTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// TemplateGroups implements PermissionsClient.TemplateGroups
+func (m *MockPermissionsClient) TemplateGroups(opt *sonargo.PermissionsTemplateGroupsOption) (v *sonargo.PermissionsTemplateGroupsObject, resp *http.Response, err error) {
+	if m.TemplateGroupsFn != nil {
+		return m.TemplateGroupsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// TemplateUsers implements PermissionsClient.TemplateUsers
+func (m *MockPermissionsClient) TemplateUsers(opt *sonargo.PermissionsTemplateUsersOption) (v *sonargo.PermissionsTemplateUsersObject, resp *http.Response, err error) {
+	if m.TemplateUsersFn != nil {
+		return m.TemplateUsersFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// UpdateTemplate implements PermissionsClient.UpdateTemplate
+func (m *MockPermissionsClient) UpdateTemplate(opt *instance.PermissionsUpdateTemplateOption) (resp *http.Response, err error) {
+	if m.UpdateTemplateFn != nil {
+		return m.UpdateTemplateFn(opt)
+	}
+	return nil, nil
+}
+
+// Users implements PermissionsClient.Users
+func (m *MockPermissionsClient) Users(opt *sonargo.PermissionsUsersOption) (v *sonargo.PermissionsUsersObject, resp *http.Response, err error) {
+	if m.UsersFn != nil {
+		return m.UsersFn(opt)
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockPortfoliosClient is a mock implementation of the PortfoliosClient interface.
+type MockPortfoliosClient struct {
+	AddPortfolioFn             func(opt *instance.ViewsAddPortfolioOption) (resp *http.Response, err error)
+	AddProjectFn               func(opt *instance.ViewsAddProjectOption) (resp *http.Response, err error)
+	CreateFn                   func(opt *instance.ViewsCreateOption) (resp *http.Response, err error)
+	DeleteFn                   func(opt *instance.ViewsDeleteOption) (resp *http.Response, err error)
+	RemovePortfolioFn          func(opt *instance.ViewsRemovePortfolioOption) (resp *http.Response, err error)
+	RemoveProjectFn            func(opt *instance.ViewsRemoveProjectOption) (resp *http.Response, err error)
+	SetManualModeFn            func(opt *instance.ViewsSetManualModeOption) (resp *http.Response, err error)
+	SetNoneModeFn              func(opt *instance.ViewsSetNoneModeOption) (resp *http.Response, err error)
+	SetRegexpModeFn            func(opt *instance.ViewsSetRegexpModeOption) (resp *http.Response, err error)
+	SetRemainingProjectsModeFn func(opt *instance.ViewsSetRemainingProjectsModeOption) (resp *http.Response, err error)
+	SetTagsModeFn              func(opt *instance.ViewsSetTagsModeOption) (resp *http.Response, err error)
+	ShowFn                     func(opt *instance.ViewsShowOption) (v *instance.ViewsShowObject, resp *http.Response, err error)
+	UpdateFn                   func(opt *instance.ViewsUpdateOption) (resp *http.Response, err error)
+}
+
+// Ensure MockPortfoliosClient implements PortfoliosClient
+var _ instance.PortfoliosClient = &MockPortfoliosClient{}
+
+// AddPortfolio implements PortfoliosClient.AddPortfolio
+func (m *MockPortfoliosClient) AddPortfolio(opt *instance.ViewsAddPortfolioOption) (resp *http.Response, err error) {
+	if m.AddPortfolioFn != nil {
+		return m.AddPortfolioFn(opt)
+	}
+	return nil, nil
+}
+
+// AddProject implements PortfoliosClient.AddProject
+func (m *MockPortfoliosClient) AddProject(opt *instance.ViewsAddProjectOption) (resp *http.Response, err error) {
+	if m.AddProjectFn != nil {
+		return m.AddProjectFn(opt)
+	}
+	return nil, nil
+}
+
+// Create implements PortfoliosClient.Create
+func (m *MockPortfoliosClient) Create(opt *instance.ViewsCreateOption) (resp *http.Response, err error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(opt)
+	}
+	return nil, nil
+}
+
+// Delete implements PortfoliosClient.Delete
+func (m *MockPortfoliosClient) Delete(opt *instance.ViewsDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// RemovePortfolio implements PortfoliosClient.RemovePortfolio
+func (m *MockPortfoliosClient) RemovePortfolio(opt *instance.ViewsRemovePortfolioOption) (resp *http.Response, err error) {
+	if m.RemovePortfolioFn != nil {
+		return m.RemovePortfolioFn(opt)
+	}
+	return nil, nil
+}
+
+// RemoveProject implements PortfoliosClient.RemoveProject
+func (m *MockPortfoliosClient) RemoveProject(opt *instance.ViewsRemoveProjectOption) (resp *http.Response, err error) {
+	if m.RemoveProjectFn != nil {
+		return m.RemoveProjectFn(opt)
+	}
+	return nil, nil
+}
+
+// SetManualMode implements PortfoliosClient.SetManualMode
+func (m *MockPortfoliosClient) SetManualMode(opt *instance.ViewsSetManualModeOption) (resp *http.Response, err error) {
+	if m.SetManualModeFn != nil {
+		return m.SetManualModeFn(opt)
+	}
+	return nil, nil
+}
+
+// SetNoneMode implements PortfoliosClient.SetNoneMode
+func (m *MockPortfoliosClient) SetNoneMode(opt *instance.ViewsSetNoneModeOption) (resp *http.Response, err error) {
+	if m.SetNoneModeFn != nil {
+		return m.SetNoneModeFn(opt)
+	}
+	return nil, nil
+}
+
+// SetRegexpMode implements PortfoliosClient.SetRegexpMode
+func (m *MockPortfoliosClient) SetRegexpMode(opt *instance.ViewsSetRegexpModeOption) (resp *http.Response, err error) {
+	if m.SetRegexpModeFn != nil {
+		return m.SetRegexpModeFn(opt)
+	}
+	return nil, nil
+}
+
+// SetRemainingProjectsMode implements PortfoliosClient.SetRemainingProjectsMode
+func (m *MockPortfoliosClient) SetRemainingProjectsMode(opt *instance.ViewsSetRemainingProjectsModeOption) (resp *http.Response, err error) {
+	if m.SetRemainingProjectsModeFn != nil {
+		return m.SetRemainingProjectsModeFn(opt)
+	}
+	return nil, nil
+}
+
+// SetTagsMode implements PortfoliosClient.SetTagsMode
+func (m *MockPortfoliosClient) SetTagsMode(opt *instance.ViewsSetTagsModeOption) (resp *http.Response, err error) {
+	if m.SetTagsModeFn != nil {
+		return m.SetTagsModeFn(opt)
+	}
+	return nil, nil
+}
+
+// Show implements PortfoliosClient.Show
+func (m *MockPortfoliosClient) Show(opt *instance.ViewsShowOption) (v *instance.ViewsShowObject, resp *http.Response, err error) {
+	if m.ShowFn != nil {
+		return m.ShowFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Update implements PortfoliosClient.Update
+func (m *MockPortfoliosClient) Update(opt *instance.ViewsUpdateOption) (resp *http.Response, err error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(opt)
+	}
+	return nil, nil
+}
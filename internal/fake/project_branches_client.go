@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockProjectBranchesClient is a mock implementation of the ProjectBranchesClient interface.
+type MockProjectBranchesClient struct {
+	DeleteFn                         func(opt *sonargo.ProjectBranchesDeleteOption) (resp *http.Response, err error)
+	ListFn                           func(opt *sonargo.ProjectBranchesListOption) (v *sonargo.ProjectBranchesListObject, resp *http.Response, err error)
+	RenameFn                         func(opt *sonargo.ProjectBranchesRenameOption) (resp *http.Response, err error)
+	SetAutomaticDeletionProtectionFn func(opt *sonargo.ProjectBranchesSetAutomaticDeletionProtectionOption) (resp *http.Response, err error)
+	SetMainFn                        func(opt *sonargo.ProjectBranchesSetMainOption) (resp *http.Response, err error)
+}
+
+// Ensure MockProjectBranchesClient implements ProjectBranchesClient
+var _ instance.ProjectBranchesClient = &MockProjectBranchesClient{}
+
+// Delete implements ProjectBranchesClient.Delete
+func (m *MockProjectBranchesClient) Delete(opt *sonargo.ProjectBranchesDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// List implements ProjectBranchesClient.List
+func (m *MockProjectBranchesClient) List(opt *sonargo.ProjectBranchesListOption) (v *sonargo.ProjectBranchesListObject, resp *http.Response, err error) {
+	if m.ListFn != nil {
+		return m.ListFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Rename implements ProjectBranchesClient.Rename
+func (m *MockProjectBranchesClient) Rename(opt *sonargo.ProjectBranchesRenameOption) (resp *http.Response, err error) {
+	if m.RenameFn != nil {
+		return m.RenameFn(opt)
+	}
+	return nil, nil
+}
+
+// SetAutomaticDeletionProtection implements ProjectBranchesClient.SetAutomaticDeletionProtection
+func (m *MockProjectBranchesClient) SetAutomaticDeletionProtection(opt *sonargo.ProjectBranchesSetAutomaticDeletionProtectionOption) (resp *http.Response, err error) {
+	if m.SetAutomaticDeletionProtectionFn != nil {
+		return m.SetAutomaticDeletionProtectionFn(opt)
+	}
+	return nil, nil
+}
+
+// SetMain implements ProjectBranchesClient.SetMain
+func (m *MockProjectBranchesClient) SetMain(opt *sonargo.ProjectBranchesSetMainOption) (resp *http.Response, err error) {
+	if m.SetMainFn != nil {
+		return m.SetMainFn(opt)
+	}
+	return nil, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockProjectsClient is a mock implementation of the ProjectsClient interface.
+type MockProjectsClient struct {
+	BulkDeleteFn                func(opt *sonargo.ProjectsBulkDeleteOption) (resp *http.Response, err error)
+	CreateFn                    func(opt *sonargo.ProjectsCreateOption) (v *sonargo.ProjectsCreateObject, resp *http.Response, err error)
+	DeleteFn                    func(opt *sonargo.ProjectsDeleteOption) (resp *http.Response, err error)
+	SearchFn                    func(opt *sonargo.ProjectsSearchOption) (v *sonargo.ProjectsSearchObject, resp *http.Response, err error)
+	SearchMyProjectsFn          func(opt *sonargo.ProjectsSearchMyProjectsOption) (v *sonargo.ProjectsSearchMyProjectsObject, resp *http.Response, err error)
+	SearchMyScannableProjectsFn func(opt *sonargo.ProjectsSearchMyScannableProjectsOption) (v *sonargo.ProjectsSearchMyScannableProjectsObject, resp *http.Response, err error)
+	UpdateDefaultVisibilityFn   func(opt *sonargo.ProjectsUpdateDefaultVisibilityOption) (resp *http.Response, err error)
+	UpdateKeyFn                 func(opt *sonargo.ProjectsUpdateKeyOption) (resp *http.Response, err error)
+	UpdateVisibilityFn          func(opt *sonargo.ProjectsUpdateVisibilityOption) (resp *http.Response, err error)
+}
+
+// Ensure MockProjectsClient implements ProjectsClient
+var _ instance.ProjectsClient = &MockProjectsClient{}
+
+// BulkDelete implements ProjectsClient.BulkDelete
+func (m *MockProjectsClient) BulkDelete(opt *sonargo.ProjectsBulkDeleteOption) (resp *http.Response, err error) {
+	if m.BulkDeleteFn != nil {
+		return m.BulkDeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// Create implements ProjectsClient.Create
+func (m *MockProjectsClient) Create(opt *sonargo.ProjectsCreateOption) (v *sonargo.ProjectsCreateObject, resp *http.Response, err error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Delete implements ProjectsClient.Delete
+func (m *MockProjectsClient) Delete(opt *sonargo.ProjectsDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// Search implements ProjectsClient.Search
+func (m *MockProjectsClient) Search(opt *sonargo.ProjectsSearchOption) (v *sonargo.ProjectsSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// SearchMyProjects implements ProjectsClient.SearchMyProjects
+func (m *MockProjectsClient) SearchMyProjects(opt *sonargo.ProjectsSearchMyProjectsOption) (v *sonargo.ProjectsSearchMyProjectsObject, resp *http.Response, err error) {
+	if m.SearchMyProjectsFn != nil {
+		return m.SearchMyProjectsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// SearchMyScannableProjects implements ProjectsClient.SearchMyScannableProjects
+func (m *MockProjectsClient) SearchMyScannableProjects(opt *sonargo.ProjectsSearchMyScannableProjectsOption) (v *sonargo.ProjectsSearchMyScannableProjectsObject, resp *http.Response, err error) {
+	if m.SearchMyScannableProjectsFn != nil {
+		return m.SearchMyScannableProjectsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// UpdateDefaultVisibility implements ProjectsClient.UpdateDefaultVisibility
+func (m *MockProjectsClient) UpdateDefaultVisibility(opt *sonargo.ProjectsUpdateDefaultVisibilityOption) (resp *http.Response, err error) {
+	if m.UpdateDefaultVisibilityFn != nil {
+		return m.UpdateDefaultVisibilityFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateKey implements ProjectsClient.UpdateKey
+func (m *MockProjectsClient) UpdateKey(opt *sonargo.ProjectsUpdateKeyOption) (resp *http.Response, err error) {
+	if m.UpdateKeyFn != nil {
+		return m.UpdateKeyFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateVisibility implements ProjectsClient.UpdateVisibility
+func (m *MockProjectsClient) UpdateVisibility(opt *sonargo.ProjectsUpdateVisibilityOption) (resp *http.Response, err error) {
+	if m.UpdateVisibilityFn != nil {
+		return m.UpdateVisibilityFn(opt)
+	}
+	return nil, nil
+}
+
+// MockProjectTagsClient is a mock implementation of the ProjectTagsClient interface.
+type MockProjectTagsClient struct {
+	SearchFn func(opt *sonargo.ProjectTagsSearchOption) (v *sonargo.ProjectTagsSearchObject, resp *http.Response, err error)
+	SetFn    func(opt *sonargo.ProjectTagsSetOption) (resp *http.Response, err error)
+}
+
+// Ensure MockProjectTagsClient implements ProjectTagsClient
+var _ instance.ProjectTagsClient = &MockProjectTagsClient{}
+
+// Search implements ProjectTagsClient.Search
+func (m *MockProjectTagsClient) Search(opt *sonargo.ProjectTagsSearchOption) (v *sonargo.ProjectTagsSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Set implements ProjectTagsClient.Set
+func (m *MockProjectTagsClient) Set(opt *sonargo.ProjectTagsSetOption) (resp *http.Response, err error) {
+	if m.SetFn != nil {
+		return m.SetFn(opt)
+	}
+	return nil, nil
+}
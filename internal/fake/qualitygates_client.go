@@ -27,27 +27,28 @@ import (
 
 // MockQualityGatesClient is a mock implementation of the QualityGatesClient interface.
 type MockQualityGatesClient struct {
-	AddGroupFn        func(opt *sonargo.QualitygatesAddGroupOption) (resp *http.Response, err error)
-	AddUserFn         func(opt *sonargo.QualitygatesAddUserOption) (resp *http.Response, err error)
-	CopyFn            func(opt *sonargo.QualitygatesCopyOption) (resp *http.Response, err error)
-	CreateFn          func(opt *sonargo.QualitygatesCreateOption) (v *sonargo.QualitygatesCreateObject, resp *http.Response, err error)
-	CreateConditionFn func(opt *sonargo.QualitygatesCreateConditionOption) (v *sonargo.QualitygatesCreateConditionObject, resp *http.Response, err error)
-	DeleteConditionFn func(opt *sonargo.QualitygatesDeleteConditionOption) (resp *http.Response, err error)
-	DeselectFn        func(opt *sonargo.QualitygatesDeselectOption) (resp *http.Response, err error)
-	DestroyFn         func(opt *sonargo.QualitygatesDestroyOption) (resp *http.Response, err error)
-	GetByProjectFn    func(opt *sonargo.QualitygatesGetByProjectOption) (v *sonargo.QualitygatesGetByProjectObject, resp *http.Response, err error)
-	ListFn            func() (v *sonargo.QualitygatesListObject, resp *http.Response, err error)
-	ProjectStatusFn   func(opt *sonargo.QualitygatesProjectStatusOption) (v *sonargo.QualitygatesProjectStatusObject, resp *http.Response, err error)
-	RemoveGroupFn     func(opt *sonargo.QualitygatesRemoveGroupOption) (resp *http.Response, err error)
-	RemoveUserFn      func(opt *sonargo.QualitygatesRemoveUserOption) (resp *http.Response, err error)
-	RenameFn          func(opt *sonargo.QualitygatesRenameOption) (resp *http.Response, err error)
-	SearchFn          func(opt *sonargo.QualitygatesSearchOption) (v *sonargo.QualitygatesSearchObject, resp *http.Response, err error)
-	SearchGroupsFn    func(opt *sonargo.QualitygatesSearchGroupsOption) (v *sonargo.QualitygatesSearchGroupsObject, resp *http.Response, err error)
-	SearchUsersFn     func(opt *sonargo.QualitygatesSearchUsersOption) (v *sonargo.QualitygatesSearchUsersObject, resp *http.Response, err error)
-	SelectFn          func(opt *sonargo.QualitygatesSelectOption) (resp *http.Response, err error)
-	SetAsDefaultFn    func(opt *sonargo.QualitygatesSetAsDefaultOption) (resp *http.Response, err error)
-	ShowFn            func(opt *sonargo.QualitygatesShowOption) (v *sonargo.QualitygatesShowObject, resp *http.Response, err error)
-	UpdateConditionFn func(opt *sonargo.QualitygatesUpdateConditionOption) (resp *http.Response, err error)
+	AddGroupFn           func(opt *sonargo.QualitygatesAddGroupOption) (resp *http.Response, err error)
+	AddUserFn            func(opt *sonargo.QualitygatesAddUserOption) (resp *http.Response, err error)
+	CopyFn               func(opt *sonargo.QualitygatesCopyOption) (resp *http.Response, err error)
+	CreateFn             func(opt *sonargo.QualitygatesCreateOption) (v *sonargo.QualitygatesCreateObject, resp *http.Response, err error)
+	CreateConditionFn    func(opt *sonargo.QualitygatesCreateConditionOption) (v *sonargo.QualitygatesCreateConditionObject, resp *http.Response, err error)
+	DeleteConditionFn    func(opt *sonargo.QualitygatesDeleteConditionOption) (resp *http.Response, err error)
+	DeselectFn           func(opt *sonargo.QualitygatesDeselectOption) (resp *http.Response, err error)
+	DestroyFn            func(opt *sonargo.QualitygatesDestroyOption) (resp *http.Response, err error)
+	GetByProjectFn       func(opt *sonargo.QualitygatesGetByProjectOption) (v *sonargo.QualitygatesGetByProjectObject, resp *http.Response, err error)
+	ListFn               func() (v *sonargo.QualitygatesListObject, resp *http.Response, err error)
+	ProjectStatusFn      func(opt *sonargo.QualitygatesProjectStatusOption) (v *sonargo.QualitygatesProjectStatusObject, resp *http.Response, err error)
+	RemoveGroupFn        func(opt *sonargo.QualitygatesRemoveGroupOption) (resp *http.Response, err error)
+	RemoveUserFn         func(opt *sonargo.QualitygatesRemoveUserOption) (resp *http.Response, err error)
+	RenameFn             func(opt *sonargo.QualitygatesRenameOption) (resp *http.Response, err error)
+	SearchFn             func(opt *sonargo.QualitygatesSearchOption) (v *sonargo.QualitygatesSearchObject, resp *http.Response, err error)
+	SearchGroupsFn       func(opt *sonargo.QualitygatesSearchGroupsOption) (v *sonargo.QualitygatesSearchGroupsObject, resp *http.Response, err error)
+	SearchUsersFn        func(opt *sonargo.QualitygatesSearchUsersOption) (v *sonargo.QualitygatesSearchUsersObject, resp *http.Response, err error)
+	SelectFn             func(opt *sonargo.QualitygatesSelectOption) (resp *http.Response, err error)
+	SetAiCodeAssuranceFn func(opt *instance.QualitygatesSetAiCodeAssuranceOption) (resp *http.Response, err error)
+	SetAsDefaultFn       func(opt *sonargo.QualitygatesSetAsDefaultOption) (resp *http.Response, err error)
+	ShowFn               func(opt *sonargo.QualitygatesShowOption) (v *sonargo.QualitygatesShowObject, resp *http.Response, err error)
+	UpdateConditionFn    func(opt *sonargo.QualitygatesUpdateConditionOption) (resp *http.Response, err error)
 }
 
 // Ensure MockQualityGatesClient implements QualityGatesClient
@@ -197,6 +198,14 @@ func (m *MockQualityGatesClient) Select(opt *sonargo.QualitygatesSelectOption) (
 	return nil, nil
 }
 
+// SetAiCodeAssurance implements QualityGatesClient.SetAiCodeAssurance
+func (m *MockQualityGatesClient) SetAiCodeAssurance(opt *instance.QualitygatesSetAiCodeAssuranceOption) (resp *http.Response, err error) {
+	if m.SetAiCodeAssuranceFn != nil {
+		return m.SetAiCodeAssuranceFn(opt)
+	}
+	return nil, nil
+}
+
 // SetAsDefault implements QualityGatesClient.SetAsDefault
 func (m *MockQualityGatesClient) SetAsDefault(opt *sonargo.QualitygatesSetAsDefaultOption) (resp *http.Response, err error) {
 	if m.SetAsDefaultFn != nil {
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockSettingsClient is a mock implementation of the SettingsClient interface.
+type MockSettingsClient struct {
+	ResetFn  func(opt *sonargo.SettingsResetOption) (resp *http.Response, err error)
+	SetFn    func(opt *instance.SettingsSetOption) (resp *http.Response, err error)
+	ValuesFn func(opt *sonargo.SettingsValuesOption) (v *instance.SettingsValuesObject, resp *http.Response, err error)
+}
+
+// Ensure MockSettingsClient implements SettingsClient
+var _ instance.SettingsClient = &MockSettingsClient{}
+
+// Reset implements SettingsClient.Reset
+func (m *MockSettingsClient) Reset(opt *sonargo.SettingsResetOption) (resp *http.Response, err error) {
+	if m.ResetFn != nil {
+		return m.ResetFn(opt)
+	}
+	return nil, nil
+}
+
+// Set implements SettingsClient.Set
+func (m *MockSettingsClient) Set(opt *instance.SettingsSetOption) (resp *http.Response, err error) {
+	if m.SetFn != nil {
+		return m.SetFn(opt)
+	}
+	return nil, nil
+}
+
+// Values implements SettingsClient.Values
+func (m *MockSettingsClient) Values(opt *sonargo.SettingsValuesOption) (v *instance.SettingsValuesObject, resp *http.Response, err error) {
+	if m.ValuesFn != nil {
+		return m.ValuesFn(opt)
+	}
+	return nil, nil, nil
+}
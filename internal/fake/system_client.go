@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockSystemClient is a mock implementation of the SystemClient interface.
+type MockSystemClient struct {
+	InfoFn func() (v *instance.SystemInfoObject, resp *http.Response, err error)
+}
+
+// Ensure MockSystemClient implements SystemClient
+var _ instance.SystemClient = &MockSystemClient{}
+
+// Info implements SystemClient.Info
+func (m *MockSystemClient) Info() (v *instance.SystemInfoObject, resp *http.Response, err error) {
+	if m.InfoFn != nil {
+		return m.InfoFn()
+	}
+	return nil, nil, nil
+}
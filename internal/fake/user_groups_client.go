@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockUserGroupsClient is a mock implementation of the UserGroupsClient interface.
+type MockUserGroupsClient struct {
+	AddUserFn    func(opt *sonargo.UserGroupsAddUserOption) (resp *http.Response, err error)
+	CreateFn     func(opt *sonargo.UserGroupsCreateOption) (v *sonargo.UserGroupsCreateObject, resp *http.Response, err error)
+	DeleteFn     func(opt *sonargo.UserGroupsDeleteOption) (resp *http.Response, err error)
+	RemoveUserFn func(opt *sonargo.UserGroupsRemoveUserOption) (resp *http.Response, err error)
+	SearchFn     func(opt *sonargo.UserGroupsSearchOption) (v *sonargo.UserGroupsSearchObject, resp *http.Response, err error)
+	UpdateFn     func(opt *instance.UserGroupsUpdateOption) (resp *http.Response, err error)
+	UsersFn      func(opt *sonargo.UserGroupsUsersOption) (v *sonargo.UserGroupsUsersObject, resp *http.Response, err error)
+}
+
+// Ensure MockUserGroupsClient implements UserGroupsClient
+var _ instance.UserGroupsClient = &MockUserGroupsClient{}
+
+// AddUser implements UserGroupsClient.AddUser
+func (m *MockUserGroupsClient) AddUser(opt *sonargo.UserGroupsAddUserOption) (resp *http.Response, err error) {
+	if m.AddUserFn != nil {
+		return m.AddUserFn(opt)
+	}
+	return nil, nil
+}
+
+// Create implements UserGroupsClient.Create
+func (m *MockUserGroupsClient) Create(opt *sonargo.UserGroupsCreateOption) (v *sonargo.UserGroupsCreateObject, resp *http.Response, err error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Delete implements UserGroupsClient.Delete
+func (m *MockUserGroupsClient) Delete(opt *sonargo.UserGroupsDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// RemoveUser implements UserGroupsClient.RemoveUser
+func (m *MockUserGroupsClient) RemoveUser(opt *sonargo.UserGroupsRemoveUserOption) (resp *http.Response, err error) {
+	if m.RemoveUserFn != nil {
+		return m.RemoveUserFn(opt)
+	}
+	return nil, nil
+}
+
+// Search implements UserGroupsClient.Search
+func (m *MockUserGroupsClient) Search(opt *sonargo.UserGroupsSearchOption) (v *sonargo.UserGroupsSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Update implements UserGroupsClient.Update
+func (m *MockUserGroupsClient) Update(opt *instance.UserGroupsUpdateOption) (resp *http.Response, err error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(opt)
+	}
+	return nil, nil
+}
+
+// Users implements UserGroupsClient.Users
+func (m *MockUserGroupsClient) Users(opt *sonargo.UserGroupsUsersOption) (v *sonargo.UserGroupsUsersObject, resp *http.Response, err error) {
+	if m.UsersFn != nil {
+		return m.UsersFn(opt)
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockUserTokensClient is a mock implementation of the UserTokensClient interface.
+type MockUserTokensClient struct {
+	GenerateFn func(opt *sonargo.UserTokensGenerateOption) (v *sonargo.UserTokensGenerateObject, resp *http.Response, err error)
+	RevokeFn   func(opt *sonargo.UserTokensRevokeOption) (resp *http.Response, err error)
+	SearchFn   func(opt *sonargo.UserTokensSearchOption) (v *sonargo.UserTokensSearchObject, resp *http.Response, err error)
+}
+
+// Ensure MockUserTokensClient implements UserTokensClient
+var _ instance.UserTokensClient = &MockUserTokensClient{}
+
+// Generate implements UserTokensClient.Generate
+func (m *MockUserTokensClient) Generate(opt *sonargo.UserTokensGenerateOption) (v *sonargo.UserTokensGenerateObject, resp *http.Response, err error) {
+	if m.GenerateFn != nil {
+		return m.GenerateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Revoke implements UserTokensClient.Revoke
+func (m *MockUserTokensClient) Revoke(opt *sonargo.UserTokensRevokeOption) (resp *http.Response, err error) {
+	if m.RevokeFn != nil {
+		return m.RevokeFn(opt)
+	}
+	return nil, nil
+}
+
+// Search implements UserTokensClient.Search
+func (m *MockUserTokensClient) Search(opt *sonargo.UserTokensSearchOption) (v *sonargo.UserTokensSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockUsersClient is a mock implementation of the UsersClient interface.
+type MockUsersClient struct {
+	AnonymizeFn              func(opt *sonargo.UsersAnonymizeOption) (resp *http.Response, err error)
+	ChangePasswordFn         func(opt *sonargo.UsersChangePasswordOption) (resp *http.Response, err error)
+	CreateFn                 func(opt *instance.UsersCreateOption) (v *sonargo.UsersCreateObject, resp *http.Response, err error)
+	CurrentFn                func() (v *sonargo.UsersCurrentObject, resp *http.Response, err error)
+	DeactivateFn             func(opt *sonargo.UsersDeactivateOption) (v *sonargo.UsersDeactivateObject, resp *http.Response, err error)
+	DismissNoticeFn          func(opt *sonargo.UsersDismissNoticeOption) (resp *http.Response, err error)
+	GroupsFn                 func(opt *sonargo.UsersGroupsOption) (v *sonargo.UsersGroupsObject, resp *http.Response, err error)
+	IdentityProvidersFn      func() (v *sonargo.UsersIdentityProvidersObject, resp *http.Response, err error)
+	SearchFn                 func(opt *sonargo.UsersSearchOption) (v *sonargo.UsersSearchObject, resp *http.Response, err error)
+	SetHomepageFn            func(opt *sonargo.UsersSetHomepageOption) (resp *http.Response, err error)
+	UpdateFn                 func(opt *instance.UsersUpdateOption) (v *sonargo.UsersUpdateObject, resp *http.Response, err error)
+	UpdateIdentityProviderFn func(opt *sonargo.UsersUpdateIdentityProviderOption) (resp *http.Response, err error)
+	UpdateLoginFn            func(opt *sonargo.UsersUpdateLoginOption) (resp *http.Response, err error)
+	ValidateCredentialsFn    func(opt *instance.AuthenticationValidateCredentialsOption) (v *sonargo.AuthenticationValidateObject, resp *http.Response, err error)
+}
+
+// Ensure MockUsersClient implements UsersClient
+var _ instance.UsersClient = &MockUsersClient{}
+
+// Anonymize implements UsersClient.Anonymize
+func (m *MockUsersClient) Anonymize(opt *sonargo.UsersAnonymizeOption) (resp *http.Response, err error) {
+	if m.AnonymizeFn != nil {
+		return m.AnonymizeFn(opt)
+	}
+	return nil, nil
+}
+
+// ChangePassword implements UsersClient.ChangePassword
+func (m *MockUsersClient) ChangePassword(opt *sonargo.UsersChangePasswordOption) (resp *http.Response, err error) {
+	if m.ChangePasswordFn != nil {
+		return m.ChangePasswordFn(opt)
+	}
+	return nil, nil
+}
+
+// Create implements UsersClient.Create
+func (m *MockUsersClient) Create(opt *instance.UsersCreateOption) (v *sonargo.UsersCreateObject, resp *http.Response, err error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Current implements UsersClient.Current
+func (m *MockUsersClient) Current() (v *sonargo.UsersCurrentObject, resp *http.Response, err error) {
+	if m.CurrentFn != nil {
+		return m.CurrentFn()
+	}
+	return nil, nil, nil
+}
+
+// Deactivate implements UsersClient.Deactivate
+func (m *MockUsersClient) Deactivate(opt *sonargo.UsersDeactivateOption) (v *sonargo.UsersDeactivateObject, resp *http.Response, err error) {
+	if m.DeactivateFn != nil {
+		return m.DeactivateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// DismissNotice implements UsersClient.DismissNotice
+func (m *MockUsersClient) DismissNotice(opt *sonargo.UsersDismissNoticeOption) (resp *http.Response, err error) {
+	if m.DismissNoticeFn != nil {
+		return m.DismissNoticeFn(opt)
+	}
+	return nil, nil
+}
+
+// Groups implements UsersClient.Groups
+func (m *MockUsersClient) Groups(opt *sonargo.UsersGroupsOption) (v *sonargo.UsersGroupsObject, resp *http.Response, err error) {
+	if m.GroupsFn != nil {
+		return m.GroupsFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// IdentityProviders implements UsersClient.IdentityProviders
+func (m *MockUsersClient) IdentityProviders() (v *sonargo.UsersIdentityProvidersObject, resp *http.Response, err error) {
+	if m.IdentityProvidersFn != nil {
+		return m.IdentityProvidersFn()
+	}
+	return nil, nil, nil
+}
+
+// Search implements UsersClient.Search
+func (m *MockUsersClient) Search(opt *sonargo.UsersSearchOption) (v *sonargo.UsersSearchObject, resp *http.Response, err error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// SetHomepage implements UsersClient.SetHomepage
+func (m *MockUsersClient) SetHomepage(opt *sonargo.UsersSetHomepageOption) (resp *http.Response, err error) {
+	if m.SetHomepageFn != nil {
+		return m.SetHomepageFn(opt)
+	}
+	return nil, nil
+}
+
+// Update implements UsersClient.Update
+func (m *MockUsersClient) Update(opt *instance.UsersUpdateOption) (v *sonargo.UsersUpdateObject, resp *http.Response, err error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// UpdateIdentityProvider implements UsersClient.UpdateIdentityProvider
+func (m *MockUsersClient) UpdateIdentityProvider(opt *sonargo.UsersUpdateIdentityProviderOption) (resp *http.Response, err error) {
+	if m.UpdateIdentityProviderFn != nil {
+		return m.UpdateIdentityProviderFn(opt)
+	}
+	return nil, nil
+}
+
+// UpdateLogin implements UsersClient.UpdateLogin
+func (m *MockUsersClient) UpdateLogin(opt *sonargo.UsersUpdateLoginOption) (resp *http.Response, err error) {
+	if m.UpdateLoginFn != nil {
+		return m.UpdateLoginFn(opt)
+	}
+	return nil, nil
+}
+
+// ValidateCredentials implements UsersClient.ValidateCredentials
+func (m *MockUsersClient) ValidateCredentials(opt *instance.AuthenticationValidateCredentialsOption) (v *sonargo.AuthenticationValidateObject, resp *http.Response, err error) {
+	if m.ValidateCredentialsFn != nil {
+		return m.ValidateCredentialsFn(opt)
+	}
+	return nil, nil, nil
+}
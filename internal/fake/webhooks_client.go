@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	sonargo "github.com/boxboxjason/sonarqube-client-go/sonar"
+
+	"github.com/crossplane/provider-sonarqube/internal/clients/instance"
+)
+
+// MockWebhooksClient is a mock implementation of the WebhooksClient interface.
+type MockWebhooksClient struct {
+	CreateFn     func(opt *sonargo.WebhooksCreateOption) (v *sonargo.WebhooksCreateObject, resp *http.Response, err error)
+	DeleteFn     func(opt *sonargo.WebhooksDeleteOption) (resp *http.Response, err error)
+	DeliveriesFn func(opt *sonargo.WebhooksDeliveriesOption) (v *sonargo.WebhooksDeliveriesObject, resp *http.Response, err error)
+	ListFn       func(opt *sonargo.WebhooksListOption) (v *sonargo.WebhooksListObject, resp *http.Response, err error)
+	UpdateFn     func(opt *instance.WebhooksUpdateOption) (resp *http.Response, err error)
+}
+
+// Ensure MockWebhooksClient implements WebhooksClient
+var _ instance.WebhooksClient = &MockWebhooksClient{}
+
+// Create implements WebhooksClient.Create
+func (m *MockWebhooksClient) Create(opt *sonargo.WebhooksCreateOption) (v *sonargo.WebhooksCreateObject, resp *http.Response, err error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Delete implements WebhooksClient.Delete
+func (m *MockWebhooksClient) Delete(opt *sonargo.WebhooksDeleteOption) (resp *http.Response, err error) {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(opt)
+	}
+	return nil, nil
+}
+
+// Deliveries implements WebhooksClient.Deliveries
+func (m *MockWebhooksClient) Deliveries(opt *sonargo.WebhooksDeliveriesOption) (v *sonargo.WebhooksDeliveriesObject, resp *http.Response, err error) {
+	if m.DeliveriesFn != nil {
+		return m.DeliveriesFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// List implements WebhooksClient.List
+func (m *MockWebhooksClient) List(opt *sonargo.WebhooksListOption) (v *sonargo.WebhooksListObject, resp *http.Response, err error) {
+	if m.ListFn != nil {
+		return m.ListFn(opt)
+	}
+	return nil, nil, nil
+}
+
+// Update implements WebhooksClient.Update
+func (m *MockWebhooksClient) Update(opt *instance.WebhooksUpdateOption) (resp *http.Response, err error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(opt)
+	}
+	return nil, nil
+}
@@ -73,3 +73,23 @@ func AssignIfNil[T any](ptr **T, val T) {
 		*ptr = &val
 	}
 }
+
+// IsComparableSliceEqualIgnoringOrder compares two slices of comparable types regardless of the order of their elements.
+// Duplicated elements are taken into account, nil and empty slices are considered equal.
+func IsComparableSliceEqualIgnoringOrder[T comparable](slice1 []T, slice2 []T) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+	// count the occurrences of each element in slice1, then consume them with slice2
+	counts := make(map[T]int, len(slice1))
+	for _, val := range slice1 {
+		counts[val]++
+	}
+	for _, val := range slice2 {
+		if counts[val] == 0 {
+			return false
+		}
+		counts[val]--
+	}
+	return true
+}
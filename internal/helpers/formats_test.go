@@ -260,3 +260,56 @@ func TestAssignIfNil(t *testing.T) {
 		}
 	})
 }
+
+func TestIsComparableSliceEqualIgnoringOrder(t *testing.T) {
+	tests := map[string]struct {
+		slice1 []string
+		slice2 []string
+		want   bool
+	}{
+		"BothNilReturnsTrue": {
+			slice1: nil,
+			slice2: nil,
+			want:   true,
+		},
+		"NilAndEmptyReturnsTrue": {
+			slice1: nil,
+			slice2: []string{},
+			want:   true,
+		},
+		"SameOrderReturnsTrue": {
+			slice1: []string{"a", "b", "c"},
+			slice2: []string{"a", "b", "c"},
+			want:   true,
+		},
+		"DifferentOrderReturnsTrue": {
+			slice1: []string{"a", "b", "c"},
+			slice2: []string{"c", "a", "b"},
+			want:   true,
+		},
+		"DifferentLengthReturnsFalse": {
+			slice1: []string{"a", "b"},
+			slice2: []string{"a", "b", "c"},
+			want:   false,
+		},
+		"DifferentElementsReturnsFalse": {
+			slice1: []string{"a", "b", "c"},
+			slice2: []string{"a", "b", "d"},
+			want:   false,
+		},
+		"DifferentDuplicatesReturnsFalse": {
+			slice1: []string{"a", "a", "b"},
+			slice2: []string{"a", "b", "b"},
+			want:   false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := IsComparableSliceEqualIgnoringOrder(tc.slice1, tc.slice2)
+			if got != tc.want {
+				t.Errorf("IsComparableSliceEqualIgnoringOrder() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}